@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/analytics"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type analyticsController struct {
+	analyticsService analytics.AnalyticsService
+}
+
+func NewAnalyticsController(s analytics.AnalyticsService) *analyticsController {
+	return &analyticsController{
+		analyticsService: s,
+	}
+}
+
+// Revenue sums the sales per ?group_by=, from ?from= to ?to=.
+func (c analyticsController) Revenue() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		revenue, err := c.analyticsService.Revenue(ctx.Query("group_by"), ctx.Query("from"), ctx.Query("to"))
+		if err != nil {
+			status := analyticsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		report.Render(ctx, "revenue", report.Slice(revenue))
+	}
+}
+
+// Margins compares the sales with their purchase cost, grouped like Revenue.
+func (c analyticsController) Margins() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		margins, err := c.analyticsService.Margins(ctx.Query("group_by"), ctx.Query("from"), ctx.Query("to"))
+		if err != nil {
+			status := analyticsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		report.Render(ctx, "margins", report.Slice(margins))
+	}
+}
+
+func analyticsErrorHandler(err error) int {
+	switch err {
+
+	case analytics.InvalidGroupByError, analytics.InvalidDateError, analytics.InvalidRangeError:
+		return http.StatusBadRequest
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
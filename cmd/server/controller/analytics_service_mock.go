@@ -0,0 +1,32 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type mockAnalyticsService struct {
+	revenue []db.RevenueReport
+	margins []db.MarginReport
+	err     error
+	groupBy *string
+}
+
+func (m mockAnalyticsService) Revenue(groupBy string, from string, to string) ([]db.RevenueReport, error) {
+	if m.groupBy != nil {
+		*m.groupBy = groupBy
+	}
+	if m.err != nil {
+		return []db.RevenueReport{}, m.err
+	}
+	return m.revenue, nil
+}
+
+func (m mockAnalyticsService) Margins(groupBy string, from string, to string) ([]db.MarginReport, error) {
+	if m.groupBy != nil {
+		*m.groupBy = groupBy
+	}
+	if m.err != nil {
+		return []db.MarginReport{}, m.err
+	}
+	return m.margins, nil
+}
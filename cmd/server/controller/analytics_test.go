@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/analytics"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Analytics_Revenue_200(t *testing.T) {
+
+	var groupBy string
+	revenue := []db.RevenueReport{{Group: "1", Name: "Frutas SA", Orders: 2, Units: 5, RevenueCents: 5500}}
+
+	router := setupAnalyticsRouter(mockAnalyticsService{revenue: revenue, groupBy: &groupBy})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/analytics/revenue?group_by=locality", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.RevenueReport{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, revenue, responseData)
+	assert.Equal(t, analytics.ByLocality, groupBy)
+}
+
+func Test_Analytics_Revenue_400_InvalidGroupBy(t *testing.T) {
+
+	router := setupAnalyticsRouter(mockAnalyticsService{err: analytics.InvalidGroupByError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/analytics/revenue?group_by=buyer", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Analytics_Margins_200_CSV(t *testing.T) {
+
+	margins := []db.MarginReport{{Group: "1", Name: "Frutas", Units: 5, RevenueCents: 5500, CostCents: 2800, MarginCents: 2700, MarginRate: 0.4909}}
+
+	router := setupAnalyticsRouter(mockAnalyticsService{margins: margins})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/analytics/margins?group_by=product_type&format=csv", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "group,name,units,revenue_cents,cost_cents,margin_cents,margin_rate\n"+
+		"1,Frutas,5,5500,2800,2700,0.4909\n", response.Body.String())
+}
+
+func Test_Analytics_Margins_400_InvalidDate(t *testing.T) {
+
+	router := setupAnalyticsRouter(mockAnalyticsService{err: analytics.InvalidDateError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/analytics/margins?from=yesterday", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Analytics_Margins_500(t *testing.T) {
+
+	router := setupAnalyticsRouter(mockAnalyticsService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/analytics/margins", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func setupAnalyticsRouter(mockService mockAnalyticsService) *gin.Engine {
+	controller := NewAnalyticsController(mockService)
+
+	router := gin.Default()
+	router.GET("/api/v1/analytics/revenue", controller.Revenue())
+	router.GET("/api/v1/analytics/margins", controller.Margins())
+
+	return router
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/audit"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type auditController struct {
+	auditService audit.AuditService
+}
+
+func NewAuditController(s audit.AuditService) *auditController {
+	return &auditController{
+		auditService: s,
+	}
+}
+
+// GetAll lists audit events, optionally of one entity (?entity=products)
+// or one record of it (?entity=products&id=3).
+func (c *auditController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		if entity := ctx.Query("entity"); entity != "" {
+			params.Filters["entity"] = entity
+		}
+		if id := ctx.Query("id"); id != "" {
+			params.Filters["entity_id"] = id
+		}
+
+		events, err := c.auditService.GetAll(params)
+		if err != nil {
+			status := auditErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, events.Items, events.Meta))
+	}
+}
+
+func auditErrorHandler(err error) int {
+	switch err {
+
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockAuditService struct {
+	result any
+	err    error
+	params *query.Params
+}
+
+func (m mockAuditService) Record(event db.AuditEvent, before any, after any) (db.AuditEvent, error) {
+	if m.err != nil {
+		return db.AuditEvent{}, m.err
+	}
+	return event, nil
+}
+
+func (m mockAuditService) GetAll(params query.Params) (query.Page[db.AuditEvent], error) {
+	if m.params != nil {
+		*m.params = params
+	}
+	if m.err != nil {
+		return query.Page[db.AuditEvent]{}, m.err
+	}
+	return query.NewPage(m.result.([]db.AuditEvent), params)
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Audit_GetAll_200(t *testing.T) {
+
+	events := []db.AuditEvent{
+		{
+			Id:        1,
+			Actor:     "ops",
+			ActorRole: "admin",
+			Method:    "PATCH",
+			Route:     "/api/v1/products/:id",
+			Entity:    "products",
+			EntityId:  "3",
+			Changes:   json.RawMessage(`{"description":{"after":"Apple","before":"Banana"}}`),
+			CreatedAt: "2022-07-12 10:00:00",
+		},
+	}
+
+	var params query.Params
+
+	mockService := mockAuditService{
+		result: events,
+		params: &params,
+	}
+
+	router := setupAuditRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/audit?entity=products&id=3", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.AuditEvent{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, events, responseData)
+	assert.Equal(t, map[string]string{"entity": "products", "entity_id": "3"}, params.Filters)
+}
+
+func Test_Audit_GetAll_400(t *testing.T) {
+
+	router := setupAuditRouter(mockAuditService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/audit?limit=0", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Equal(t, query.ErrInvalidLimit.Error(), responseData.Error)
+}
+
+func setupAuditRouter(mockService mockAuditService) *gin.Engine {
+	controller := NewAuditController(mockService)
+
+	router := gin.Default()
+	router.GET("/api/v1/audit", controller.GetAll())
+
+	return router
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/buyers"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -56,7 +58,13 @@ func (c buyerController) Create() gin.HandlerFunc {
 func (c *buyerController) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
-		buyers, err := c.buyerService.GetAll()
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		buyers, err := c.buyerService.GetAll(params)
 
 		if err != nil {
 			status := buyerErrorHandler(err)
@@ -64,7 +72,7 @@ func (c *buyerController) GetAll() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, buyers, ""))
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, buyers.Items, buyers.Meta))
 	}
 
 }
@@ -103,18 +111,9 @@ func (c *buyerController) CountPurchaseOrdersByBuyers() gin.HandlerFunc {
 				ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
 				return
 			}
-			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, buyers, ""))
+			report.RenderOne(ctx, "purchase_orders_by_buyer", buyers)
 		} else {
-
-			buyers, err := c.buyerService.CountPurchaseOrdersByBuyers()
-
-			if err != nil {
-				status := buyerErrorHandler(err)
-				ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
-				return
-			}
-
-			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, buyers, ""))
+			report.Render(ctx, "purchase_orders_by_buyer", c.buyerService.CountPurchaseOrdersByBuyers())
 		}
 	}
 }
@@ -174,6 +173,9 @@ func (c *buyerController) Update() gin.HandlerFunc {
 func buyerErrorHandler(err error) int {
 	switch err {
 
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
 	case buyers.BuyerNotFoundError:
 		return http.StatusNotFound
 
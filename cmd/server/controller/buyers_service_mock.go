@@ -1,6 +1,10 @@
 package controller
 
-import db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
 
 type mockBuyerService struct {
 	result any
@@ -23,11 +27,11 @@ func (m mockBuyerService) Get(id uint64) (db.Buyer, error) {
 	return m.result.(db.Buyer), nil
 }
 
-func (m mockBuyerService) GetAll() ([]db.Buyer, error) {
+func (m mockBuyerService) GetAll(params query.Params) (query.Page[db.Buyer], error) {
 	if m.err != nil {
-		return []db.Buyer{}, m.err
+		return query.Page[db.Buyer]{}, m.err
 	}
-	return m.result.([]db.Buyer), nil
+	return query.NewPage(m.result.([]db.Buyer), params)
 }
 
 func (m mockBuyerService) CountPurchaseOrdersByBuyer(id uint64) (db.CountBuyer, error) {
@@ -37,11 +41,11 @@ func (m mockBuyerService) CountPurchaseOrdersByBuyer(id uint64) (db.CountBuyer,
 	return m.result.(db.CountBuyer), nil
 }
 
-func (m mockBuyerService) CountPurchaseOrdersByBuyers() ([]db.CountBuyer, error) {
+func (m mockBuyerService) CountPurchaseOrdersByBuyers() report.Rows[db.CountBuyer] {
 	if m.err != nil {
-		return []db.CountBuyer{}, m.err
+		return report.Err[db.CountBuyer](m.err)
 	}
-	return m.result.([]db.CountBuyer), nil
+	return report.Slice(m.result.([]db.CountBuyer))
 }
 
 func (m mockBuyerService) Update(
@@ -1,9 +1,12 @@
 package controller
 
 import (
-	"net/http"	
+	"net/http"
+	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -16,6 +19,14 @@ type createCarrierRequest struct {
 	LocalityID  string `json:"locality_id" binding:"required"`
 }
 
+type updateCarrierRequest struct {
+	Cid         string `json:"cid"`
+	CompanyName string `json:"company_name"`
+	Address     string `json:"address"`
+	Telephone   string `json:"telephone"`
+	LocalityID  string `json:"locality_id"`
+}
+
 type carrierController struct {
 	carrierService carries.CarrierService
 }
@@ -60,18 +71,105 @@ func (c *carrierController) GetAllCarrierInfo() gin.HandlerFunc {
 			return
 		}
 
+		report.Render(ctx, "carriers_by_locality", carrier)
+	}
+}
+
+func (c *carrierController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		page, err := c.carrierService.GetAll(params)
+		if err != nil {
+			status := carrierErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, page.Items, page.Meta))
+	}
+}
+
+func (c *carrierController) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "carrier id binding error"))
+			return
+		}
+
+		carrier, err := c.carrierService.Get(id)
+		if err != nil {
+			status := carrierErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
 		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, carrier, ""))
 	}
 }
 
+func (c *carrierController) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "carrier id binding error"))
+			return
+		}
+
+		var request updateCarrierRequest
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		updatedCarrier, err := c.carrierService.Update(id, request.Cid, request.CompanyName, request.Address, request.Telephone, request.LocalityID)
+		if err != nil {
+			status := carrierErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, updatedCarrier, ""))
+	}
+}
+
+// Delete refuses carriers that were assigned a shipment, so the shipments
+// keep pointing at their carrier.
+func (c *carrierController) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "carrier id binding error"))
+			return
+		}
+
+		if err := c.carrierService.Delete(id); err != nil {
+			status := carrierErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, web.NewResponse(http.StatusNoContent, nil, ""))
+	}
+}
+
 func carrierErrorHandler(err error) int {
 	switch err {
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
 	case carries.CarrierNotFoundError:
 		return http.StatusNotFound
 	case carries.ExistsCarrierCidError:
 		return http.StatusConflict
 	case carries.LocalityIdNotExistsError:
 		return http.StatusConflict
+	case carries.CarrierHasShipmentsError:
+		return http.StatusConflict
 	default:
 		return http.StatusInternalServerError
 	}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
+
+type mockCarrierService struct {
+	result   db.Carrier
+	carriers []db.Carrier
+	info     []carries.CarrierInfo
+	err      error
+}
+
+func (m mockCarrierService) Create(cid string, companyName string, address string, telephone string, localityId string) (db.Carrier, error) {
+	return m.result, m.err
+}
+
+func (m mockCarrierService) GetAllCarrierInfo(id string) (report.Rows[carries.CarrierInfo], error) {
+	return report.Slice(m.info), m.err
+}
+
+func (m mockCarrierService) GetAll(params query.Params) (query.Page[db.Carrier], error) {
+	if m.err != nil {
+		return query.Page[db.Carrier]{}, m.err
+	}
+	return query.NewPage(m.carriers, params)
+}
+
+func (m mockCarrierService) Get(id uint64) (db.Carrier, error) {
+	return m.result, m.err
+}
+
+func (m mockCarrierService) Update(id uint64, cid string, companyName string, address string, telephone string, localityId string) (db.Carrier, error) {
+	return m.result, m.err
+}
+
+func (m mockCarrierService) Delete(id uint64) error {
+	return m.err
+}
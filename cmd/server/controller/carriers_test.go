@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedCarrier = db.Carrier{Id: 1, Cid: "CID1", CompanyName: "Rapido", Address: "Rua 1", Telephone: "1111", LocalityID: "11065001"}
+
+func Test_Carrier_GetAll_200(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{carriers: []db.Carrier{expectedCarrier}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/carries/?filter[locality_id]=11065001", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.Carrier{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, []db.Carrier{expectedCarrier}, responseData)
+}
+
+func Test_Carrier_Get_200(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{result: expectedCarrier})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/carries/1", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := db.Carrier{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedCarrier, responseData)
+}
+
+func Test_Carrier_Get_404(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{err: carries.CarrierNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/carries/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Carrier_Update_200(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{result: expectedCarrier})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/carries/1", carrierRequestBody(updateCarrierRequest{CompanyName: "Rapido"}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.Carrier{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedCarrier, responseData)
+}
+
+func Test_Carrier_Update_409(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{err: carries.ExistsCarrierCidError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/carries/1", carrierRequestBody(updateCarrierRequest{Cid: "CID2"}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func Test_Carrier_Delete_204(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/carries/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func Test_Carrier_Delete_409(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{err: carries.CarrierHasShipmentsError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/carries/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func Test_Carrier_Delete_400(t *testing.T) {
+
+	router := setupCarrierRouter(mockCarrierService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/carries/abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func carrierRequestBody(request any) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func setupCarrierRouter(mockService mockCarrierService) *gin.Engine {
+	controller := NewCarrierController(mockService)
+
+	router := gin.Default()
+	router.GET("/api/v1/carries/", controller.GetAll())
+	router.GET("/api/v1/carries/:id", controller.Get())
+	router.PATCH("/api/v1/carries/:id", controller.Update())
+	router.DELETE("/api/v1/carries/:id", controller.Delete())
+
+	return router
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/employees"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -116,7 +118,13 @@ func (c *EmployeeController) Update() gin.HandlerFunc {
 func (c *EmployeeController) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
-		employees, err := c.employeeService.GetAll()
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		employees, err := c.employeeService.GetAll(params)
 
 		if err != nil {
 			status := employeeErrorHandler(err)
@@ -124,7 +132,7 @@ func (c *EmployeeController) GetAll() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, employees, ""))
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, employees.Items, employees.Meta))
 	}
 }
 
@@ -154,23 +162,16 @@ func (c *EmployeeController) CountInboundOrders() gin.HandlerFunc {
 		id, ok := ctx.GetQuery("id")
 		if ok {
 			id, _ := strconv.ParseUint(id, 10, 64)
-			report, err := c.employeeService.CountInboundOrdersByEmployeeId(id)
+			employeeReport, err := c.employeeService.CountInboundOrdersByEmployeeId(id)
 			if err != nil {
 				status := employeeErrorHandler(err)
 				ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
 				return
 			}
 
-			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, report, ""))
+			report.RenderOne(ctx, "inbound_orders_by_employee", employeeReport)
 		} else {
-			reports, err := c.employeeService.CountInboundOrders()
-			if err != nil {
-				status := employeeErrorHandler(err)
-				ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
-				return
-			}
-
-			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, reports, ""))
+			report.Render(ctx, "inbound_orders_by_employee", c.employeeService.CountInboundOrders())
 		}
 
 	}
@@ -179,6 +180,9 @@ func (c *EmployeeController) CountInboundOrders() gin.HandlerFunc {
 func employeeErrorHandler(err error) int {
 	switch err {
 
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
 	case employees.ExistsCardNumberIdError:
 		return http.StatusConflict
 
@@ -2,6 +2,8 @@ package controller
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/employees"
 )
 
@@ -11,11 +13,11 @@ type mockEmployeeService struct {
 	employeeExists bool
 }
 
-func (m mockEmployeeService) GetAll() ([]db.Employee, error) {
+func (m mockEmployeeService) GetAll(params query.Params) (query.Page[db.Employee], error) {
 	if m.err != nil {
-		return []db.Employee{}, m.err
+		return query.Page[db.Employee]{}, m.err
 	}
-	return m.result.([]db.Employee), nil
+	return query.NewPage(m.result.([]db.Employee), params)
 }
 
 func (m mockEmployeeService) Get(id uint64) (db.Employee, error) {
@@ -61,11 +63,11 @@ func (m mockEmployeeService) CountInboundOrdersByEmployeeId(id uint64) (db.Repor
 	return m.result.(db.ReportInboundOrders), nil
 }
 
-func (m mockEmployeeService) CountInboundOrders() ([]db.ReportInboundOrders, error) {
+func (m mockEmployeeService) CountInboundOrders() report.Rows[db.ReportInboundOrders] {
 	if m.err != nil {
-		return []db.ReportInboundOrders{}, m.err
+		return report.Err[db.ReportInboundOrders](m.err)
 	}
-	return m.result.([]db.ReportInboundOrders), nil
+	return report.Slice(m.result.([]db.ReportInboundOrders))
 }
 
 func (m mockEmployeeService) ExistsEmployee(id uint64) (bool) {
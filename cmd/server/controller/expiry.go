@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/expiry"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type expiryController struct {
+	expiryService expiry.ExpiryService
+	defaultWithin time.Duration
+}
+
+func NewExpiryController(s expiry.ExpiryService, defaultWithin time.Duration) *expiryController {
+	return &expiryController{
+		expiryService: s,
+		defaultWithin: defaultWithin,
+	}
+}
+
+// GetExpiring lists the batches due within ?within=72h, grouped by
+// warehouse and section, optionally of one warehouse (?warehouse_id=1).
+func (c *expiryController) GetExpiring() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		within := c.defaultWithin
+		if value := ctx.Query("within"); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, expiry.InvalidWithinError.Error()))
+				return
+			}
+			within = parsed
+		}
+
+		var warehouseId uint64
+		if value := ctx.Query("warehouse_id"); value != "" {
+			parsed, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+				return
+			}
+			warehouseId = parsed
+		}
+
+		report, err := c.expiryService.Expiring(within, warehouseId)
+		if err != nil {
+			status := expiryErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, report, ""))
+	}
+}
+
+// GetAlerts lists the alerts written by the expiry job.
+func (c *expiryController) GetAlerts() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		alerts, err := c.expiryService.GetAlerts(params)
+		if err != nil {
+			status := expiryErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, alerts.Items, alerts.Meta))
+	}
+}
+
+func expiryErrorHandler(err error) int {
+	switch err {
+
+	case expiry.InvalidWithinError:
+		return http.StatusBadRequest
+
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
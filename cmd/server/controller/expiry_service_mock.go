@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockExpiryService struct {
+	result      []db.ExpiringWarehouse
+	alerts      []db.ExpiryAlert
+	err         error
+	within      *time.Duration
+	warehouseId *uint64
+}
+
+func (m mockExpiryService) Expiring(within time.Duration, warehouseId uint64) ([]db.ExpiringWarehouse, error) {
+	if m.within != nil {
+		*m.within = within
+	}
+	if m.warehouseId != nil {
+		*m.warehouseId = warehouseId
+	}
+	return m.result, m.err
+}
+
+func (m mockExpiryService) RecordAlerts(threshold time.Duration) ([]db.ExpiryAlert, error) {
+	return m.alerts, m.err
+}
+
+func (m mockExpiryService) GetAlerts(params query.Params) (query.Page[db.ExpiryAlert], error) {
+	if m.err != nil {
+		return query.Page[db.ExpiryAlert]{}, m.err
+	}
+	return query.NewPage(m.alerts, params)
+}
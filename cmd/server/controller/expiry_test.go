@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/expiry"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Expiry_GetExpiring_200(t *testing.T) {
+
+	report := []db.ExpiringWarehouse{
+		{WarehouseId: 1, WarehouseCode: "WH1", Sections: []db.ExpiringSection{
+			{SectionId: 1, SectionNumber: 11, Batches: []db.ExpiringBatch{
+				{Id: 2, Number: 20, ProductId: 1, ProductDescription: "Banana", CurrentQuantity: 20, DueDate: "2022-08-02 10:00:00"},
+			}},
+		}},
+	}
+
+	var within time.Duration
+	var warehouseId uint64
+
+	router := setupExpiryRouter(mockExpiryService{result: report, within: &within, warehouseId: &warehouseId})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/expiring?within=24h&warehouse_id=1", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.ExpiringWarehouse{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, report, responseData)
+	assert.Equal(t, 24*time.Hour, within)
+	assert.Equal(t, uint64(1), warehouseId)
+}
+
+func Test_Expiry_GetExpiring_DefaultWithin(t *testing.T) {
+
+	var within time.Duration
+
+	router := setupExpiryRouter(mockExpiryService{result: []db.ExpiringWarehouse{}, within: &within})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/expiring", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expiry.DefaultThreshold, within)
+}
+
+func Test_Expiry_GetExpiring_400(t *testing.T) {
+
+	router := setupExpiryRouter(mockExpiryService{})
+
+	for _, url := range []string{
+		"/api/v1/productBatches/expiring?within=soon",
+		"/api/v1/productBatches/expiring?warehouse_id=abc",
+	} {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", url, nil)
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	}
+}
+
+func Test_Expiry_GetExpiring_500(t *testing.T) {
+
+	router := setupExpiryRouter(mockExpiryService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/expiring", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func Test_Expiry_GetAlerts_200(t *testing.T) {
+
+	alerts := []db.ExpiryAlert{
+		{Id: 1, ProductBatchId: 2, DueDate: "2022-08-02 10:00:00", CurrentQuantity: 20, Threshold: "72h0m0s", CreatedAt: "2022-08-01 00:00:00"},
+	}
+
+	router := setupExpiryRouter(mockExpiryService{alerts: alerts})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/expiryAlerts", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.ExpiryAlert{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, alerts, responseData)
+}
+
+func setupExpiryRouter(mockService mockExpiryService) *gin.Engine {
+	controller := NewExpiryController(mockService, expiry.DefaultThreshold)
+
+	router := gin.Default()
+	router.GET("/api/v1/productBatches/expiring", controller.GetExpiring())
+	router.GET("/api/v1/productBatches/expiryAlerts", controller.GetAlerts())
+
+	return router
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/imports"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportBytes is the largest CSV file an import accepts.
+const maxImportBytes = 10 << 20
+
+type importController struct {
+	importService imports.ImportService
+}
+
+func NewImportController(s imports.ImportService) *importController {
+	return &importController{
+		importService: s,
+	}
+}
+
+// Products imports a CSV of products. Sellers can only import their own
+// products.
+func (c importController) Products() gin.HandlerFunc {
+	return c.handle(func(ctx *gin.Context, csv io.Reader, dryRun bool) (db.ImportReport, error) {
+		sellerId, _ := auth.SellerScope(ctx)
+		return c.importService.ImportProducts(csv, sellerId, dryRun)
+	})
+}
+
+func (c importController) Sellers() gin.HandlerFunc {
+	return c.handle(func(ctx *gin.Context, csv io.Reader, dryRun bool) (db.ImportReport, error) {
+		return c.importService.ImportSellers(csv, dryRun)
+	})
+}
+
+func (c importController) Warehouses() gin.HandlerFunc {
+	return c.handle(func(ctx *gin.Context, csv io.Reader, dryRun bool) (db.ImportReport, error) {
+		return c.importService.ImportWarehouses(csv, dryRun)
+	})
+}
+
+// handle reads the CSV from the request body, or from the file field of a
+// multipart form, and answers with the import report: 201 when the rows
+// were imported, 200 for a dry run and 422, still with the report, when
+// any row is invalid.
+func (c importController) handle(run func(ctx *gin.Context, csv io.Reader, dryRun bool) (db.ImportReport, error)) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		dryRun, err := strconv.ParseBool(ctx.DefaultQuery("dry_run", "false"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "dry_run must be true or false"))
+			return
+		}
+
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImportBytes)
+
+		var csv io.Reader = ctx.Request.Body
+
+		if ctx.ContentType() == "multipart/form-data" {
+			header, err := ctx.FormFile("file")
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "file field is required"))
+				return
+			}
+
+			file, err := header.Open()
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+				return
+			}
+			defer file.Close()
+
+			csv = file
+		}
+
+		report, err := run(ctx, csv, dryRun)
+
+		switch {
+		case err == imports.InvalidRowsError:
+			ctx.JSON(http.StatusUnprocessableEntity, web.Response{Code: http.StatusUnprocessableEntity, Data: report, Error: err.Error()})
+		case err != nil:
+			status := importErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+		case dryRun:
+			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, report, ""))
+		default:
+			ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, report, ""))
+		}
+	}
+}
+
+func importErrorHandler(err error) int {
+	switch err {
+	case imports.InvalidRowsError:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
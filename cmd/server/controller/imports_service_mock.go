@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"io"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type mockImportService struct {
+	report   db.ImportReport
+	err      error
+	sellerId *uint64
+}
+
+func (m mockImportService) ImportProducts(reader io.Reader, sellerId uint64, dryRun bool) (db.ImportReport, error) {
+	if m.sellerId != nil {
+		*m.sellerId = sellerId
+	}
+	return m.report, m.err
+}
+
+func (m mockImportService) ImportSellers(reader io.Reader, dryRun bool) (db.ImportReport, error) {
+	return m.report, m.err
+}
+
+func (m mockImportService) ImportWarehouses(reader io.Reader, dryRun bool) (db.ImportReport, error) {
+	return m.report, m.err
+}
@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/imports"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var importedReport = db.ImportReport{Rows: 2, Imported: 2, Ids: []uint64{1, 2}, Errors: []db.ImportRowError{}}
+
+func Test_Import_Products_201(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{report: importedReport})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products/import", strings.NewReader("product_code\nBAN\nMAC\n"))
+	request.Header.Set("Content-Type", "text/csv")
+	router.ServeHTTP(response, request)
+
+	responseData := db.ImportReport{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, importedReport, responseData)
+}
+
+func Test_Import_Products_200_DryRun(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{report: db.ImportReport{DryRun: true, Rows: 2}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products/import?dry_run=true", strings.NewReader("product_code\nBAN\nMAC\n"))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func Test_Import_Products_400_DryRun(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products/import?dry_run=maybe", strings.NewReader("product_code\n"))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Import_Products_422_WithReport(t *testing.T) {
+
+	expectedReport := db.ImportReport{Rows: 1, Ids: []uint64{}, Errors: []db.ImportRowError{{Line: 2, Error: "product code already exists"}}}
+
+	router := setupImportRouter(mockImportService{report: expectedReport, err: imports.InvalidRowsError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products/import", strings.NewReader("product_code\nBAN\n"))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	reportData := db.ImportReport{}
+	decodeWebResponse(response, &reportData)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Equal(t, imports.InvalidRowsError.Error(), responseData.Error)
+	assert.Equal(t, expectedReport, reportData)
+}
+
+func Test_Import_Products_500(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products/import", strings.NewReader("product_code\nBAN\n"))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func Test_Import_Products_ShouldLimitSellersToTheirProducts(t *testing.T) {
+
+	var sellerId uint64
+	controller := NewImportController(mockImportService{report: importedReport, sellerId: &sellerId})
+
+	router := gin.Default()
+	router.Use(func(ctx *gin.Context) {
+		auth.SetPrincipal(ctx, auth.Principal{Subject: "seller", Role: auth.SellerRole, SellerId: 7})
+	})
+	router.POST("/api/v1/products/import", controller.Products())
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products/import", strings.NewReader("product_code\nBAN\n"))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, uint64(7), sellerId)
+}
+
+func Test_Import_Sellers_201_Multipart(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{report: importedReport})
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	file, _ := form.CreateFormFile("file", "sellers.csv")
+	file.Write([]byte("cid\n1\n2\n"))
+	form.Close()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/sellers/import", body)
+	request.Header.Set("Content-Type", form.FormDataContentType())
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+}
+
+func Test_Import_Sellers_400_MultipartWithoutFile(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{report: importedReport})
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	form.WriteField("name", "sellers.csv")
+	form.Close()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/sellers/import", body)
+	request.Header.Set("Content-Type", form.FormDataContentType())
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Import_Warehouses_201(t *testing.T) {
+
+	router := setupImportRouter(mockImportService{report: importedReport})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/warehouses/import", strings.NewReader("warehouse_code\nWH1\nWH2\n"))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+}
+
+func setupImportRouter(mockService mockImportService) *gin.Engine {
+	controller := NewImportController(mockService)
+
+	router := gin.Default()
+	router.POST("/api/v1/products/import", controller.Products())
+	router.POST("/api/v1/sellers/import", controller.Sellers())
+	router.POST("/api/v1/warehouses/import", controller.Warehouses())
+
+	return router
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	inboundorders "github.com/GuiTadeu/mercado-fresh-panic/internal/inboundOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -14,6 +15,7 @@ type createInboundOrdersRequest struct {
 	EmployeeId     uint64    `json:"employee_id" binding:"required"`
 	ProductBatchId uint64    `json:"product_batch_id" binding:"required"`
 	WarehouseId    uint64    `json:"warehouse_id" binding:"required"`
+	Quantity       uint64    `json:"quantity" binding:"required"`
 }
 
 type inboundOrderController struct {
@@ -36,7 +38,7 @@ func (c inboundOrderController) Create() gin.HandlerFunc {
 			return
 		}
 
-		inboundOrder, err := c.inboundOrderService.Create(req.OrderDate, req.OrderNumber, req.EmployeeId, req.ProductBatchId, req.WarehouseId)
+		inboundOrder, err := c.inboundOrderService.Create(req.OrderDate, req.OrderNumber, req.EmployeeId, req.ProductBatchId, req.WarehouseId, req.Quantity)
 
 		if err != nil {
 			status := inboundOrderErrorHandler(err)
@@ -56,6 +58,14 @@ func inboundOrderErrorHandler(err error) int {
 		return http.StatusConflict
 	case inboundorders.WarehouseNotFoundError:
 		return http.StatusConflict
+	case inboundorders.ProductBatchNotFoundError:
+		return http.StatusConflict
+	case inboundorders.WarehouseMismatchError:
+		return http.StatusConflict
+	case sections.ErrSectionNotFoundError:
+		return http.StatusConflict
+	case sections.ErrSectionCapacityExceededError:
+		return http.StatusConflict
 	default:
 		return http.StatusInternalServerError
 	}
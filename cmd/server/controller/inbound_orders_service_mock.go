@@ -9,7 +9,7 @@ type mockInboundOrderService struct {
 	err    error
 }
 
-func (m mockInboundOrderService) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId uint64) (db.InboundOrder, error) {
+func (m mockInboundOrderService) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId, quantity uint64) (db.InboundOrder, error) {
 	if m.err != nil {
 		return db.InboundOrder{}, m.err
 	}
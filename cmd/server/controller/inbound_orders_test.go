@@ -10,6 +10,7 @@ import (
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	inboundorders "github.com/GuiTadeu/mercado-fresh-panic/internal/inboundOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,7 @@ func Test_Inbound_Order_Create_201(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	jsonValue, _ := json.Marshal(validInboundOrder)
@@ -57,6 +59,7 @@ func Test_Inbound_Order_Create_Employee_Not_Found_409(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	jsonValue, _ := json.Marshal(validInboundOrder)
@@ -90,6 +93,75 @@ func Test_Inbound_Order_Create_Warehouse_Not_Found_409(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
+	}
+
+	jsonValue, _ := json.Marshal(validInboundOrder)
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockInboundOrderService{
+		err: expectedError,
+	}
+
+	router := setupInboundOrderRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/inboundOrders", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Inbound_Order_Create_Section_Capacity_Exceeded_409(t *testing.T) {
+
+	expectedError := sections.ErrSectionCapacityExceededError
+
+	validInboundOrder := db.InboundOrder{
+		Id: 1,
+		OrderDate: "2021-04-04",
+		OrderNumber: "order#1",
+		EmployeeId: 1,
+		ProductBatchId: 1,
+		WarehouseId: 1,
+		Quantity: 10,
+	}
+
+	jsonValue, _ := json.Marshal(validInboundOrder)
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockInboundOrderService{
+		err: expectedError,
+	}
+
+	router := setupInboundOrderRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/inboundOrders", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Inbound_Order_Create_Section_Not_Found_409(t *testing.T) {
+
+	expectedError := sections.ErrSectionNotFoundError
+
+	validInboundOrder := db.InboundOrder{
+		Id: 1,
+		OrderDate: "2021-04-04",
+		OrderNumber: "order#1",
+		EmployeeId: 1,
+		ProductBatchId: 1,
+		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	jsonValue, _ := json.Marshal(validInboundOrder)
@@ -123,6 +195,7 @@ func Test_Inbound_Order_Create_500(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	jsonValue, _ := json.Marshal(validInboundOrder)
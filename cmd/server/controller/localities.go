@@ -2,8 +2,10 @@ package controller
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/localities"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -53,14 +55,49 @@ func (control *LocalitiesController) GetLocalityInfo() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, localityData, ""))
+		report.Render(ctx, "sellers_by_locality", localityData)
+	}
+}
+
+// GetGeoReport counts sellers, carriers, warehouses and employees per
+// country, province or locality. ?country_id=, ?province_id= and ?id=
+// drill down into one of them and ?level= picks the rows.
+func (control *LocalitiesController) GetGeoReport() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		filter := localities.GeoFilter{LocalityId: ctx.Query("id")}
+
+		for param, id := range map[string]*uint64{"country_id": &filter.CountryId, "province_id": &filter.ProvinceId} {
+			value := ctx.Query(param)
+			if value == "" {
+				continue
+			}
+
+			parsed, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, param+" in wrong format"))
+				return
+			}
+			*id = parsed
+		}
+
+		geoReport, err := control.service.GetGeoReport(ctx.Query("level"), filter)
+		if err != nil {
+			status := localityErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		report.Render(ctx, "geography", report.Slice(geoReport))
 	}
 }
 
 func localityErrorHandler(err error, ctx *gin.Context) int {
 	switch err {
 
-	case localities.LocalityNotFoundError:
+	case localities.InvalidGeoLevelError:
+		return http.StatusBadRequest
+
+	case localities.LocalityNotFoundError, localities.CountryNotFoundError, localities.ProvinceNotFoundError:
 		return http.StatusNotFound
 
 	case localities.ExistsLocalityId:
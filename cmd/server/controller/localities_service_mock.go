@@ -0,0 +1,41 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/localities"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
+
+type mockLocalityService struct {
+	result any
+	err    error
+	level  *string
+	filter *localities.GeoFilter
+}
+
+func (m mockLocalityService) Create(localityId string, localityName string, provinceId uint64) (db.Locality, error) {
+	if m.err != nil {
+		return db.Locality{}, m.err
+	}
+	return m.result.(db.Locality), nil
+}
+
+func (m mockLocalityService) GetLocalityInfo(localityId string) (report.Rows[localities.LocalityInfo], error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return report.Slice(m.result.([]localities.LocalityInfo)), nil
+}
+
+func (m mockLocalityService) GetGeoReport(level string, filter localities.GeoFilter) ([]localities.GeoReport, error) {
+	if m.level != nil {
+		*m.level = level
+	}
+	if m.filter != nil {
+		*m.filter = filter
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.result.([]localities.GeoReport), nil
+}
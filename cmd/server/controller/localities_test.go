@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/localities"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Locality_GeoReport_200(t *testing.T) {
+
+	var level string
+	var filter localities.GeoFilter
+	geoReport := []localities.GeoReport{{CountryId: 1, CountryName: "Brasil", ProvinceId: 1, ProvinceName: "São Paulo", SellersCount: 3}}
+
+	router := setupLocalityRouter(mockLocalityService{result: geoReport, level: &level, filter: &filter})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/localities/reportGeography?country_id=1&level=province", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []localities.GeoReport{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, geoReport, responseData)
+	assert.Equal(t, localities.ProvinceLevel, level)
+	assert.Equal(t, localities.GeoFilter{CountryId: 1}, filter)
+}
+
+func Test_Locality_GeoReport_400_InvalidProvinceId(t *testing.T) {
+
+	router := setupLocalityRouter(mockLocalityService{result: []localities.GeoReport{}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/localities/reportGeography?province_id=abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Locality_GeoReport_400_InvalidLevel(t *testing.T) {
+
+	router := setupLocalityRouter(mockLocalityService{err: localities.InvalidGeoLevelError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/localities/reportGeography?level=city", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Locality_GeoReport_404_UnknownCountry(t *testing.T) {
+
+	router := setupLocalityRouter(mockLocalityService{err: localities.CountryNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/localities/reportGeography?country_id=9", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func setupLocalityRouter(mockService mockLocalityService) *gin.Engine {
+	controller := NewLocality(mockService)
+
+	router := gin.Default()
+	router.GET("/api/v1/localities/reportSellers", controller.GetLocalityInfo())
+	router.GET("/api/v1/localities/reportGeography", controller.GetGeoReport())
+
+	return router
+}
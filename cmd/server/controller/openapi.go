@@ -0,0 +1,448 @@
+package controller
+
+import (
+	"net/http"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/analytics"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/buyers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/employees"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/expiry"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/imports"
+	inboundorders "github.com/GuiTadeu/mercado-fresh-panic/internal/inboundOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/localities"
+	orderdetails "github.com/GuiTadeu/mercado-fresh-panic/internal/orderDetails"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/picking"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/productTypes"
+	productrecords "github.com/GuiTadeu/mercado-fresh-panic/internal/product_records"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/recalls"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/shipments"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/stock"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/telemetry"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/transfers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/openapi"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterOperations describes the routes of every controller for the
+// OpenAPI document. Error statuses come from the error handlers of each
+// controller, so they follow any change made there.
+func RegisterOperations(registry *openapi.Registry) {
+	registry.Add(sellerOperations()...)
+	registry.Add(warehouseOperations()...)
+	registry.Add(sectionOperations()...)
+	registry.Add(telemetryOperations()...)
+	registry.Add(productOperations()...)
+	registry.Add(productTypeOperations()...)
+	registry.Add(buyerOperations()...)
+	registry.Add(employeeOperations()...)
+	registry.Add(inboundOrderOperations()...)
+	registry.Add(localityOperations()...)
+	registry.Add(carrierOperations()...)
+	registry.Add(shipmentOperations()...)
+	registry.Add(productBatchOperations()...)
+	registry.Add(expiryOperations()...)
+	registry.Add(pickingOperations()...)
+	registry.Add(transferOperations()...)
+	registry.Add(stockOperations()...)
+	registry.Add(importOperations()...)
+	registry.Add(recallOperations()...)
+	registry.Add(productRecordOperations()...)
+	registry.Add(purchaseOrderOperations()...)
+	registry.Add(orderDetailsOperations()...)
+	registry.Add(analyticsOperations()...)
+	registry.Add(auditOperations()...)
+}
+
+const invalidBody = "request body does not match the schema"
+
+// listErrors are the errors of query.Parse and query.Builder, answered with
+// 400 by every paginated endpoint.
+func listErrors() openapi.ErrorResponses {
+	return openapi.Errors(
+		func(error) int { return http.StatusBadRequest },
+		query.ErrInvalidLimit, query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor,
+	)
+}
+
+// reportQuery describes the query of the report endpoints, which can also
+// be downloaded as a spreadsheet.
+func reportQuery(description string) []openapi.Parameter {
+	return []openapi.Parameter{
+		{Name: "id", In: "query", Description: description, Schema: &openapi.Schema{Type: "string"}},
+		{Name: "format", In: "query", Description: "json, csv or xlsx, otherwise taken from the Accept header", Schema: &openapi.Schema{Type: "string"}},
+	}
+}
+
+func reportErrors(handler func(error) int, errs ...error) openapi.ErrorResponses {
+	return openapi.Errors(handler, errs...).With(http.StatusBadRequest, report.ErrInvalidFormat.Error())
+}
+
+func withoutContext(handler func(error, *gin.Context) int) func(error) int {
+	return func(err error) int {
+		return handler(err, nil)
+	}
+}
+
+func sellerOperations() []openapi.Operation {
+	handler := withoutContext(sellerErrorHandler)
+
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/sellers/", Tag: "sellers", Summary: "List sellers", Response: []db.Seller{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/sellers/:id", Tag: "sellers", Summary: "Get a seller", Response: db.Seller{},
+			Errors: openapi.Errors(handler, sellers.SellerNotFoundError)},
+		{Method: "POST", Path: "/api/v1/sellers/", Tag: "sellers", Summary: "Create a seller", Request: createSellerRequest{}, Response: db.Seller{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(handler, sellers.ExistsSellerCodeError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/sellers/:id", Tag: "sellers", Summary: "Update a seller", Request: updateSellerRequest{}, Response: db.Seller{},
+			Errors: openapi.Errors(handler, sellers.SellerNotFoundError, sellers.ExistsSellerCodeError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "DELETE", Path: "/api/v1/sellers/:id", Tag: "sellers", Summary: "Delete a seller", Status: http.StatusNoContent,
+			Errors: openapi.Errors(handler, sellers.SellerNotFoundError)},
+	}
+}
+
+func warehouseOperations() []openapi.Operation {
+	handler := withoutContext(warehouseErrorHandler)
+
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/warehouses/", Tag: "warehouses", Summary: "List warehouses", Response: []db.Warehouse{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/warehouses/:id", Tag: "warehouses", Summary: "Get a warehouse", Response: db.Warehouse{},
+			Errors: openapi.Errors(handler, warehouses.WarehouseNotFoundError)},
+		{Method: "POST", Path: "/api/v1/warehouses/", Tag: "warehouses", Summary: "Create a warehouse", Request: createWarehouseRequest{}, Response: db.Warehouse{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(handler, warehouses.ExistsWarehouseCodeError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/warehouses/:id", Tag: "warehouses", Summary: "Update a warehouse", Request: updateWarehouseRequest{}, Response: db.Warehouse{},
+			Errors: openapi.Errors(handler, warehouses.WarehouseNotFoundError, warehouses.ExistsWarehouseCodeError).With(http.StatusBadRequest, "warehouses id binding error")},
+		{Method: "DELETE", Path: "/api/v1/warehouses/:id", Tag: "warehouses", Summary: "Delete a warehouse (admin only)", Status: http.StatusNoContent,
+			Errors: openapi.Errors(handler, warehouses.WarehouseNotFoundError)},
+		{Method: "GET", Path: "/api/v1/warehouses/:id/utilisation", Tag: "warehouses", Summary: "Report the fill percentage of the warehouse and its sections", Response: db.WarehouseUtilisation{},
+			Errors: openapi.Errors(handler, warehouses.WarehouseNotFoundError).With(http.StatusBadRequest, "warehouses id binding error")},
+	}
+}
+
+func sectionOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/sections/", Tag: "sections", Summary: "List sections", Response: []db.Section{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/sections/:id", Tag: "sections", Summary: "Get a section", Response: db.Section{},
+			Errors: openapi.Errors(sectionErrorHandler, sections.ErrSectionNotFoundError)},
+		{Method: "POST", Path: "/api/v1/sections/", Tag: "sections", Summary: "Create a section", Request: CreateSectionRequest{}, Response: db.Section{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(sectionErrorHandler, sections.ErrExistsSectionNumberError, sections.ErrInvalidSectionCapacityError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/sections/:id", Tag: "sections", Summary: "Update a section", Request: UpdateSectionRequest{}, Response: db.Section{},
+			Errors: openapi.Errors(sectionErrorHandler, sections.ErrSectionNotFoundError, sections.ErrExistsSectionNumberError, sections.ErrInvalidSectionCapacityError).With(http.StatusBadRequest, "section id binding error")},
+		{Method: "DELETE", Path: "/api/v1/sections/:id", Tag: "sections", Summary: "Delete a section", Status: http.StatusNoContent,
+			Errors: openapi.Errors(sectionErrorHandler, sections.ErrSectionNotFoundError)},
+		{Method: "GET", Path: "/api/v1/sections/reportProducts", Tag: "sections", Summary: "Count products by section", Response: []db.CountProductsBySectionIdReport{},
+			Query: reportQuery("section id, all sections when omitted"), Errors: reportErrors(productBatchErrorHandler, batches.SectionNotFoundError)},
+	}
+}
+
+func telemetryOperations() []openapi.Operation {
+	timeRange := []openapi.Parameter{
+		{Name: "from", In: "query", Description: "start, e.g. 2022-08-01 10:00:00 (UTC) or RFC 3339; 24 hours before to when omitted", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "to", In: "query", Description: "end, exclusive; now when omitted", Schema: &openapi.Schema{Type: "string"}},
+	}
+
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/sections/:id/temperatures", Tag: "sections", Summary: "Ingest temperature readings of a section", Request: CreateTemperaturesRequest{}, Response: []db.TemperatureReading{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(telemetryErrorHandler, sections.ErrSectionNotFoundError, telemetry.InvalidRecordedAtError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "GET", Path: "/api/v1/sections/:id/temperatures", Tag: "sections", Summary: "Temperature history of a section, rolled up in buckets", Response: []db.TemperatureRollup{},
+			Query:  append(timeRange, openapi.Parameter{Name: "bucket", In: "query", Description: "bucket size, 1h by default", Schema: &openapi.Schema{Type: "string"}}),
+			Errors: openapi.Errors(telemetryErrorHandler, sections.ErrSectionNotFoundError, telemetry.InvalidRangeError, telemetry.InvalidBucketError, telemetry.TooManyBucketsError)},
+		{Method: "GET", Path: "/api/v1/sections/:id/excursions", Tag: "sections", Summary: "Periods below the minimum temperature of a section or of its batches", Response: []db.TemperatureExcursion{},
+			Query: timeRange, Errors: openapi.Errors(telemetryErrorHandler, sections.ErrSectionNotFoundError, telemetry.InvalidRangeError)},
+	}
+}
+
+func productOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/products/", Tag: "products", Summary: "List products", Response: []db.Product{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/products/:id", Tag: "products", Summary: "Get a product", Response: db.Product{},
+			Errors: openapi.Errors(productErrorHandler, products.ErrProductNotFoundError)},
+		{Method: "GET", Path: "/api/v1/products/reportrecords", Tag: "products", Summary: "Count records by product", Response: []db.ProductReportRecords{},
+			Query: reportQuery("product id, all products when omitted"), Errors: reportErrors(productErrorHandler, products.ErrProductNotFoundError)},
+		{Method: "POST", Path: "/api/v1/products/", Tag: "products", Summary: "Create a product (sellers only for themselves)", Request: CreateProductRequest{}, Response: db.Product{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(productErrorHandler, products.ErrExistsProductCodeError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/products/:id", Tag: "products", Summary: "Update a product (sellers only their own)", Request: UpdateProductRequest{}, Response: db.Product{},
+			Errors: openapi.Errors(productErrorHandler, products.ErrProductNotFoundError, products.ErrExistsProductCodeError).
+				With(http.StatusBadRequest, "product id binding error").With(http.StatusForbidden, auth.ErrForbidden.Error())},
+		{Method: "DELETE", Path: "/api/v1/products/:id", Tag: "products", Summary: "Delete a product (sellers only their own)", Status: http.StatusNoContent,
+			Errors: openapi.Errors(productErrorHandler, products.ErrProductNotFoundError)},
+	}
+}
+
+func buyerOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/buyers/", Tag: "buyers", Summary: "List buyers", Response: []db.Buyer{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/buyers/:id", Tag: "buyers", Summary: "Get a buyer", Response: db.Buyer{},
+			Errors: openapi.Errors(buyerErrorHandler, buyers.BuyerNotFoundError)},
+		{Method: "GET", Path: "/api/v1/buyers/reportPurchaseOrders", Tag: "buyers", Summary: "Count purchase orders by buyer", Response: []db.CountBuyer{},
+			Query: reportQuery("buyer id, all buyers when omitted"), Errors: reportErrors(buyerErrorHandler, buyers.BuyerNotFoundError)},
+		{Method: "POST", Path: "/api/v1/buyers/", Tag: "buyers", Summary: "Create a buyer", Request: createBuyersRequest{}, Response: db.Buyer{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(buyerErrorHandler, buyers.ExistsBuyerCardNumberIdError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/buyers/:id", Tag: "buyers", Summary: "Update a buyer", Request: updateBuyersRequest{}, Response: db.Buyer{},
+			Errors: openapi.Errors(buyerErrorHandler, buyers.BuyerNotFoundError, buyers.ExistsBuyerCardNumberIdError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "DELETE", Path: "/api/v1/buyers/:id", Tag: "buyers", Summary: "Delete a buyer", Status: http.StatusNoContent,
+			Errors: openapi.Errors(buyerErrorHandler, buyers.BuyerNotFoundError)},
+	}
+}
+
+func employeeOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/employees/", Tag: "employees", Summary: "List employees", Response: []db.Employee{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/employees/:id", Tag: "employees", Summary: "Get an employee", Response: db.Employee{},
+			Errors: openapi.Errors(employeeErrorHandler, employees.EmployeeNotFoundError)},
+		{Method: "GET", Path: "/api/v1/employees/reportInboundOrders", Tag: "employees", Summary: "Count inbound orders by employee", Response: []db.ReportInboundOrders{},
+			Query: reportQuery("employee id, all employees when omitted"), Errors: reportErrors(employeeErrorHandler, employees.EmployeeNotFoundError)},
+		{Method: "POST", Path: "/api/v1/employees/", Tag: "employees", Summary: "Create an employee", Request: CreateEmployeeRequest{}, Response: db.Employee{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(employeeErrorHandler, employees.ExistsCardNumberIdError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/employees/:id", Tag: "employees", Summary: "Update an employee", Request: UpdateEmployeeRequest{}, Response: db.Employee{},
+			Errors: openapi.Errors(employeeErrorHandler, employees.EmployeeNotFoundError, employees.ExistsCardNumberIdError).With(http.StatusBadRequest, "employee id binding error")},
+		{Method: "DELETE", Path: "/api/v1/employees/:id", Tag: "employees", Summary: "Delete an employee", Status: http.StatusNoContent,
+			Errors: openapi.Errors(employeeErrorHandler, employees.EmployeeNotFoundError)},
+	}
+}
+
+func inboundOrderOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/inboundOrders/", Tag: "inboundOrders", Summary: "Receive a product batch in a warehouse", Request: createInboundOrdersRequest{}, Response: db.InboundOrder{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(inboundOrderErrorHandler,
+				inboundorders.EmployeeNotFoundError, inboundorders.WarehouseNotFoundError, inboundorders.ProductBatchNotFoundError, sections.ErrSectionCapacityExceededError,
+				inboundorders.WarehouseMismatchError, sections.ErrSectionNotFoundError,
+			).With(http.StatusUnprocessableEntity, invalidBody)},
+	}
+}
+
+func productTypeOperations() []openapi.Operation {
+	handler := withoutContext(productTypeErrorHandler)
+	badId := "id in wrong format"
+
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/productTypes/", Tag: "productTypes", Summary: "List product types", Response: []db.ProductType{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/productTypes/:id", Tag: "productTypes", Summary: "Get a product type", Response: db.ProductType{},
+			Errors: openapi.Errors(handler, productTypes.ProductTypeNotFoundError).With(http.StatusBadRequest, badId)},
+		{Method: "POST", Path: "/api/v1/productTypes/", Tag: "productTypes", Summary: "Create a product type", Request: createProductTypeRequest{}, Response: db.ProductType{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(handler).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/productTypes/:id", Tag: "productTypes", Summary: "Update a product type", Request: updateProductTypeRequest{}, Response: db.ProductType{},
+			Errors: openapi.Errors(handler, productTypes.ProductTypeNotFoundError).With(http.StatusBadRequest, badId).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "DELETE", Path: "/api/v1/productTypes/:id", Tag: "productTypes", Summary: "Delete a product type without products or sections", Status: http.StatusNoContent,
+			Errors: openapi.Errors(handler, productTypes.ProductTypeNotFoundError, productTypes.ProductTypeInUseError).With(http.StatusBadRequest, badId)},
+	}
+}
+
+func localityOperations() []openapi.Operation {
+	handler := withoutContext(localityErrorHandler)
+
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/localities/", Tag: "localities", Summary: "Create a locality", Request: createLocalityRequest{}, Response: db.Locality{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(handler, localities.ExistsLocalityId, localities.ExistsProvinceIdError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "GET", Path: "/api/v1/localities/reportSellers", Tag: "localities", Summary: "Count sellers by locality", Response: []localities.LocalityInfo{},
+			Query: reportQuery("locality id, all localities when omitted"), Errors: reportErrors(handler, localities.LocalityNotFoundError)},
+		{Method: "GET", Path: "/api/v1/localities/reportGeography", Tag: "localities", Summary: "Count sellers, carriers, warehouses and employees by country, province or locality", Response: []localities.GeoReport{},
+			Query: append(reportQuery("locality id"),
+				openapi.Parameter{Name: "country_id", In: "query", Description: "country id", Schema: &openapi.Schema{Type: "integer"}},
+				openapi.Parameter{Name: "province_id", In: "query", Description: "province id", Schema: &openapi.Schema{Type: "integer"}},
+				openapi.Parameter{Name: "level", In: "query", Description: "country, province or locality; one level below the most specific filter when omitted", Schema: &openapi.Schema{Type: "string"}},
+			),
+			Errors: reportErrors(handler, localities.InvalidGeoLevelError, localities.CountryNotFoundError, localities.ProvinceNotFoundError, localities.LocalityNotFoundError).
+				With(http.StatusBadRequest, "country_id or province_id in wrong format")},
+	}
+}
+
+func carrierOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/carries/", Tag: "carries", Summary: "List carriers", Response: []db.Carrier{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/carries/:id", Tag: "carries", Summary: "Get a carrier", Response: db.Carrier{},
+			Errors: openapi.Errors(carrierErrorHandler, carries.CarrierNotFoundError).With(http.StatusBadRequest, "carrier id binding error")},
+		{Method: "PATCH", Path: "/api/v1/carries/:id", Tag: "carries", Summary: "Update a carrier", Request: updateCarrierRequest{}, Response: db.Carrier{},
+			Errors: openapi.Errors(carrierErrorHandler, carries.CarrierNotFoundError, carries.ExistsCarrierCidError, carries.LocalityIdNotExistsError).
+				With(http.StatusBadRequest, "carrier id binding error").With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "DELETE", Path: "/api/v1/carries/:id", Tag: "carries", Summary: "Delete a carrier without shipments", Status: http.StatusNoContent,
+			Errors: openapi.Errors(carrierErrorHandler, carries.CarrierNotFoundError, carries.CarrierHasShipmentsError).With(http.StatusBadRequest, "carrier id binding error")},
+		{Method: "POST", Path: "/api/v1/carries/", Tag: "carries", Summary: "Create a carrier", Request: createCarrierRequest{}, Response: db.Carrier{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(carrierErrorHandler, carries.ExistsCarrierCidError, carries.LocalityIdNotExistsError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "GET", Path: "/api/v1/carries/reportCarries", Tag: "carries", Summary: "Count carriers by locality", Response: []carries.CarrierInfo{},
+			Query: reportQuery("locality id, all localities when omitted"), Errors: reportErrors(carrierErrorHandler, carries.CarrierNotFoundError)},
+	}
+}
+
+func shipmentOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/shipments", Tag: "shipments", Summary: "Ship an approved purchase order, moving it to in transit and assigning a carrier near the locality or the warehouse when none is given", Request: CreateShipmentRequest{}, Response: db.Shipment{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(shipmentErrorHandler,
+				shipments.PurchaseOrderNotFoundError, shipments.WarehouseNotFoundError, shipments.CarrierNotFoundError, shipments.LocalityNotFoundError,
+				shipments.AlreadyShippedError, shipments.NoCarrierAvailableError, shipments.MissingTrackingCodeError, shipments.TrackingCodeInUseError,
+				shipments.NotApprovedError,
+			).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "GET", Path: "/api/v1/shipments", Tag: "shipments", Summary: "List the shipments", Response: []db.Shipment{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/shipments/:id", Tag: "shipments", Summary: "Get a shipment", Response: db.Shipment{},
+			Errors: openapi.Errors(shipmentErrorHandler, shipments.ShipmentNotFoundError).With(http.StatusBadRequest, "shipment id binding error")},
+		{Method: "POST", Path: "/api/v1/tracking/:trackingCode/events", Tag: "shipments", Summary: "Record a carrier scan of a shipment; a delivered scan delivers the purchase order (admin and carriers only)", Request: CreateTrackingEventRequest{}, Response: db.TrackingEvent{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(shipmentErrorHandler, shipments.ShipmentNotFoundError, shipments.ShipmentClosedError, shipments.ForeignShipmentError, shipments.UnknownEventTypeError, shipments.InvalidOccurredAtError).
+				With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "GET", Path: "/api/v1/purchaseOrders/:id/tracking", Tag: "shipments", Summary: "Shipment of a purchase order and its scans in the order they happened", Response: db.TrackingTimeline{},
+			Errors: openapi.Errors(shipmentErrorHandler, purchaseOrders.PurchaseOrderNotFoundError, shipments.ShipmentNotFoundError).With(http.StatusBadRequest, "purchase order id binding error")},
+	}
+}
+
+func productBatchOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/productBatches/", Tag: "productBatches", Summary: "Create a product batch", Request: CreateProductBatchRequest{}, Response: db.ProductBatch{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(productBatchErrorHandler, batches.ProductNotFoundError, batches.SectionNotFoundError, batches.ExistsBatchNumberError,
+				batches.ProductTypeMismatchError, batches.TemperatureMismatchError, sections.ErrSectionCapacityExceededError, batches.InvalidDueDateError,
+			).With(http.StatusUnprocessableEntity, invalidBody)},
+	}
+}
+
+func expiryOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/productBatches/expiring", Tag: "productBatches", Summary: "List the batches about to expire, by warehouse and section", Response: []db.ExpiringWarehouse{},
+			Query: []openapi.Parameter{
+				{Name: "within", In: "query", Description: "duration from now, e.g. 72h; the alert threshold when omitted", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "warehouse_id", In: "query", Description: "warehouse id, all warehouses when omitted", Schema: &openapi.Schema{Type: "integer"}},
+			},
+			Errors: openapi.Errors(expiryErrorHandler, expiry.InvalidWithinError)},
+		{Method: "GET", Path: "/api/v1/productBatches/expiryAlerts", Tag: "productBatches", Summary: "List the alerts of batches that entered the expiry threshold", Response: []db.ExpiryAlert{}, Paginated: true, Errors: listErrors()},
+	}
+}
+
+func pickingOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/pickings", Tag: "pickings", Summary: "Pick a product from stock, first expired first out", Request: CreatePickingRequest{}, Response: db.PickList{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(pickingErrorHandler, picking.ProductNotFoundError, picking.InsufficientStockError, batches.InsufficientQuantityError, sections.ErrSectionCapacityUnderflowError).With(http.StatusUnprocessableEntity, invalidBody)},
+	}
+}
+
+func transferOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/transfers", Tag: "transfers", Summary: "Move all or part of a product batch to another section", Request: CreateTransferRequest{}, Response: db.StockTransfer{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(transferErrorHandler,
+				transfers.ProductBatchNotFoundError, transfers.SectionNotFoundError, transfers.SameSectionError, transfers.ProductTypeMismatchError, transfers.TemperatureMismatchError,
+				batches.InsufficientQuantityError, sections.ErrSectionCapacityExceededError, sections.ErrSectionCapacityUnderflowError,
+			).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "GET", Path: "/api/v1/transfers", Tag: "transfers", Summary: "List the stock transfers", Response: []db.StockTransfer{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/transfers/:id", Tag: "transfers", Summary: "Get a stock transfer", Response: db.StockTransfer{},
+			Errors: openapi.Errors(transferErrorHandler, transfers.TransferNotFoundError).With(http.StatusBadRequest, "transfer id binding error")},
+	}
+}
+
+func stockOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/stock", Tag: "stock", Summary: "Rebuild the stock by product and warehouse at a moment from the ledger", Response: []db.StockLevel{},
+			Query: []openapi.Parameter{
+				{Name: "product_id", In: "query", Description: "product id, all products when omitted", Schema: &openapi.Schema{Type: "integer"}},
+				{Name: "warehouse_id", In: "query", Description: "warehouse id, all warehouses when omitted", Schema: &openapi.Schema{Type: "integer"}},
+				{Name: "at", In: "query", Description: "RFC 3339 date time, e.g. 2022-06-01T00:00:00Z; now when omitted", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+			},
+			Errors: openapi.Errors(stockErrorHandler, stock.InvalidAtError)},
+		{Method: "GET", Path: "/api/v1/stock/movements", Tag: "stock", Summary: "List the inventory movements ledger", Response: []db.InventoryMovement{}, Paginated: true, Errors: listErrors()},
+		{Method: "POST", Path: "/api/v1/stock/adjustments", Tag: "stock", Summary: "Adjust or write off the stock of a product batch", Request: CreateAdjustmentRequest{}, Response: db.InventoryMovement{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(stockErrorHandler,
+				stock.InvalidMovementTypeError, stock.InvalidQuantityError, stock.InvalidWriteOffError, stock.ProductBatchNotFoundError,
+				batches.InsufficientQuantityError, sections.ErrSectionCapacityExceededError, sections.ErrSectionCapacityUnderflowError,
+			).With(http.StatusUnprocessableEntity, invalidBody)},
+	}
+}
+
+func importOperations() []openapi.Operation {
+	dryRun := []openapi.Parameter{
+		{Name: "dry_run", In: "query", Description: "validate every row and roll back, false by default", Schema: &openapi.Schema{Type: "boolean"}},
+	}
+	errs := func() openapi.ErrorResponses {
+		return openapi.Errors(importErrorHandler, imports.InvalidRowsError).With(http.StatusBadRequest, "dry_run must be true or false")
+	}
+
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/products/import", Tag: "imports", Summary: "Import products from a CSV body or file field, all or nothing", Response: db.ImportReport{}, Status: http.StatusCreated, Query: dryRun, Errors: errs()},
+		{Method: "POST", Path: "/api/v1/sellers/import", Tag: "imports", Summary: "Import sellers from a CSV body or file field, all or nothing", Response: db.ImportReport{}, Status: http.StatusCreated, Query: dryRun, Errors: errs()},
+		{Method: "POST", Path: "/api/v1/warehouses/import", Tag: "imports", Summary: "Import warehouses from a CSV body or file field, all or nothing", Response: db.ImportReport{}, Status: http.StatusCreated, Query: dryRun, Errors: errs()},
+	}
+}
+
+func recallOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/productBatches/:id/trace", Tag: "recalls", Summary: "Trace a product batch back to its seller and forward to its buyers", Response: db.BatchTrace{},
+			Errors: openapi.Errors(recallErrorHandler, recalls.ProductBatchNotFoundError).With(http.StatusBadRequest, "product batch id binding error")},
+		{Method: "POST", Path: "/api/v1/productBatches/:id/recall", Tag: "recalls", Summary: "Recall a product batch, blocking it from picking", Request: CreateRecallRequest{}, Response: db.RecallReport{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(recallErrorHandler, recalls.ProductBatchNotFoundError, recalls.AlreadyRecalledError).
+				With(http.StatusBadRequest, "product batch id binding error").With(http.StatusUnprocessableEntity, invalidBody).With(http.StatusForbidden, auth.ErrForbidden.Error())},
+	}
+}
+
+func analyticsOperations() []openapi.Operation {
+	query := []openapi.Parameter{
+		{Name: "group_by", In: "query", Description: "seller, product_type, locality or month; seller when omitted", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "from", In: "query", Description: "first order day, e.g. 2022-01-01", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "to", In: "query", Description: "last order day, included", Schema: &openapi.Schema{Type: "string"}},
+		{Name: "format", In: "query", Description: "json, csv or xlsx, otherwise taken from the Accept header", Schema: &openapi.Schema{Type: "string"}},
+	}
+	errs := reportErrors(analyticsErrorHandler, analytics.InvalidGroupByError, analytics.InvalidDateError, analytics.InvalidRangeError)
+
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/analytics/revenue", Tag: "analytics", Summary: "Revenue of the sold purchase orders, in cents, per group", Response: []db.RevenueReport{}, Query: query, Errors: errs},
+		{Method: "GET", Path: "/api/v1/analytics/margins", Tag: "analytics", Summary: "Revenue, purchase cost and margin of the sold purchase orders, in cents, per group", Response: []db.MarginReport{}, Query: query, Errors: errs},
+	}
+}
+
+func productRecordOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/productRecords/", Tag: "productRecords", Summary: "Record the prices of a product (sellers only record prices of their own products)", Request: CreateProductRecordsRequest{}, Response: db.ProductRecord{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(productRecordsErrorHandler, productrecords.ErrProductNotFoundError, productrecords.ErrSalePriceBelowPurchaseError).
+				With(http.StatusUnprocessableEntity, invalidBody).With(http.StatusForbidden, auth.ErrForbidden.Error())},
+		{Method: "GET", Path: "/api/v1/products/:id/prices", Tag: "productRecords", Summary: "List the price history of a product, oldest first", Response: []db.ProductRecord{},
+			Errors: openapi.Errors(productPricesErrorHandler, productrecords.ErrProductNotFoundError).With(http.StatusBadRequest, "product id binding error")},
+		{Method: "GET", Path: "/api/v1/products/:id/prices/current", Tag: "productRecords", Summary: "Get the price of a product at the end of a day", Response: db.ProductRecord{},
+			Query: []openapi.Parameter{
+				{Name: "date", In: "query", Description: "YYYY-MM-DD, today when omitted", Schema: &openapi.Schema{Type: "string", Format: "date"}},
+			},
+			Errors: openapi.Errors(productPricesErrorHandler, productrecords.ErrProductNotFoundError, productrecords.ErrPriceNotFoundError, productrecords.ErrInvalidDateError).
+				With(http.StatusBadRequest, "product id binding error")},
+	}
+}
+
+func purchaseOrderOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "POST", Path: "/api/v1/purchaseOrders", Tag: "purchaseOrders", Summary: "Create a purchase order", Request: CreatePurchaseOrderRequest{}, Response: db.PurchaseOrder{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(purchaseOrderErrorHandler, purchaseOrders.ExistsIdError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "POST", Path: "/api/v1/purchaseOrders/:id/transitions", Tag: "purchaseOrders", Summary: "Move a purchase order to its next status", Request: TransitionPurchaseOrderRequest{}, Response: db.PurchaseOrder{},
+			Errors: openapi.Errors(purchaseOrderErrorHandler,
+				purchaseOrders.PurchaseOrderNotFoundError, purchaseOrders.UnknownTransitionActionError, purchaseOrders.IllegalTransitionError,
+			).With(http.StatusBadRequest, "purchase order id binding error")},
+		{Method: "GET", Path: "/api/v1/purchaseOrders/:id/transitions", Tag: "purchaseOrders", Summary: "List the status history of a purchase order", Response: []db.PurchaseOrderStatusHistory{},
+			Errors: openapi.Errors(purchaseOrderErrorHandler, purchaseOrders.PurchaseOrderNotFoundError).With(http.StatusBadRequest, "purchase order id binding error")},
+		{Method: "GET", Path: "/api/v1/purchaseOrders/:id/details", Tag: "purchaseOrders", Summary: "List the details of a purchase order", Response: []db.OrderDetails{},
+			Errors: openapi.Errors(orderDetailsErrorHandler, orderdetails.PurchaseOrderNotFoundError).With(http.StatusBadRequest, "purchase order id binding error")},
+	}
+}
+
+func orderDetailsOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/orderDetails/", Tag: "orderDetails", Summary: "List order details", Response: []db.OrderDetails{}, Paginated: true, Errors: listErrors()},
+		{Method: "GET", Path: "/api/v1/orderDetails/:id", Tag: "orderDetails", Summary: "Get an order detail", Response: db.OrderDetails{},
+			Errors: openapi.Errors(orderDetailsErrorHandler, orderdetails.OrderDetailsNotFoundError).With(http.StatusBadRequest, "order details id binding error")},
+		{Method: "POST", Path: "/api/v1/orderDetails/", Tag: "orderDetails", Summary: "Create an order detail", Request: CreateOrderDetailsRequest{}, Response: db.OrderDetails{}, Status: http.StatusCreated,
+			Errors: openapi.Errors(orderDetailsErrorHandler, orderdetails.ProductRecordNotFoundError, orderdetails.PurchaseOrderNotFoundError).With(http.StatusUnprocessableEntity, invalidBody)},
+		{Method: "PATCH", Path: "/api/v1/orderDetails/:id", Tag: "orderDetails", Summary: "Update an order detail", Request: UpdateOrderDetailsRequest{}, Response: db.OrderDetails{},
+			Errors: openapi.Errors(orderDetailsErrorHandler, orderdetails.OrderDetailsNotFoundError, orderdetails.ProductRecordNotFoundError, orderdetails.PurchaseOrderNotFoundError).
+				With(http.StatusBadRequest, "order details id binding error")},
+		{Method: "DELETE", Path: "/api/v1/orderDetails/:id", Tag: "orderDetails", Summary: "Delete an order detail", Status: http.StatusNoContent,
+			Errors: openapi.Errors(orderDetailsErrorHandler, orderdetails.OrderDetailsNotFoundError).With(http.StatusBadRequest, "order details id binding error")},
+	}
+}
+
+func auditOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{Method: "GET", Path: "/api/v1/audit", Tag: "audit", Summary: "List audit events (admin only)", Response: []db.AuditEvent{}, Paginated: true, Errors: listErrors(),
+			Query: []openapi.Parameter{
+				{Name: "entity", In: "query", Description: "entity type, e.g. products", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "id", In: "query", Description: "entity id", Schema: &openapi.Schema{Type: "string"}},
+			}},
+	}
+}
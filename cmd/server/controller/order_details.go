@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	orderdetails "github.com/GuiTadeu/mercado-fresh-panic/internal/orderDetails"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateOrderDetailsRequest struct {
+	CleanLinessStatus string  `json:"clean_liness_status" binding:"required"`
+	Quantity          uint64  `json:"quantity" binding:"required"`
+	Temperature       float32 `json:"temperature" binding:"required"`
+	ProductRecordId   uint64  `json:"product_record_id" binding:"required"`
+	PurchaseOrderId   uint64  `json:"purchase_order_id" binding:"required"`
+}
+
+type UpdateOrderDetailsRequest struct {
+	CleanLinessStatus string  `json:"clean_liness_status"`
+	Quantity          uint64  `json:"quantity"`
+	Temperature       float32 `json:"temperature"`
+	ProductRecordId   uint64  `json:"product_record_id"`
+	PurchaseOrderId   uint64  `json:"purchase_order_id"`
+}
+
+type orderDetailsController struct {
+	orderDetailsService orderdetails.OrderDetailsService
+}
+
+func NewOrderDetailsController(s orderdetails.OrderDetailsService) *orderDetailsController {
+	return &orderDetailsController{
+		orderDetailsService: s,
+	}
+}
+
+func (c *orderDetailsController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		var request CreateOrderDetailsRequest
+
+		err := ctx.ShouldBindJSON(&request)
+		if err != nil {
+			ctx.JSON(
+				http.StatusUnprocessableEntity,
+				web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()),
+			)
+			return
+		}
+
+		orderDetails, err := c.orderDetailsService.Create(
+			request.CleanLinessStatus,
+			request.Quantity,
+			request.Temperature,
+			request.ProductRecordId,
+			request.PurchaseOrderId,
+		)
+
+		if err != nil {
+			status := orderDetailsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, orderDetails, ""))
+	}
+}
+
+func (c *orderDetailsController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		page, err := c.orderDetailsService.GetAll(params)
+		if err != nil {
+			status := orderDetailsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, page.Items, page.Meta))
+	}
+}
+
+func (c *orderDetailsController) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(
+				http.StatusBadRequest,
+				web.NewResponse(http.StatusBadRequest, nil, "order details id binding error"),
+			)
+			return
+		}
+
+		orderDetails, err := c.orderDetailsService.Get(id)
+		if err != nil {
+			status := orderDetailsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, orderDetails, ""))
+	}
+}
+
+func (c *orderDetailsController) GetByPurchaseOrderId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		purchaseOrderId, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(
+				http.StatusBadRequest,
+				web.NewResponse(http.StatusBadRequest, nil, "purchase order id binding error"),
+			)
+			return
+		}
+
+		orderDetails, err := c.orderDetailsService.GetByPurchaseOrderId(purchaseOrderId)
+		if err != nil {
+			status := orderDetailsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, orderDetails, ""))
+	}
+}
+
+func (c *orderDetailsController) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		var request UpdateOrderDetailsRequest
+
+		err := ctx.ShouldBindJSON(&request)
+		if err != nil {
+			ctx.JSON(
+				http.StatusUnprocessableEntity,
+				web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()),
+			)
+			return
+		}
+
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(
+				http.StatusBadRequest,
+				web.NewResponse(http.StatusBadRequest, nil, "order details id binding error"),
+			)
+			return
+		}
+
+		orderDetails, err := c.orderDetailsService.Update(
+			id,
+			request.CleanLinessStatus,
+			request.Quantity,
+			request.Temperature,
+			request.ProductRecordId,
+			request.PurchaseOrderId,
+		)
+
+		if err != nil {
+			status := orderDetailsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, orderDetails, ""))
+	}
+}
+
+func (c *orderDetailsController) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(
+				http.StatusBadRequest,
+				web.NewResponse(http.StatusBadRequest, nil, "order details id binding error"),
+			)
+			return
+		}
+
+		err = c.orderDetailsService.Delete(id)
+		if err != nil {
+			status := orderDetailsErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, web.NewResponse(http.StatusNoContent, nil, ""))
+	}
+}
+
+func orderDetailsErrorHandler(err error) int {
+	switch err {
+
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
+	case orderdetails.OrderDetailsNotFoundError:
+		return http.StatusNotFound
+
+	case orderdetails.ProductRecordNotFoundError:
+		return http.StatusConflict
+
+	case orderdetails.PurchaseOrderNotFoundError:
+		return http.StatusConflict
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockOrderDetailsService struct {
+	result any
+	err    error
+}
+
+func (m mockOrderDetailsService) Create(
+	cleanLinessStatus string, quantity uint64, temperature float32, productRecordId uint64, purchaseOrderId uint64,
+) (db.OrderDetails, error) {
+	if m.err != nil {
+		return db.OrderDetails{}, m.err
+	}
+	return m.result.(db.OrderDetails), nil
+}
+
+func (m mockOrderDetailsService) Get(id uint64) (db.OrderDetails, error) {
+	if m.err != nil {
+		return db.OrderDetails{}, m.err
+	}
+	return m.result.(db.OrderDetails), nil
+}
+
+func (m mockOrderDetailsService) GetAll(params query.Params) (query.Page[db.OrderDetails], error) {
+	if m.err != nil {
+		return query.Page[db.OrderDetails]{}, m.err
+	}
+	return query.NewPage(m.result.([]db.OrderDetails), params)
+}
+
+func (m mockOrderDetailsService) GetByPurchaseOrderId(purchaseOrderId uint64) ([]db.OrderDetails, error) {
+	if m.err != nil {
+		return []db.OrderDetails{}, m.err
+	}
+	return m.result.([]db.OrderDetails), nil
+}
+
+func (m mockOrderDetailsService) Update(
+	id uint64, cleanLinessStatus string, quantity uint64, temperature float32,
+	productRecordId uint64, purchaseOrderId uint64,
+) (db.OrderDetails, error) {
+	if m.err != nil {
+		return db.OrderDetails{}, m.err
+	}
+	return m.result.(db.OrderDetails), nil
+}
+
+func (m mockOrderDetailsService) Delete(id uint64) error {
+	return m.err
+}
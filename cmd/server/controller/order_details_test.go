@@ -0,0 +1,268 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	orderdetails "github.com/GuiTadeu/mercado-fresh-panic/internal/orderDetails"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderDetails_Create_201(t *testing.T) {
+
+	validOrderDetails := db.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Aprovado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   1,
+		PurchaseOrderId:   1,
+	}
+
+	jsonValue, _ := json.Marshal(validOrderDetails)
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockOrderDetailsService{
+		result: validOrderDetails,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/orderDetails/", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := db.OrderDetails{}
+	decodeOrderDetailsWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, validOrderDetails, responseData)
+}
+
+func Test_OrderDetails_Create_422(t *testing.T) {
+
+	jsonValue, _ := json.Marshal(db.OrderDetails{})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	router := setupOrderDetailsRouter(mockOrderDetailsService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/orderDetails/", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_OrderDetails_Create_409(t *testing.T) {
+
+	validOrderDetails := db.OrderDetails{
+		CleanLinessStatus: "Aprovado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   99,
+		PurchaseOrderId:   1,
+	}
+
+	jsonValue, _ := json.Marshal(validOrderDetails)
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockOrderDetailsService{
+		err: orderdetails.ProductRecordNotFoundError,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/orderDetails/", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func Test_OrderDetails_Get_404(t *testing.T) {
+
+	mockService := mockOrderDetailsService{
+		err: orderdetails.OrderDetailsNotFoundError,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/orderDetails/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_OrderDetails_GetAll_200(t *testing.T) {
+
+	expectedOrderDetails := []db.OrderDetails{
+		{Id: 1, CleanLinessStatus: "Aprovado", Quantity: 10, ProductRecordId: 1, PurchaseOrderId: 1},
+		{Id: 2, CleanLinessStatus: "Rejeitado", Quantity: 20, ProductRecordId: 2, PurchaseOrderId: 1},
+	}
+
+	mockService := mockOrderDetailsService{
+		result: expectedOrderDetails,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/orderDetails/", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.OrderDetails{}
+	decodeOrderDetailsWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedOrderDetails, responseData)
+}
+
+func Test_OrderDetails_GetAll_400_InvalidLimit(t *testing.T) {
+
+	router := setupOrderDetailsRouter(mockOrderDetailsService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/orderDetails/?limit=abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_OrderDetails_GetAll_400_InvalidFilter(t *testing.T) {
+
+	router := setupOrderDetailsRouter(mockOrderDetailsService{err: query.ErrInvalidFilter})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/orderDetails/?unknown=1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_OrderDetails_GetByPurchaseOrderId_200(t *testing.T) {
+
+	expectedOrderDetails := []db.OrderDetails{
+		{Id: 1, CleanLinessStatus: "Aprovado", Quantity: 10, ProductRecordId: 1, PurchaseOrderId: 2},
+	}
+
+	mockService := mockOrderDetailsService{
+		result: expectedOrderDetails,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/purchaseOrders/2/details", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.OrderDetails{}
+	decodeOrderDetailsWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedOrderDetails, responseData)
+}
+
+func Test_OrderDetails_GetByPurchaseOrderId_409(t *testing.T) {
+
+	mockService := mockOrderDetailsService{
+		err: orderdetails.PurchaseOrderNotFoundError,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/purchaseOrders/2/details", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func Test_OrderDetails_Update_200(t *testing.T) {
+
+	updatedOrderDetails := db.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Rejeitado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   1,
+		PurchaseOrderId:   1,
+	}
+
+	jsonValue, _ := json.Marshal(UpdateOrderDetailsRequest{CleanLinessStatus: "Rejeitado"})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockOrderDetailsService{
+		result: updatedOrderDetails,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/orderDetails/1", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := db.OrderDetails{}
+	decodeOrderDetailsWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, updatedOrderDetails, responseData)
+}
+
+func Test_OrderDetails_Delete_204(t *testing.T) {
+
+	router := setupOrderDetailsRouter(mockOrderDetailsService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/orderDetails/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func Test_OrderDetails_Delete_404(t *testing.T) {
+
+	mockService := mockOrderDetailsService{
+		err: orderdetails.OrderDetailsNotFoundError,
+	}
+
+	router := setupOrderDetailsRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/orderDetails/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func decodeOrderDetailsWebResponse(response *httptest.ResponseRecorder, responseData any) {
+	responseStruct := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseStruct)
+
+	jsonData, _ := json.Marshal(responseStruct.Data)
+	json.Unmarshal(jsonData, &responseData)
+}
+
+func setupOrderDetailsRouter(mockService mockOrderDetailsService) *gin.Engine {
+	controller := NewOrderDetailsController(mockService)
+
+	router := gin.Default()
+
+	orderDetailsRoutes := router.Group("/api/v1/orderDetails")
+	orderDetailsRoutes.GET("/", controller.GetAll())
+	orderDetailsRoutes.GET("/:id", controller.Get())
+	orderDetailsRoutes.POST("/", controller.Create())
+	orderDetailsRoutes.PATCH("/:id", controller.Update())
+	orderDetailsRoutes.DELETE("/:id", controller.Delete())
+
+	router.GET("/api/v1/purchaseOrders/:id/details", controller.GetByPurchaseOrderId())
+
+	return router
+}
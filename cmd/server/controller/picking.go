@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/picking"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type CreatePickingRequest struct {
+	ProductId   uint64 `json:"product_id" binding:"required"`
+	WarehouseId uint64 `json:"warehouse_id"`
+	Quantity    uint64 `json:"quantity" binding:"required"`
+}
+
+type pickingController struct {
+	pickingService picking.PickingService
+}
+
+func NewPickingController(s picking.PickingService) *pickingController {
+	return &pickingController{
+		pickingService: s,
+	}
+}
+
+func (c pickingController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req CreatePickingRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		pickList, err := c.pickingService.Pick(req.ProductId, req.WarehouseId, req.Quantity)
+		if err != nil {
+			status := pickingErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, pickList, ""))
+	}
+}
+
+func pickingErrorHandler(err error) int {
+	switch err {
+	case picking.ProductNotFoundError:
+		return http.StatusConflict
+	case picking.InsufficientStockError:
+		return http.StatusConflict
+	case batches.InsufficientQuantityError, sections.ErrSectionCapacityUnderflowError:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,14 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type mockPickingService struct {
+	result db.PickList
+	err    error
+}
+
+func (m mockPickingService) Pick(productId uint64, warehouseId uint64, quantity uint64) (db.PickList, error) {
+	return m.result, m.err
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/picking"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Picking_Create_201(t *testing.T) {
+
+	expectedPickList := db.PickList{
+		ProductId: 1,
+		Quantity:  12,
+		Picks: []db.Pick{
+			{ProductBatchId: 3, BatchNumber: 30, DueDate: "2022-08-10 10:00:00", SectionId: 2, WarehouseId: 2, Quantity: 5},
+			{ProductBatchId: 2, BatchNumber: 20, DueDate: "2022-09-01 10:00:00", SectionId: 1, WarehouseId: 1, Quantity: 7},
+		},
+	}
+
+	router := setupPickingRouter(mockPickingService{result: expectedPickList})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/pickings", pickingRequestBody(CreatePickingRequest{ProductId: 1, Quantity: 12}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.PickList{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, expectedPickList, responseData)
+}
+
+func Test_Picking_Create_422(t *testing.T) {
+
+	router := setupPickingRouter(mockPickingService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/pickings", pickingRequestBody(CreatePickingRequest{ProductId: 1}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_Picking_Create_409(t *testing.T) {
+
+	expectedError := picking.InsufficientStockError
+
+	router := setupPickingRouter(mockPickingService{err: expectedError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/pickings", pickingRequestBody(CreatePickingRequest{ProductId: 1, Quantity: 12}))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Picking_Create_500(t *testing.T) {
+
+	router := setupPickingRouter(mockPickingService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/pickings", pickingRequestBody(CreatePickingRequest{ProductId: 1, Quantity: 12}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func pickingRequestBody(request CreatePickingRequest) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func setupPickingRouter(mockService mockPickingService) *gin.Engine {
+	controller := NewPickingController(mockService)
+
+	router := gin.Default()
+	router.POST("/api/v1/pickings", controller.Create())
+
+	return router
+}
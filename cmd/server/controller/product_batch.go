@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -73,34 +75,32 @@ func (c *productBatchController) Create() gin.HandlerFunc {
 func (c *productBatchController) CountProductsBySections() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		
-		var report any
-		var err error
-		var sectionId uint64
-
 		sectionIdParam := ctx.Query("id")
 
-		if(sectionIdParam != "") {
-			sectionId, err = strconv.ParseUint(sectionIdParam, 10, 64)
-			report, err = c.productBatchService.CountProductsBySectionId(sectionId)
-		}
-
 		if(sectionIdParam == "") {
-			report, err = c.productBatchService.CountProductsBySections()
+			report.Render(ctx, "products_by_section", c.productBatchService.CountProductsBySections())
+			return
 		}
 
+		sectionId, _ := strconv.ParseUint(sectionIdParam, 10, 64)
+		sectionReport, err := c.productBatchService.CountProductsBySectionId(sectionId)
+
 		if err != nil {
 			status := productErrorHandler(err)
 			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, report, ""))
+		report.RenderOne(ctx, "products_by_section", sectionReport)
 	}
 }
 
 func productBatchErrorHandler(err error) int {
 	switch err {
 
+	case batches.InvalidDueDateError:
+		return http.StatusUnprocessableEntity
+
 	case batches.ProductNotFoundError:
 		return http.StatusConflict
 
@@ -110,6 +110,9 @@ func productBatchErrorHandler(err error) int {
 	case batches.ExistsBatchNumberError:
 		return http.StatusConflict
 
+	case batches.ProductTypeMismatchError, batches.TemperatureMismatchError, sections.ErrSectionCapacityExceededError:
+		return http.StatusConflict
+
 	default:
 		return http.StatusInternalServerError
 	}
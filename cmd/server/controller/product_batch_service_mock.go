@@ -2,6 +2,7 @@ package controller
 
 import (
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type mockProductBatchService struct {
@@ -18,11 +19,11 @@ func (m mockProductBatchService) Create(number uint64, currentQuantity uint64, c
 	return m.result.(models.ProductBatch), nil
 }
 
-func (m mockProductBatchService) CountProductsBySections() ([]models.CountProductsBySectionIdReport, error) {
+func (m mockProductBatchService) CountProductsBySections() report.Rows[models.CountProductsBySectionIdReport] {
 	if m.err != nil {
-		return []models.CountProductsBySectionIdReport{}, m.err
+		return report.Err[models.CountProductsBySectionIdReport](m.err)
 	}
-	return m.result.([]models.CountProductsBySectionIdReport), nil
+	return report.Slice(m.result.([]models.CountProductsBySectionIdReport))
 }
 
 func (m mockProductBatchService) CountProductsBySectionId(sectionId uint64) (models.CountProductsBySectionIdReport, error) {
@@ -9,6 +9,7 @@ import (
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/stretchr/testify/assert"
 
@@ -122,6 +123,54 @@ func Test_Create_Batch_409_NotFound_SectionId(t *testing.T) {
 	assert.Equal(t, expectedError.Error(), responseData.Error)
 }
 
+func Test_Create_Batch_409_ProductTypeMismatch(t *testing.T) {
+	expectedError := batches.ProductTypeMismatchError
+
+	validProductBatch := models.ProductBatch{
+		Number:             666,
+		CurrentQuantity:    666,
+		CurrentTemperature: 666,
+		DueDate:            "2012",
+		InitialQuantity:    666,
+		ManufacturingDate:  "2012",
+		ManufacturingHour:  "16:20",
+		MinimumTemperature: 666,
+		ProductId:          1,
+		SectionId:          1,
+	}
+
+	jsonValue, _ := json.Marshal(validProductBatch)
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	router := setupBatchRouter(mockProductBatchService{err: expectedError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Create_Batch_422_InvalidDueDate(t *testing.T) {
+	expectedError := batches.InvalidDueDateError
+
+	router := setupBatchRouter(mockProductBatchService{err: expectedError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches", bytes.NewBufferString(`{"batch_number": 666, "due_date": "01/08/2022"}`))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
 func Test_CountProductsBySections(t *testing.T) {
 	
 	report := []models.CountProductsBySectionIdReport{
@@ -181,6 +230,52 @@ func Test_CountProductsBySections_With_SectionId(t *testing.T) {
 	assert.Equal(t, report, responseData)
 }
 
+func Test_CountProductsBySections_With_SectionId_CSV(t *testing.T) {
+
+	mockService := mockProductBatchService{
+		result: models.CountProductsBySectionIdReport{SectionId: 1, SectionNumber: 200, ProductsCount: 350},
+	}
+
+	router := setupBatchRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sections/reportProducts?id=1", nil)
+	request.Header.Set("Accept", "text/csv")
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "section_id,section_number,products_count\n1,200,350\n", response.Body.String())
+}
+
+func Test_CountProductsBySections_XLSX(t *testing.T) {
+
+	mockService := mockProductBatchService{
+		result: []models.CountProductsBySectionIdReport{{SectionId: 1, SectionNumber: 200, ProductsCount: 350}},
+	}
+
+	router := setupBatchRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sections/reportProducts?format=xlsx", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, report.XLSXContentType, response.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="products_by_section.xlsx"`, response.Header().Get("Content-Disposition"))
+	assert.True(t, bytes.HasPrefix(response.Body.Bytes(), []byte("PK")))
+}
+
+func Test_CountProductsBySections_400_InvalidFormat(t *testing.T) {
+
+	router := setupBatchRouter(mockProductBatchService{result: []models.CountProductsBySectionIdReport{}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sections/reportProducts?format=pdf", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
 func decodeBatchWebResponse(response *httptest.ResponseRecorder, responseData any) {
 	responseStruct := web.Response{}
 	json.Unmarshal(response.Body.Bytes(), &responseStruct)
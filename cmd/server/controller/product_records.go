@@ -2,7 +2,9 @@ package controller
 
 import (
 	"net/http"
+	"strconv"
 
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
 	productrecords "github.com/GuiTadeu/mercado-fresh-panic/internal/product_records"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
@@ -13,6 +15,7 @@ type CreateProductRecordsRequest struct {
 	PurchasePrice  float32 `json:"purchase_price" binding:"required"`
 	SalePrice      float32 `json:"sale_price" binding:"required"`
 	ProductId      uint64  `json:"product_id" binding:"required"`
+	Promotion      bool    `json:"promotion"`
 }
 
 type productRecordsController struct {
@@ -41,11 +44,16 @@ func (c *productRecordsController) Create() gin.HandlerFunc {
 			return
 		}
 
+		if !c.ownsProduct(ctx, request.ProductId) {
+			return
+		}
+
 		addedProductRecords, err := service.Create(
 			request.LastUpdateDate,
 			request.PurchasePrice,
 			request.SalePrice,
 			request.ProductId,
+			request.Promotion,
 		)
 
 		if err != nil {
@@ -58,12 +66,90 @@ func (c *productRecordsController) Create() gin.HandlerFunc {
 	}
 }
 
+// ownsProduct stops sellers from pricing products of other sellers. It
+// writes the error response and returns false when the request must stop.
+func (c *productRecordsController) ownsProduct(ctx *gin.Context, productId uint64) bool {
+	sellerId, scoped := auth.SellerScope(ctx)
+	if !scoped {
+		return true
+	}
+
+	product, err := c.productRecordsService.GetProduct(productId)
+	if err != nil {
+		status := productRecordsErrorHandler(err)
+		ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+		return false
+	}
+
+	if product.SellerId != sellerId {
+		ctx.JSON(http.StatusForbidden, web.NewResponse(http.StatusForbidden, nil, auth.ErrForbidden.Error()))
+		return false
+	}
+
+	return true
+}
+
+func (c *productRecordsController) GetPrices() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		prices, err := c.productRecordsService.GetPrices(id)
+		if err != nil {
+			status := productPricesErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, prices, ""))
+	}
+}
+
+func (c *productRecordsController) GetPriceAt() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		price, err := c.productRecordsService.GetPriceAt(id, ctx.Query("date"))
+		if err != nil {
+			status := productPricesErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, price, ""))
+	}
+}
+
 func productRecordsErrorHandler(err error) int {
 	switch err {
 
 	case productrecords.ErrProductNotFoundError:
 		return http.StatusConflict
 
+	case productrecords.ErrSalePriceBelowPurchaseError:
+		return http.StatusUnprocessableEntity
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func productPricesErrorHandler(err error) int {
+	switch err {
+
+	case productrecords.ErrProductNotFoundError, productrecords.ErrPriceNotFoundError:
+		return http.StatusNotFound
+
+	case productrecords.ErrInvalidDateError:
+		return http.StatusBadRequest
+
 	default:
 		return http.StatusInternalServerError
 	}
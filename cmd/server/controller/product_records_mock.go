@@ -7,13 +7,40 @@ import (
 type mockProductRecordsService struct {
 	result any
 	err    error
+	date   *string
+	// product is the product of every record, owned by its seller.
+	product db.Product
 }
 
 func (m mockProductRecordsService) Create(
-	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64,
+	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64, promotion bool,
 ) (db.ProductRecord, error) {
 	if m.err != nil {
 		return db.ProductRecord{}, m.err
 	}
 	return m.result.(db.ProductRecord), nil
 }
+
+func (m mockProductRecordsService) GetPrices(productId uint64) ([]db.ProductRecord, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.result.([]db.ProductRecord), nil
+}
+
+func (m mockProductRecordsService) GetPriceAt(productId uint64, date string) (db.ProductRecord, error) {
+	if m.date != nil {
+		*m.date = date
+	}
+	if m.err != nil {
+		return db.ProductRecord{}, m.err
+	}
+	return m.result.(db.ProductRecord), nil
+}
+
+func (m mockProductRecordsService) GetProduct(productId uint64) (db.Product, error) {
+	if m.err != nil {
+		return db.Product{}, m.err
+	}
+	return m.product, nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
 	productrecords "github.com/GuiTadeu/mercado-fresh-panic/internal/product_records"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
@@ -99,6 +100,137 @@ func Test_Product_Records_Create_422(t *testing.T) {
 
 }
 
+func Test_Product_Records_Create_422_SaleBelowPurchase(t *testing.T) {
+	jsonValue, _ := json.Marshal(CreateProductRecordsRequest{LastUpdateDate: "2021-04-04", PurchasePrice: 10, SalePrice: 8, ProductId: 1})
+
+	router := setupProductRecordsRouter(mockProductRecordsService{err: productrecords.ErrSalePriceBelowPurchaseError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productRecords", bytes.NewBuffer(jsonValue))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Equal(t, productrecords.ErrSalePriceBelowPurchaseError.Error(), responseData.Error)
+}
+
+func Test_Product_Records_Create_201_OwnProduct(t *testing.T) {
+	productRecord := db.ProductRecord{Id: 1, LastUpdateDate: "2021-04-04", PurchasePrice: 10, SalePrice: 15, ProductId: 1}
+
+	jsonValue, _ := json.Marshal(productRecord)
+
+	router := setupSellerScopedProductRecordsRouter(mockProductRecordsService{
+		result:  productRecord,
+		product: db.Product{Id: 1, SellerId: 1},
+	}, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productRecords", bytes.NewBuffer(jsonValue))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+}
+
+func Test_Product_Records_Create_403_OtherSeller(t *testing.T) {
+	productRecord := db.ProductRecord{Id: 1, LastUpdateDate: "2021-04-04", PurchasePrice: 10, SalePrice: 15, ProductId: 1}
+
+	jsonValue, _ := json.Marshal(productRecord)
+
+	router := setupSellerScopedProductRecordsRouter(mockProductRecordsService{
+		result:  productRecord,
+		product: db.Product{Id: 1, SellerId: 2},
+	}, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productRecords", bytes.NewBuffer(jsonValue))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusForbidden, response.Code)
+	assert.Equal(t, auth.ErrForbidden.Error(), responseData.Error)
+}
+
+func Test_Product_Prices_200(t *testing.T) {
+	prices := []db.ProductRecord{
+		{Id: 2, LastUpdateDate: "2022-06-14 10:00:00", PurchasePrice: 10, SalePrice: 12, ProductId: 1, Margin: 2},
+		{Id: 1, LastUpdateDate: "2022-07-04 09:00:00", PurchasePrice: 10, SalePrice: 8, ProductId: 1, Promotion: true, Margin: -2},
+	}
+
+	router := setupProductRecordsRouter(mockProductRecordsService{result: prices})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/1/prices", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.ProductRecord{}
+	decodeProductRecords(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, prices, responseData)
+}
+
+func Test_Product_Prices_400(t *testing.T) {
+	router := setupProductRecordsRouter(mockProductRecordsService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/abc/prices", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Product_Prices_404(t *testing.T) {
+	router := setupProductRecordsRouter(mockProductRecordsService{err: productrecords.ErrProductNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/999/prices", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Product_CurrentPrice_200(t *testing.T) {
+	price := db.ProductRecord{Id: 2, LastUpdateDate: "2022-06-14 10:00:00", PurchasePrice: 10, SalePrice: 12, ProductId: 1, Margin: 2}
+
+	var date string
+	router := setupProductRecordsRouter(mockProductRecordsService{result: price, date: &date})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/1/prices/current?date=2022-06-30", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := db.ProductRecord{}
+	decodeProductRecords(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, price, responseData)
+	assert.Equal(t, "2022-06-30", date)
+}
+
+func Test_Product_CurrentPrice_400_InvalidDate(t *testing.T) {
+	router := setupProductRecordsRouter(mockProductRecordsService{err: productrecords.ErrInvalidDateError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/1/prices/current?date=30/06/2022", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Product_CurrentPrice_404(t *testing.T) {
+	router := setupProductRecordsRouter(mockProductRecordsService{err: productrecords.ErrPriceNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/1/prices/current", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
 func decodeProductRecords(response *httptest.ResponseRecorder, responseData any) {
 	responseStruct := web.Response{}
 	json.Unmarshal(response.Body.Bytes(), &responseStruct)
@@ -112,6 +244,20 @@ func setupProductRecordsRouter(mockService mockProductRecordsService) *gin.Engin
 
 	router := gin.Default()
 	router.POST("/api/v1/productRecords", controller.Create())
+	router.GET("/api/v1/products/:id/prices", controller.GetPrices())
+	router.GET("/api/v1/products/:id/prices/current", controller.GetPriceAt())
+
+	return router
+}
+
+func setupSellerScopedProductRecordsRouter(mockService mockProductRecordsService, sellerId uint64) *gin.Engine {
+	controller := NewProductRecordsController(mockService)
+
+	router := gin.Default()
+	router.Use(func(ctx *gin.Context) {
+		auth.SetPrincipal(ctx, auth.Principal{Subject: "seller", Role: auth.SellerRole, SellerId: sellerId})
+	})
+	router.POST("/api/v1/productRecords", controller.Create())
 
 	return router
 }
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/productTypes"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type ProductTypesController struct {
+	service productTypes.Service
+}
+
+func NewProductType(s productTypes.Service) *ProductTypesController {
+	return &ProductTypesController{
+		service: s,
+	}
+}
+
+func (control *ProductTypesController) FindAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		page, err := control.service.FindAll(params)
+		if err != nil {
+			status := productTypeErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, page.Items, page.Meta))
+	}
+}
+
+func (control *ProductTypesController) FindOne() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "id in wrong format"))
+			return
+		}
+
+		productType, err := control.service.FindOne(id)
+		if err != nil {
+			status := productTypeErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, productType, ""))
+	}
+}
+
+func (control *ProductTypesController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req createProductTypeRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		productType, err := control.service.Create(req.Description)
+		if err != nil {
+			status := productTypeErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, productType, ""))
+	}
+}
+
+func (control *ProductTypesController) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "id in wrong format"))
+			return
+		}
+
+		var req updateProductTypeRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		productType, err := control.service.Update(id, req.Description)
+		if err != nil {
+			status := productTypeErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, productType, ""))
+	}
+}
+
+func (control *ProductTypesController) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "id in wrong format"))
+			return
+		}
+
+		err = control.service.Delete(id)
+		if err != nil {
+			status := productTypeErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, web.NewResponse(http.StatusNoContent, nil, ""))
+	}
+}
+
+func productTypeErrorHandler(err error, ctx *gin.Context) int {
+	switch err {
+
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
+	case productTypes.ProductTypeNotFoundError:
+		return http.StatusNotFound
+
+	case productTypes.ProductTypeInUseError:
+		return http.StatusConflict
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+type createProductTypeRequest struct {
+	Description string `json:"description" binding:"required"`
+}
+
+type updateProductTypeRequest struct {
+	Description string `json:"description"`
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockProductTypeService struct {
+	result any
+	err    error
+}
+
+func (m mockProductTypeService) FindAll(params query.Params) (query.Page[db.ProductType], error) {
+	if m.err != nil {
+		return query.Page[db.ProductType]{}, m.err
+	}
+	return query.NewPage(m.result.([]db.ProductType), params)
+}
+
+func (m mockProductTypeService) FindOne(id uint64) (db.ProductType, error) {
+	if m.err != nil {
+		return db.ProductType{}, m.err
+	}
+	return m.result.(db.ProductType), nil
+}
+
+func (m mockProductTypeService) Create(description string) (db.ProductType, error) {
+	if m.err != nil {
+		return db.ProductType{}, m.err
+	}
+	return m.result.(db.ProductType), nil
+}
+
+func (m mockProductTypeService) Update(id uint64, description string) (db.ProductType, error) {
+	if m.err != nil {
+		return db.ProductType{}, m.err
+	}
+	return m.result.(db.ProductType), nil
+}
+
+func (m mockProductTypeService) Delete(id uint64) error {
+	return m.err
+}
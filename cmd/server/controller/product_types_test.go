@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/productTypes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProductType_Create_201(t *testing.T) {
+
+	frozen := db.ProductType{Id: 1, Description: "Congelados"}
+	router := setupProductTypeRouter(mockProductTypeService{result: frozen})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productTypes", bytes.NewBufferString(`{"description": "Congelados"}`))
+	router.ServeHTTP(response, request)
+
+	responseData := db.ProductType{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, frozen, responseData)
+}
+
+func Test_ProductType_Create_422(t *testing.T) {
+
+	router := setupProductTypeRouter(mockProductTypeService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productTypes", bytes.NewBufferString(`{}`))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_ProductType_GetAll_200(t *testing.T) {
+
+	productTypesList := []db.ProductType{{Id: 1, Description: "Congelados"}, {Id: 2, Description: "Frescos"}}
+	router := setupProductTypeRouter(mockProductTypeService{result: productTypesList})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productTypes", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.ProductType{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, productTypesList, responseData)
+}
+
+func Test_ProductType_Get_404(t *testing.T) {
+
+	router := setupProductTypeRouter(mockProductTypeService{err: productTypes.ProductTypeNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productTypes/9", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_ProductType_Get_400_InvalidId(t *testing.T) {
+
+	router := setupProductTypeRouter(mockProductTypeService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productTypes/abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_ProductType_Update_200(t *testing.T) {
+
+	fresh := db.ProductType{Id: 2, Description: "Frescos"}
+	router := setupProductTypeRouter(mockProductTypeService{result: fresh})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/productTypes/2", bytes.NewBufferString(`{"description": "Frescos"}`))
+	router.ServeHTTP(response, request)
+
+	responseData := db.ProductType{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, fresh, responseData)
+}
+
+func Test_ProductType_Delete_204(t *testing.T) {
+
+	router := setupProductTypeRouter(mockProductTypeService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/productTypes/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func Test_ProductType_Delete_409_InUse(t *testing.T) {
+
+	router := setupProductTypeRouter(mockProductTypeService{err: productTypes.ProductTypeInUseError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/productTypes/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func setupProductTypeRouter(mockService mockProductTypeService) *gin.Engine {
+	controller := NewProductType(mockService)
+
+	router := gin.Default()
+	router.POST("/api/v1/productTypes", controller.Create())
+	router.GET("/api/v1/productTypes", controller.FindAll())
+	router.GET("/api/v1/productTypes/:id", controller.FindOne())
+	router.PATCH("/api/v1/productTypes/:id", controller.Update())
+	router.DELETE("/api/v1/productTypes/:id", controller.Delete())
+
+	return router
+}
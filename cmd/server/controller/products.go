@@ -4,7 +4,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -61,6 +64,11 @@ func (c *productController) Create() gin.HandlerFunc {
 			return
 		}
 
+		if sellerId, scoped := auth.SellerScope(ctx); scoped && request.SellerId != sellerId {
+			ctx.JSON(http.StatusForbidden, web.NewResponse(http.StatusForbidden, nil, auth.ErrForbidden.Error()))
+			return
+		}
+
 		addedProduct, err := service.Create(
 			request.Code,
 			request.Description,
@@ -88,14 +96,20 @@ func (c *productController) Create() gin.HandlerFunc {
 func (c *productController) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
-		products, err := c.productService.GetAll()
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		products, err := c.productService.GetAll(params)
 		if err != nil {
 			status := productErrorHandler(err)
 			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, products, ""))
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, products.Items, products.Meta))
 	}
 }
 
@@ -105,23 +119,16 @@ func (c *productController) GetAllReportRecords() gin.HandlerFunc {
 		id, ok := ctx.GetQuery("id")
 		if ok {
 			id, _ := strconv.ParseUint(id, 10, 64)
-			report, err := c.productService.GetReportRecords(id)
+			records, err := c.productService.GetReportRecords(id)
 			if err != nil {
 				status := productErrorHandler(err)
 				ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
 				return
 			}
 
-			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, report, ""))
+			report.RenderOne(ctx, "records_by_product", records)
 		} else {
-			reports, err := c.productService.GetAllReportRecords()
-			if err != nil {
-				status := productErrorHandler(err)
-				ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
-				return
-			}
-
-			ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, reports, ""))
+			report.Render(ctx, "records_by_product", c.productService.GetAllReportRecords())
 		}
 
 	}
@@ -173,6 +180,10 @@ func (c *productController) Update() gin.HandlerFunc {
 			return
 		}
 
+		if !c.ownsProduct(ctx, id) {
+			return
+		}
+
 		updatedProduct, err := c.productService.Update(
 			id,
 			request.Code,
@@ -208,6 +219,10 @@ func (c *productController) Delete() gin.HandlerFunc {
 			return
 		}
 
+		if !c.ownsProduct(ctx, id) {
+			return
+		}
+
 		err = c.productService.Delete(id)
 
 		if err != nil {
@@ -220,9 +235,35 @@ func (c *productController) Delete() gin.HandlerFunc {
 	}
 }
 
+// ownsProduct stops sellers from changing products of other sellers. It
+// writes the error response and returns false when the request must stop.
+func (c *productController) ownsProduct(ctx *gin.Context, id uint64) bool {
+	sellerId, scoped := auth.SellerScope(ctx)
+	if !scoped {
+		return true
+	}
+
+	product, err := c.productService.Get(id)
+	if err != nil {
+		status := productErrorHandler(err)
+		ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+		return false
+	}
+
+	if product.SellerId != sellerId {
+		ctx.JSON(http.StatusForbidden, web.NewResponse(http.StatusForbidden, nil, auth.ErrForbidden.Error()))
+		return false
+	}
+
+	return true
+}
+
 func productErrorHandler(err error) int {
 	switch err {
 
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
 	case products.ErrProductNotFoundError:
 		return http.StatusNotFound
 
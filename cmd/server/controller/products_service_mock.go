@@ -2,6 +2,8 @@ package controller
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
 )
 
@@ -11,11 +13,11 @@ type mockProductService struct {
 	productsExists bool
 }
 
-func (m mockProductService) GetAll() ([]db.Product, error) {
+func (m mockProductService) GetAll(params query.Params) (query.Page[db.Product], error) {
 	if m.err != nil {
-		return []db.Product{}, m.err
+		return query.Page[db.Product]{}, m.err
 	}
-	return m.result.([]db.Product), nil
+	return query.NewPage(m.result.([]db.Product), params)
 }
 
 func (m mockProductService) Get(id uint64) (db.Product, error) {
@@ -32,11 +34,11 @@ func (m mockProductService) GetReportRecords(id uint64) (db.ProductReportRecords
 	return m.result.(db.ProductReportRecords), nil
 }
 
-func (m mockProductService) GetAllReportRecords() ([]db.ProductReportRecords, error) {
+func (m mockProductService) GetAllReportRecords() report.Rows[db.ProductReportRecords] {
 	if m.err != nil {
-		return []db.ProductReportRecords{}, m.err
+		return report.Err[db.ProductReportRecords](m.err)
 	}
-	return m.result.([]db.ProductReportRecords), nil
+	return report.Slice(m.result.([]db.ProductReportRecords))
 }
 
 func (m mockProductService) Delete(id uint64) error {
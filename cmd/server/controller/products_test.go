@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 
@@ -245,6 +246,26 @@ func Test_GetAllReportRecords_200(t *testing.T) {
 	assert.Equal(t, productsList, responseData)
 }
 
+func Test_GetAllReportRecords_200_CSV(t *testing.T) {
+
+	mockService := mockProductService{
+		result: []db.ProductReportRecords{
+			{Id: 1, Description: "paints, acrylic", RecordsCount: 10},
+			{Id: 2, Description: "shoes", RecordsCount: 115},
+		},
+	}
+
+	router := setupRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/products/reportrecords?format=csv", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, `attachment; filename="records_by_product.csv"`, response.Header().Get("Content-Disposition"))
+	assert.Equal(t, "product_id,description,records_count\n1,\"paints, acrylic\",10\n2,shoes,115\n", response.Body.String())
+}
+
 func Test_Get_200(t *testing.T) {
 
 	foundProduct := db.Product{
@@ -451,6 +472,124 @@ func Test_Delete_404(t *testing.T) {
 	assert.Equal(t, 404, response.Code)
 }
 
+func Test_Create_403_OtherSeller(t *testing.T) {
+
+	product := db.Product{
+		Code:                    "ABC",
+		Description:             "ABC",
+		Width:                   1.0,
+		Height:                  1.0,
+		Length:                  1.0,
+		NetWeight:               1.0,
+		ExpirationRate:          1.0,
+		RecommendedFreezingTemp: 1.0,
+		FreezingRate:            1.0,
+		ProductTypeId:           1,
+		SellerId:                2,
+	}
+
+	jsonValue, _ := json.Marshal(product)
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockProductService{
+		result: product,
+		err:    nil,
+	}
+
+	router := setupSellerScopedRouter(mockService, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/products", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, 403, response.Code)
+}
+
+func Test_Update_200_OwnProduct(t *testing.T) {
+
+	ownProduct := db.Product{
+		Id:          1,
+		Code:        "DEF",
+		Description: "DEF",
+		SellerId:    1,
+	}
+
+	jsonValue, _ := json.Marshal(UpdateProductRequest{Description: "DEF"})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockProductService{
+		result: ownProduct,
+		err:    nil,
+	}
+
+	router := setupSellerScopedRouter(mockService, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/products/1", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, 200, response.Code)
+}
+
+func Test_Update_403_OtherSeller(t *testing.T) {
+
+	otherSellerProduct := db.Product{
+		Id:       1,
+		SellerId: 2,
+	}
+
+	jsonValue, _ := json.Marshal(UpdateProductRequest{Description: "DEF"})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockProductService{
+		result: otherSellerProduct,
+		err:    nil,
+	}
+
+	router := setupSellerScopedRouter(mockService, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/products/1", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, 403, response.Code)
+	assert.Equal(t, auth.ErrForbidden.Error(), responseData.Error)
+}
+
+func Test_Delete_403_OtherSeller(t *testing.T) {
+
+	mockService := mockProductService{
+		result: db.Product{Id: 1, SellerId: 2},
+		err:    nil,
+	}
+
+	router := setupSellerScopedRouter(mockService, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/products/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, 403, response.Code)
+}
+
+func Test_Delete_404_OtherSeller(t *testing.T) {
+
+	mockService := mockProductService{
+		err: products.ErrProductNotFoundError,
+	}
+
+	router := setupSellerScopedRouter(mockService, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/products/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, 404, response.Code)
+}
+
 func decodeWebResponse(response *httptest.ResponseRecorder, responseData any) {
 	responseStruct := web.Response{}
 	json.Unmarshal(response.Body.Bytes(), &responseStruct)
@@ -472,3 +611,17 @@ func setupRouter(mockService mockProductService) *gin.Engine {
 
 	return router
 }
+
+func setupSellerScopedRouter(mockService mockProductService, sellerId uint64) *gin.Engine {
+	controller := NewProductController(mockService)
+
+	router := gin.Default()
+	router.Use(func(ctx *gin.Context) {
+		auth.SetPrincipal(ctx, auth.Principal{Subject: "seller", Role: auth.SellerRole, SellerId: sellerId})
+	})
+	router.POST("/api/v1/products", controller.Create())
+	router.PATCH("/api/v1/products/:id", controller.Update())
+	router.DELETE("/api/v1/products/:id", controller.Delete())
+
+	return router
+}
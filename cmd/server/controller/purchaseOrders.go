@@ -5,6 +5,7 @@ import (
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"strconv"
 )
 
 type PurchaseOrdersController struct {
@@ -54,6 +55,60 @@ func (c *PurchaseOrdersController) Create() gin.HandlerFunc {
 	}
 }
 
+type TransitionPurchaseOrderRequest struct {
+	Action string `json:"action" binding:"required"`
+}
+
+func (c *PurchaseOrdersController) Transition() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(
+				http.StatusBadRequest,
+				web.NewResponse(http.StatusBadRequest, nil, "purchase order id binding error"),
+			)
+			return
+		}
+
+		var req TransitionPurchaseOrderRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		purchaseOrder, err := c.purchaseOrdesService.Transition(id, req.Action)
+		if err != nil {
+			status := purchaseOrderErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, purchaseOrder, ""))
+	}
+}
+
+func (c *PurchaseOrdersController) GetStatusHistory() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(
+				http.StatusBadRequest,
+				web.NewResponse(http.StatusBadRequest, nil, "purchase order id binding error"),
+			)
+			return
+		}
+
+		history, err := c.purchaseOrdesService.GetStatusHistory(id)
+		if err != nil {
+			status := purchaseOrderErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, history, ""))
+	}
+}
+
 func purchaseOrderErrorHandler(err error) int {
 	switch err {
 
@@ -62,6 +117,13 @@ func purchaseOrderErrorHandler(err error) int {
 
 	case purchaseOrders.PurchaseOrderNotFoundError:
 		return http.StatusNotFound
+
+	case purchaseOrders.UnknownTransitionActionError:
+		return http.StatusUnprocessableEntity
+
+	case purchaseOrders.IllegalTransitionError:
+		return http.StatusConflict
+
 	default:
 		return http.StatusInternalServerError
 	}
@@ -17,3 +17,17 @@ func (m mockPurchaseOrdersService) Create(
 	}
 	return m.result.(db.PurchaseOrder), nil
 }
+
+func (m mockPurchaseOrdersService) Transition(id uint64, action string) (db.PurchaseOrder, error) {
+	if m.err != nil {
+		return db.PurchaseOrder{}, m.err
+	}
+	return m.result.(db.PurchaseOrder), nil
+}
+
+func (m mockPurchaseOrdersService) GetStatusHistory(id uint64) ([]db.PurchaseOrderStatusHistory, error) {
+	if m.err != nil {
+		return []db.PurchaseOrderStatusHistory{}, m.err
+	}
+	return m.result.([]db.PurchaseOrderStatusHistory), nil
+}
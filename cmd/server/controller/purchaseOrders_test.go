@@ -91,6 +91,170 @@ func Test_PurchaseOrders_Create_409(t *testing.T) {
 	assert.Equal(t, 409, response.Code)
 }
 
+func Test_PurchaseOrders_Transition_200(t *testing.T) {
+
+	shippedPurchaseOrder := db.PurchaseOrder{
+		Id:              1,
+		OrderNumber:     "777",
+		OrderDate:       "2022-07-12",
+		TrackingCode:    "777",
+		BuyerId:         1,
+		OrderStatusId:   purchaseOrders.InTransitStatusId,
+		ProductRecordId: 1,
+	}
+
+	jsonValue, _ := json.Marshal(TransitionPurchaseOrderRequest{Action: purchaseOrders.ShipAction})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockPurchaseOrdersService{
+		result: shippedPurchaseOrder,
+		err:    nil,
+	}
+
+	router := setupPurchaseOrdersRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/purchaseOrders/1/transitions", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := db.PurchaseOrder{}
+	decodePurchaseOrdersWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, shippedPurchaseOrder, responseData)
+}
+
+func Test_PurchaseOrders_Transition_400(t *testing.T) {
+
+	jsonValue, _ := json.Marshal(TransitionPurchaseOrderRequest{Action: purchaseOrders.ShipAction})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	router := setupPurchaseOrdersRouter(mockPurchaseOrdersService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/purchaseOrders/abc/transitions", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_PurchaseOrders_Transition_404(t *testing.T) {
+
+	jsonValue, _ := json.Marshal(TransitionPurchaseOrderRequest{Action: purchaseOrders.ShipAction})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockPurchaseOrdersService{
+		err: purchaseOrders.PurchaseOrderNotFoundError,
+	}
+
+	router := setupPurchaseOrdersRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/purchaseOrders/1/transitions", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_PurchaseOrders_Transition_409(t *testing.T) {
+
+	jsonValue, _ := json.Marshal(TransitionPurchaseOrderRequest{Action: purchaseOrders.DeliverAction})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockPurchaseOrdersService{
+		err: purchaseOrders.IllegalTransitionError,
+	}
+
+	router := setupPurchaseOrdersRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/purchaseOrders/1/transitions", requestBody)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, purchaseOrders.IllegalTransitionError.Error(), responseData.Error)
+}
+
+func Test_PurchaseOrders_Transition_422(t *testing.T) {
+
+	requestBody := bytes.NewBuffer([]byte(`{}`))
+
+	router := setupPurchaseOrdersRouter(mockPurchaseOrdersService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/purchaseOrders/1/transitions", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_PurchaseOrders_Transition_422_UnknownAction(t *testing.T) {
+
+	jsonValue, _ := json.Marshal(TransitionPurchaseOrderRequest{Action: "teleport"})
+	requestBody := bytes.NewBuffer(jsonValue)
+
+	mockService := mockPurchaseOrdersService{
+		err: purchaseOrders.UnknownTransitionActionError,
+	}
+
+	router := setupPurchaseOrdersRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/purchaseOrders/1/transitions", requestBody)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_PurchaseOrders_GetStatusHistory_200(t *testing.T) {
+
+	history := []db.PurchaseOrderStatusHistory{
+		{
+			Id:              1,
+			PurchaseOrderId: 1,
+			FromStatusId:    purchaseOrders.PendingStatusId,
+			ToStatusId:      purchaseOrders.ApprovedStatusId,
+			Action:          purchaseOrders.ApproveAction,
+			ChangedAt:       "2022-07-12 10:00:00",
+		},
+	}
+
+	mockService := mockPurchaseOrdersService{
+		result: history,
+		err:    nil,
+	}
+
+	router := setupPurchaseOrdersRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/purchaseOrders/1/transitions", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.PurchaseOrderStatusHistory{}
+	decodePurchaseOrdersWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, history, responseData)
+}
+
+func Test_PurchaseOrders_GetStatusHistory_404(t *testing.T) {
+
+	mockService := mockPurchaseOrdersService{
+		err: purchaseOrders.PurchaseOrderNotFoundError,
+	}
+
+	router := setupPurchaseOrdersRouter(mockService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/purchaseOrders/1/transitions", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
 func decodePurchaseOrdersWebResponse(response *httptest.ResponseRecorder, responseData any) {
 	responseStruct := web.Response{}
 	json.Unmarshal(response.Body.Bytes(), &responseStruct)
@@ -104,6 +268,8 @@ func setupPurchaseOrdersRouter(mockService mockPurchaseOrdersService) *gin.Engin
 
 	router := gin.Default()
 	router.POST("/api/v1/purchaseOrders", controller.Create())
+	router.POST("/api/v1/purchaseOrders/:id/transitions", controller.Transition())
+	router.GET("/api/v1/purchaseOrders/:id/transitions", controller.GetStatusHistory())
 
 	return router
 }
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/recalls"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateRecallRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type recallController struct {
+	recallService recalls.RecallService
+}
+
+func NewRecallController(s recalls.RecallService) *recallController {
+	return &recallController{
+		recallService: s,
+	}
+}
+
+// Trace reports where the batch came from, where its stock is and who may
+// have bought it.
+func (c recallController) Trace() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "product batch id binding error"))
+			return
+		}
+
+		trace, err := c.recallService.Trace(id)
+		if err != nil {
+			status := recallErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, trace, ""))
+	}
+}
+
+// Recall blocks the batch from picking and lists the impacted buyers.
+func (c recallController) Recall() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "product batch id binding error"))
+			return
+		}
+
+		var req CreateRecallRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		if !c.ownsBatch(ctx, id) {
+			return
+		}
+
+		report, err := c.recallService.Recall(id, req.Reason)
+		if err != nil {
+			status := recallErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, report, ""))
+	}
+}
+
+// ownsBatch stops sellers from recalling batches of products of other
+// sellers. It writes the error response and returns false when the request
+// must stop.
+func (c recallController) ownsBatch(ctx *gin.Context, id uint64) bool {
+	sellerId, scoped := auth.SellerScope(ctx)
+	if !scoped {
+		return true
+	}
+
+	trace, err := c.recallService.Trace(id)
+	if err != nil {
+		status := recallErrorHandler(err)
+		ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+		return false
+	}
+
+	if trace.Product.SellerId != sellerId {
+		ctx.JSON(http.StatusForbidden, web.NewResponse(http.StatusForbidden, nil, auth.ErrForbidden.Error()))
+		return false
+	}
+
+	return true
+}
+
+func recallErrorHandler(err error) int {
+	switch err {
+	case recalls.ProductBatchNotFoundError:
+		return http.StatusNotFound
+	case recalls.AlreadyRecalledError:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,19 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type mockRecallService struct {
+	trace  db.BatchTrace
+	report db.RecallReport
+	err    error
+}
+
+func (m mockRecallService) Trace(productBatchId uint64) (db.BatchTrace, error) {
+	return m.trace, m.err
+}
+
+func (m mockRecallService) Recall(productBatchId uint64, reason string) (db.RecallReport, error) {
+	return m.report, m.err
+}
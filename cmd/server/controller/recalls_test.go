@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/recalls"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedTrace = db.BatchTrace{
+	Batch:     db.ProductBatch{Id: 1, Number: 10, ProductId: 1, SectionId: 1},
+	Product:   db.Product{Id: 1, Description: "Banana", SellerId: 2},
+	Seller:    db.Seller{Id: 2, CompanyName: "Frutas SA"},
+	Receipts:  []db.TraceReceipt{{InboundOrderId: 1, OrderNumber: "IO-1", Quantity: 35, WarehouseId: 1}},
+	Locations: []db.TraceLocation{{ProductBatchId: 1, CurrentQuantity: 30, SectionId: 1, WarehouseId: 1}},
+	Sales:     []db.TraceSale{{PurchaseOrderId: 2, OrderNumber: "PO-2", Quantity: 6, BuyerId: 3}},
+	Buyers:    []db.Buyer{{Id: 3, FirstName: "Ana"}},
+}
+
+var expectedRecallReport = db.RecallReport{
+	Recall:    db.BatchRecall{Id: 1, ProductBatchId: 1, BatchNumber: 10, Reason: "Listeria", CreatedAt: "2022-08-01 10:00:00"},
+	Locations: expectedTrace.Locations,
+	Buyers:    expectedTrace.Buyers,
+}
+
+func Test_Recall_Trace_200(t *testing.T) {
+
+	router := setupRecallRouter(mockRecallService{trace: expectedTrace})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/1/trace", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := db.BatchTrace{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedTrace, responseData)
+}
+
+func Test_Recall_Trace_400(t *testing.T) {
+
+	router := setupRecallRouter(mockRecallService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/abc/trace", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Recall_Trace_404(t *testing.T) {
+
+	router := setupRecallRouter(mockRecallService{err: recalls.ProductBatchNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/productBatches/1/trace", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Recall_Create_201(t *testing.T) {
+
+	router := setupRecallRouter(mockRecallService{report: expectedRecallReport})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches/1/recall", recallRequestBody(CreateRecallRequest{Reason: "Listeria"}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.RecallReport{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, expectedRecallReport, responseData)
+}
+
+func Test_Recall_Create_422(t *testing.T) {
+
+	router := setupRecallRouter(mockRecallService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches/1/recall", recallRequestBody(CreateRecallRequest{}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_Recall_Create_409(t *testing.T) {
+
+	expectedError := recalls.AlreadyRecalledError
+
+	router := setupRecallRouter(mockRecallService{err: expectedError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches/1/recall", recallRequestBody(CreateRecallRequest{Reason: "Listeria"}))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Recall_Create_500(t *testing.T) {
+
+	router := setupRecallRouter(mockRecallService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches/1/recall", recallRequestBody(CreateRecallRequest{Reason: "Listeria"}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func Test_Recall_Create_201_OwnSeller(t *testing.T) {
+
+	router := setupSellerScopedRecallRouter(mockRecallService{trace: expectedTrace, report: expectedRecallReport}, 2)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches/1/recall", recallRequestBody(CreateRecallRequest{Reason: "Listeria"}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+}
+
+func Test_Recall_Create_403_OtherSeller(t *testing.T) {
+
+	router := setupSellerScopedRecallRouter(mockRecallService{trace: expectedTrace, report: expectedRecallReport}, 1)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/productBatches/1/recall", recallRequestBody(CreateRecallRequest{Reason: "Listeria"}))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusForbidden, response.Code)
+	assert.Equal(t, auth.ErrForbidden.Error(), responseData.Error)
+}
+
+func recallRequestBody(request CreateRecallRequest) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func setupRecallRouter(mockService mockRecallService) *gin.Engine {
+	controller := NewRecallController(mockService)
+
+	router := gin.Default()
+	router.GET("/api/v1/productBatches/:id/trace", controller.Trace())
+	router.POST("/api/v1/productBatches/:id/recall", controller.Recall())
+
+	return router
+}
+
+func setupSellerScopedRecallRouter(mockService mockRecallService, sellerId uint64) *gin.Engine {
+	controller := NewRecallController(mockService)
+
+	router := gin.Default()
+	router.Use(func(ctx *gin.Context) {
+		auth.SetPrincipal(ctx, auth.Principal{Subject: "seller", Role: auth.SellerRole, SellerId: sellerId})
+	})
+	router.POST("/api/v1/productBatches/:id/recall", controller.Recall())
+
+	return router
+}
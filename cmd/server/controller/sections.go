@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -42,14 +43,20 @@ func NewSectionController(s sections.SectionService) *sectionController {
 func (c *sectionController) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
-		sections, err := c.sectionService.GetAll()
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		sections, err := c.sectionService.GetAll(params)
 		if err != nil {
 			status := sectionErrorHandler(err)
 			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, sections, ""))
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, sections.Items, sections.Meta))
 	}
 }
 
@@ -182,12 +189,18 @@ func (c *sectionController) Delete() gin.HandlerFunc {
 func sectionErrorHandler(err error) int {
 	switch err {
 
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
 	case sections.ErrSectionNotFoundError:
 		return http.StatusNotFound
 
 	case sections.ErrExistsSectionNumberError:
 		return http.StatusConflict
 
+	case sections.ErrInvalidSectionCapacityError:
+		return http.StatusUnprocessableEntity
+
 	default:
 		return http.StatusInternalServerError
 	}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
 )
 
@@ -10,11 +11,11 @@ type mockSectionService struct {
 	err    error
 }
 
-func (m mockSectionService) GetAll() ([]db.Section, error) {
+func (m mockSectionService) GetAll(params query.Params) (query.Page[db.Section], error) {
 	if m.err != nil {
-		return []db.Section{}, m.err
+		return query.Page[db.Section]{}, m.err
 	}
-	return m.result.([]db.Section), nil
+	return query.NewPage(m.result.([]db.Section), params)
 }
 
 func (m mockSectionService) Get(id uint64) (db.Section, error) {
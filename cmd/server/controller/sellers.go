@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -21,7 +22,13 @@ func NewSeller(s sellers.Service) *SellersController {
 
 func (control *SellersController) FindAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		s, err := control.service.FindAll()
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		s, err := control.service.FindAll(params)
 
 		if err != nil {
 			status := sellerErrorHandler(err, ctx)
@@ -29,7 +36,7 @@ func (control *SellersController) FindAll() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, s, ""))
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, s.Items, s.Meta))
 	}
 }
 
@@ -138,6 +145,9 @@ func (control *SellersController) Delete() gin.HandlerFunc {
 func sellerErrorHandler(err error, ctx *gin.Context) int {
 	switch err {
 
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+
 	case sellers.SellerNotFoundError:
 		return http.StatusNotFound
 
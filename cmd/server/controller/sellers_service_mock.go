@@ -2,6 +2,7 @@ package controller
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
 )
 
@@ -10,11 +11,11 @@ type mockSellerService struct {
 	err    error
 }
 
-func (m mockSellerService) FindAll() ([]db.Seller, error) {
+func (m mockSellerService) FindAll(params query.Params) (query.Page[db.Seller], error) {
 	if m.err != nil {
-		return []db.Seller{}, m.err
+		return query.Page[db.Seller]{}, m.err
 	}
-	return m.result.([]db.Seller), nil
+	return query.NewPage(m.result.([]db.Seller), params)
 }
 
 func (m mockSellerService) FindOne(id uint64) (db.Seller, error) {
@@ -9,6 +9,7 @@ import (
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 
 	"github.com/gin-gonic/gin"
@@ -139,6 +140,66 @@ func Test_Seller_GetAll_200(t *testing.T) {
 	assert.Equal(t, sellersList, responseData)
 }
 
+func Test_Seller_GetAll_200_Paginated(t *testing.T) {
+
+	sellersList := []db.Seller{
+		{Id: 1, Cid: 1, CompanyName: "Nike", Address: "Avenida Paulista, 202", Telephone: "13997780890", LocalityId: "11065001"},
+		{Id: 2, Cid: 2, CompanyName: "adidas", Address: "Avenida Mineira, 202", Telephone: "13927180890", LocalityId: "11065001"},
+	}
+
+	mockSellerService := mockSellerService{
+		result: sellersList,
+		err:    nil,
+	}
+
+	router := setupSellerRouter(mockSellerService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sellers?limit=1", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := struct {
+		Data []db.Seller `json:"data"`
+		Meta query.Meta  `json:"meta"`
+	}{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, sellersList[:1], responseData.Data)
+	assert.Equal(t, 1, responseData.Meta.Limit)
+	assert.NotEmpty(t, responseData.Meta.NextCursor)
+}
+
+func Test_Seller_GetAll_400_InvalidLimit(t *testing.T) {
+
+	router := setupSellerRouter(mockSellerService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sellers?limit=abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Seller_GetAll_400_InvalidSort(t *testing.T) {
+
+	mockSellerService := mockSellerService{
+		err: query.ErrInvalidSort,
+	}
+
+	router := setupSellerRouter(mockSellerService)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sellers?sort=unknown", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Equal(t, query.ErrInvalidSort.Error(), responseData.Error)
+}
+
 func Test_Seller_Get_200(t *testing.T) {
 
 	foundSeller := db.Seller{
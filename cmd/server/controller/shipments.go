@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/shipments"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateShipmentRequest struct {
+	PurchaseOrderId uint64 `json:"purchase_order_id" binding:"required"`
+	WarehouseId     uint64 `json:"warehouse_id" binding:"required"`
+	LocalityId      string `json:"locality_id"`
+	CarrierId       uint64 `json:"carrier_id"`
+}
+
+type CreateTrackingEventRequest struct {
+	EventType  string `json:"event_type" binding:"required"`
+	Location   string `json:"location"`
+	OccurredAt string `json:"occurred_at"`
+}
+
+type shipmentController struct {
+	shipmentService shipments.ShipmentService
+}
+
+func NewShipmentController(s shipments.ShipmentService) *shipmentController {
+	return &shipmentController{
+		shipmentService: s,
+	}
+}
+
+// Create ships a purchase order. Without a carrier id one near the delivery
+// locality or the warehouse is assigned.
+func (c shipmentController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req CreateShipmentRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		shipment, err := c.shipmentService.Create(req.PurchaseOrderId, req.WarehouseId, req.LocalityId, req.CarrierId)
+		if err != nil {
+			status := shipmentErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, shipment, ""))
+	}
+}
+
+func (c shipmentController) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "shipment id binding error"))
+			return
+		}
+
+		shipment, err := c.shipmentService.Get(id)
+		if err != nil {
+			status := shipmentErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, shipment, ""))
+	}
+}
+
+func (c shipmentController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		page, err := c.shipmentService.GetAll(params)
+		if err != nil {
+			status := shipmentErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, page.Items, page.Meta))
+	}
+}
+
+// RecordEvent stores a carrier scan of the shipment with the tracking code
+// of the path. A delivered scan also delivers the purchase order. Carriers
+// can only scan their own shipments.
+func (c shipmentController) RecordEvent() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req CreateTrackingEventRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		carrierId, _ := auth.CarrierScope(ctx)
+
+		event, err := c.shipmentService.RecordEvent(ctx.Param("trackingCode"), req.EventType, req.Location, req.OccurredAt, carrierId)
+		if err != nil {
+			status := shipmentErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, event, ""))
+	}
+}
+
+func (c shipmentController) GetTracking() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "purchase order id binding error"))
+			return
+		}
+
+		timeline, err := c.shipmentService.GetTracking(id)
+		if err != nil {
+			status := shipmentErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, timeline, ""))
+	}
+}
+
+func shipmentErrorHandler(err error) int {
+	switch err {
+	case shipments.ShipmentNotFoundError, purchaseOrders.PurchaseOrderNotFoundError:
+		return http.StatusNotFound
+	case shipments.PurchaseOrderNotFoundError, shipments.WarehouseNotFoundError, shipments.CarrierNotFoundError, shipments.LocalityNotFoundError:
+		return http.StatusConflict
+	case shipments.AlreadyShippedError, shipments.NoCarrierAvailableError, shipments.ShipmentClosedError:
+		return http.StatusConflict
+	case shipments.MissingTrackingCodeError, shipments.TrackingCodeInUseError, shipments.NotApprovedError:
+		return http.StatusConflict
+	case shipments.ForeignShipmentError:
+		return http.StatusForbidden
+	case shipments.UnknownEventTypeError, shipments.InvalidOccurredAtError:
+		return http.StatusUnprocessableEntity
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
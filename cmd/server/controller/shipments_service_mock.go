@@ -0,0 +1,37 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockShipmentService struct {
+	result    db.Shipment
+	shipments []db.Shipment
+	event     db.TrackingEvent
+	timeline  db.TrackingTimeline
+	err       error
+}
+
+func (m mockShipmentService) Create(purchaseOrderId uint64, warehouseId uint64, localityId string, carrierId uint64) (db.Shipment, error) {
+	return m.result, m.err
+}
+
+func (m mockShipmentService) Get(id uint64) (db.Shipment, error) {
+	return m.result, m.err
+}
+
+func (m mockShipmentService) GetAll(params query.Params) (query.Page[db.Shipment], error) {
+	if m.err != nil {
+		return query.Page[db.Shipment]{}, m.err
+	}
+	return query.NewPage(m.shipments, params)
+}
+
+func (m mockShipmentService) RecordEvent(trackingCode string, eventType string, location string, occurredAt string, carrierId uint64) (db.TrackingEvent, error) {
+	return m.event, m.err
+}
+
+func (m mockShipmentService) GetTracking(purchaseOrderId uint64) (db.TrackingTimeline, error) {
+	return m.timeline, m.err
+}
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/shipments"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedShipment = db.Shipment{
+	Id: 1, PurchaseOrderId: 1, CarrierId: 2, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00",
+}
+
+func Test_Shipment_Create_201(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{result: expectedShipment})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/shipments", shipmentRequestBody(CreateShipmentRequest{PurchaseOrderId: 1, WarehouseId: 1}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.Shipment{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, expectedShipment, responseData)
+}
+
+func Test_Shipment_Create_422(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/shipments", shipmentRequestBody(CreateShipmentRequest{PurchaseOrderId: 1}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_Shipment_Create_409(t *testing.T) {
+
+	expectedError := shipments.NoCarrierAvailableError
+
+	router := setupShipmentRouter(mockShipmentService{err: expectedError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/shipments", shipmentRequestBody(CreateShipmentRequest{PurchaseOrderId: 1, WarehouseId: 1}))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Shipment_Create_500(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/shipments", shipmentRequestBody(CreateShipmentRequest{PurchaseOrderId: 1, WarehouseId: 1}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func Test_Shipment_Get_200(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{result: expectedShipment})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/shipments/1", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := db.Shipment{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedShipment, responseData)
+}
+
+func Test_Shipment_Get_400(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/shipments/abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Shipment_Get_404(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{err: shipments.ShipmentNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/shipments/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Shipment_GetAll_200(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{shipments: []db.Shipment{expectedShipment}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/shipments?filter[carrier_id]=2", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.Shipment{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, []db.Shipment{expectedShipment}, responseData)
+}
+
+func Test_Shipment_RecordEvent_201(t *testing.T) {
+
+	expectedEvent := db.TrackingEvent{Id: 1, ShipmentId: 1, Type: shipments.DeliveredEvent, Location: "Santos", OccurredAt: "2022-08-02 10:00:00", CreatedAt: "2022-08-02 10:00:00"}
+
+	router := setupShipmentRouter(mockShipmentService{event: expectedEvent})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/tracking/TRK1/events", trackingRequestBody(CreateTrackingEventRequest{EventType: shipments.DeliveredEvent, Location: "Santos"}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.TrackingEvent{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, expectedEvent, responseData)
+}
+
+func Test_Shipment_RecordEvent_422(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{err: shipments.UnknownEventTypeError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/tracking/TRK1/events", trackingRequestBody(CreateTrackingEventRequest{EventType: "lost"}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_Shipment_RecordEvent_404(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{err: shipments.ShipmentNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/tracking/NOPE/events", trackingRequestBody(CreateTrackingEventRequest{EventType: shipments.PickedUpEvent}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Shipment_RecordEvent_403(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{err: shipments.ForeignShipmentError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/tracking/TRK1/events", trackingRequestBody(CreateTrackingEventRequest{EventType: shipments.DeliveredEvent}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusForbidden, response.Code)
+}
+
+func Test_Shipment_GetTracking_200(t *testing.T) {
+
+	expectedTimeline := db.TrackingTimeline{PurchaseOrderId: 1, OrderStatusId: 5, Shipment: expectedShipment, Events: []db.TrackingEvent{}}
+
+	router := setupShipmentRouter(mockShipmentService{timeline: expectedTimeline})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/purchaseOrders/1/tracking", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := db.TrackingTimeline{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedTimeline, responseData)
+}
+
+func Test_Shipment_GetTracking_400(t *testing.T) {
+
+	router := setupShipmentRouter(mockShipmentService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/purchaseOrders/abc/tracking", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func trackingRequestBody(request CreateTrackingEventRequest) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func shipmentRequestBody(request CreateShipmentRequest) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func setupShipmentRouter(mockService mockShipmentService) *gin.Engine {
+	controller := NewShipmentController(mockService)
+
+	router := gin.Default()
+	router.POST("/api/v1/shipments", controller.Create())
+	router.GET("/api/v1/shipments", controller.GetAll())
+	router.GET("/api/v1/shipments/:id", controller.Get())
+	router.POST("/api/v1/tracking/:trackingCode/events", controller.RecordEvent())
+	router.GET("/api/v1/purchaseOrders/:id/tracking", controller.GetTracking())
+
+	return router
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/stock"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateAdjustmentRequest struct {
+	ProductBatchId uint64 `json:"product_batch_id" binding:"required"`
+	MovementType   string `json:"movement_type" binding:"required"`
+	Quantity       int64  `json:"quantity" binding:"required"`
+	Reason         string `json:"reason"`
+}
+
+type stockController struct {
+	stockService stock.StockService
+}
+
+func NewStockController(s stock.StockService) *stockController {
+	return &stockController{
+		stockService: s,
+	}
+}
+
+// GetStock answers the stock by product and warehouse at ?at=, now when
+// omitted, optionally of one product (?product_id=1) or warehouse
+// (?warehouse_id=1).
+func (c *stockController) GetStock() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		var productId uint64
+		if value := ctx.Query("product_id"); value != "" {
+			parsed, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+				return
+			}
+			productId = parsed
+		}
+
+		var warehouseId uint64
+		if value := ctx.Query("warehouse_id"); value != "" {
+			parsed, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+				return
+			}
+			warehouseId = parsed
+		}
+
+		var at time.Time
+		if value := ctx.Query("at"); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				status := stockErrorHandler(stock.InvalidAtError)
+				ctx.JSON(status, web.NewResponse(status, nil, stock.InvalidAtError.Error()))
+				return
+			}
+			at = parsed
+		}
+
+		levels, err := c.stockService.StockAt(productId, warehouseId, at)
+		if err != nil {
+			status := stockErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, levels, ""))
+	}
+}
+
+func (c *stockController) GetMovements() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		movements, err := c.stockService.GetMovements(params)
+		if err != nil {
+			status := stockErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, movements.Items, movements.Meta))
+	}
+}
+
+func (c *stockController) CreateAdjustment() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req CreateAdjustmentRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		movement, err := c.stockService.Adjust(req.ProductBatchId, req.MovementType, req.Quantity, req.Reason)
+		if err != nil {
+			status := stockErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, movement, ""))
+	}
+}
+
+func stockErrorHandler(err error) int {
+	switch err {
+	case stock.InvalidMovementTypeError, stock.InvalidQuantityError, stock.InvalidWriteOffError:
+		return http.StatusUnprocessableEntity
+	case stock.ProductBatchNotFoundError:
+		return http.StatusConflict
+	case batches.InsufficientQuantityError, sections.ErrSectionCapacityExceededError, sections.ErrSectionCapacityUnderflowError:
+		return http.StatusConflict
+	case stock.InvalidAtError:
+		return http.StatusBadRequest
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
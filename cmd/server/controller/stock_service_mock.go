@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockStockService struct {
+	levels      []db.StockLevel
+	movement    db.InventoryMovement
+	movements   []db.InventoryMovement
+	err         error
+	productId   *uint64
+	warehouseId *uint64
+	at          *time.Time
+}
+
+func (m mockStockService) StockAt(productId uint64, warehouseId uint64, at time.Time) ([]db.StockLevel, error) {
+	if m.productId != nil {
+		*m.productId = productId
+	}
+	if m.warehouseId != nil {
+		*m.warehouseId = warehouseId
+	}
+	if m.at != nil {
+		*m.at = at
+	}
+	return m.levels, m.err
+}
+
+func (m mockStockService) Adjust(productBatchId uint64, movementType string, quantity int64, reason string) (db.InventoryMovement, error) {
+	return m.movement, m.err
+}
+
+func (m mockStockService) GetMovements(params query.Params) (query.Page[db.InventoryMovement], error) {
+	if m.err != nil {
+		return query.Page[db.InventoryMovement]{}, m.err
+	}
+	return query.NewPage(m.movements, params)
+}
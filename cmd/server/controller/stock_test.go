@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/stock"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedMovement = db.InventoryMovement{
+	Id: 1, ProductBatchId: 1, ProductId: 1, SectionId: 1, WarehouseId: 1, Type: "write_off", Quantity: -2, Reason: "broken", CreatedAt: "2022-08-01 10:00:00",
+}
+
+func Test_Stock_GetStock_200(t *testing.T) {
+
+	expectedLevels := []db.StockLevel{{ProductId: 1, WarehouseId: 2, Quantity: 70}}
+
+	var productId, warehouseId uint64
+	var at time.Time
+	router := setupStockRouter(mockStockService{levels: expectedLevels, productId: &productId, warehouseId: &warehouseId, at: &at})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/stock?product_id=1&warehouse_id=2&at=2022-06-01T00:00:00Z", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.StockLevel{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedLevels, responseData)
+	assert.Equal(t, uint64(1), productId)
+	assert.Equal(t, uint64(2), warehouseId)
+	assert.True(t, time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC).Equal(at))
+}
+
+func Test_Stock_GetStock_400(t *testing.T) {
+
+	router := setupStockRouter(mockStockService{})
+
+	for _, path := range []string{"/api/v1/stock?at=yesterday", "/api/v1/stock?product_id=abc"} {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	}
+}
+
+func Test_Stock_GetMovements_200(t *testing.T) {
+
+	router := setupStockRouter(mockStockService{movements: []db.InventoryMovement{expectedMovement}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/stock/movements?filter[product_id]=1", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.InventoryMovement{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, []db.InventoryMovement{expectedMovement}, responseData)
+}
+
+func Test_Stock_CreateAdjustment_201(t *testing.T) {
+
+	router := setupStockRouter(mockStockService{movement: expectedMovement})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/stock/adjustments", adjustmentRequestBody(CreateAdjustmentRequest{
+		ProductBatchId: 1, MovementType: "write_off", Quantity: -2, Reason: "broken",
+	}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.InventoryMovement{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, expectedMovement, responseData)
+}
+
+func Test_Stock_CreateAdjustment_422(t *testing.T) {
+
+	router := setupStockRouter(mockStockService{err: stock.InvalidWriteOffError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/stock/adjustments", adjustmentRequestBody(CreateAdjustmentRequest{
+		ProductBatchId: 1, MovementType: "write_off", Quantity: 2,
+	}))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Equal(t, stock.InvalidWriteOffError.Error(), responseData.Error)
+}
+
+func Test_Stock_CreateAdjustment_409(t *testing.T) {
+
+	router := setupStockRouter(mockStockService{err: batches.InsufficientQuantityError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/stock/adjustments", adjustmentRequestBody(CreateAdjustmentRequest{
+		ProductBatchId: 1, MovementType: "adjustment", Quantity: -200,
+	}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func Test_Stock_CreateAdjustment_500(t *testing.T) {
+
+	router := setupStockRouter(mockStockService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/stock/adjustments", adjustmentRequestBody(CreateAdjustmentRequest{
+		ProductBatchId: 1, MovementType: "adjustment", Quantity: 2,
+	}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func adjustmentRequestBody(request CreateAdjustmentRequest) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func setupStockRouter(mockService mockStockService) *gin.Engine {
+	controller := NewStockController(mockService)
+
+	router := gin.Default()
+	router.GET("/api/v1/stock", controller.GetStock())
+	router.GET("/api/v1/stock/movements", controller.GetMovements())
+	router.POST("/api/v1/stock/adjustments", controller.CreateAdjustment())
+
+	return router
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/telemetry"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type TemperatureReadingRequest struct {
+	RecordedAt string `json:"recorded_at" binding:"required"`
+	// Temperature is a pointer so that a reading of zero degrees is
+	// accepted by the required rule.
+	Temperature *float32 `json:"temperature" binding:"required"`
+}
+
+type CreateTemperaturesRequest struct {
+	Readings []TemperatureReadingRequest `json:"readings" binding:"required,min=1,max=1000,dive"`
+}
+
+type telemetryController struct {
+	telemetryService telemetry.TelemetryService
+}
+
+func NewTelemetryController(s telemetry.TelemetryService) *telemetryController {
+	return &telemetryController{
+		telemetryService: s,
+	}
+}
+
+func (c *telemetryController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, web.NewResponse(http.StatusNotFound, nil, err.Error()))
+			return
+		}
+
+		var req CreateTemperaturesRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		readings := make([]db.TemperatureReading, 0, len(req.Readings))
+		for _, reading := range req.Readings {
+			readings = append(readings, db.TemperatureReading{RecordedAt: reading.RecordedAt, Temperature: *reading.Temperature})
+		}
+
+		created, err := c.telemetryService.Ingest(id, readings)
+		if err != nil {
+			status := telemetryErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, created, ""))
+	}
+}
+
+// GetHistory rolls the readings of ?from= to ?to= (the last 24 hours by
+// default) up into buckets of ?bucket= (1h by default).
+func (c *telemetryController) GetHistory() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		id, from, to, ok := telemetryParams(ctx)
+		if !ok {
+			return
+		}
+
+		bucket := time.Hour
+		if value := ctx.Query("bucket"); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, telemetry.InvalidBucketError.Error()))
+				return
+			}
+			bucket = parsed
+		}
+
+		history, err := c.telemetryService.History(id, from, to, bucket)
+		if err != nil {
+			status := telemetryErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, history, ""))
+	}
+}
+
+// GetExcursions lists the excursions between ?from= and ?to= (the last 24
+// hours by default).
+func (c *telemetryController) GetExcursions() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		id, from, to, ok := telemetryParams(ctx)
+		if !ok {
+			return
+		}
+
+		excursions, err := c.telemetryService.Excursions(id, from, to)
+		if err != nil {
+			status := telemetryErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, excursions, ""))
+	}
+}
+
+// telemetryParams reads the section id and the time range of the request,
+// answering it with an error when one of them is invalid.
+func telemetryParams(ctx *gin.Context) (uint64, time.Time, time.Time, bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, web.NewResponse(http.StatusNotFound, nil, err.Error()))
+		return 0, time.Time{}, time.Time{}, false
+	}
+
+	to := time.Now().UTC()
+	from := to.Add(-24 * time.Hour)
+
+	for name, target := range map[string]*time.Time{"from": &from, "to": &to} {
+		value := ctx.Query(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := telemetry.ParseTime(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, name+": "+telemetry.InvalidRecordedAtError.Error()))
+			return 0, time.Time{}, time.Time{}, false
+		}
+		*target = parsed
+	}
+
+	return id, from, to, true
+}
+
+func telemetryErrorHandler(err error) int {
+	switch err {
+
+	case sections.ErrSectionNotFoundError:
+		return http.StatusNotFound
+
+	case telemetry.InvalidRecordedAtError:
+		return http.StatusUnprocessableEntity
+
+	case telemetry.InvalidRangeError, telemetry.InvalidBucketError, telemetry.TooManyBucketsError:
+		return http.StatusBadRequest
+
+	default:
+		return http.StatusInternalServerError
+	}
+}
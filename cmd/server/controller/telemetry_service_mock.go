@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type mockTelemetryService struct {
+	readings   []db.TemperatureReading
+	history    []db.TemperatureRollup
+	excursions []db.TemperatureExcursion
+	err        error
+	ingested   *[]db.TemperatureReading
+}
+
+func (m mockTelemetryService) Ingest(sectionId uint64, readings []db.TemperatureReading) ([]db.TemperatureReading, error) {
+	if m.ingested != nil {
+		*m.ingested = readings
+	}
+	return m.readings, m.err
+}
+
+func (m mockTelemetryService) History(sectionId uint64, from time.Time, to time.Time, bucket time.Duration) ([]db.TemperatureRollup, error) {
+	return m.history, m.err
+}
+
+func (m mockTelemetryService) Excursions(sectionId uint64, from time.Time, to time.Time) ([]db.TemperatureExcursion, error) {
+	return m.excursions, m.err
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/telemetry"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Telemetry_Create_201(t *testing.T) {
+
+	created := []db.TemperatureReading{{Id: 1, SectionId: 1, RecordedAt: "2022-08-01 10:00:00", Temperature: 0}}
+	var ingested []db.TemperatureReading
+
+	router := setupTelemetryRouter(mockTelemetryService{readings: created, ingested: &ingested})
+
+	body := bytes.NewBufferString(`{"readings":[{"recorded_at":"2022-08-01 10:00:00","temperature":0}]}`)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/sections/1/temperatures", body)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.TemperatureReading{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, created, responseData)
+	assert.Equal(t, []db.TemperatureReading{{RecordedAt: "2022-08-01 10:00:00", Temperature: 0}}, ingested)
+}
+
+func Test_Telemetry_Create_422(t *testing.T) {
+
+	router := setupTelemetryRouter(mockTelemetryService{})
+
+	for _, body := range []string{
+		`{"readings":[]}`,
+		`{"readings":[{"recorded_at":"2022-08-01 10:00:00"}]}`,
+	} {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest("POST", "/api/v1/sections/1/temperatures", bytes.NewBufferString(body))
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	}
+}
+
+func Test_Telemetry_Create_404(t *testing.T) {
+
+	router := setupTelemetryRouter(mockTelemetryService{err: sections.ErrSectionNotFoundError})
+
+	body := bytes.NewBufferString(`{"readings":[{"recorded_at":"2022-08-01 10:00:00","temperature":1}]}`)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/sections/9/temperatures", body)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Telemetry_GetHistory_200(t *testing.T) {
+
+	history := []db.TemperatureRollup{{BucketStart: "2022-08-01 10:00:00", Count: 3, Minimum: -3, Maximum: 1, Average: -1}}
+
+	router := setupTelemetryRouter(mockTelemetryService{history: history})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sections/1/temperatures?from=2022-08-01T10:00:00Z&to=2022-08-01T12:00:00Z&bucket=30m", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.TemperatureRollup{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, history, responseData)
+}
+
+func Test_Telemetry_GetHistory_400(t *testing.T) {
+
+	router := setupTelemetryRouter(mockTelemetryService{err: telemetry.InvalidRangeError})
+
+	for _, url := range []string{
+		"/api/v1/sections/1/temperatures?from=yesterday",
+		"/api/v1/sections/1/temperatures?bucket=hourly",
+		"/api/v1/sections/1/temperatures",
+	} {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", url, nil)
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	}
+}
+
+func Test_Telemetry_GetExcursions_200(t *testing.T) {
+
+	excursions := []db.TemperatureExcursion{
+		{SectionId: 1, MinimumTemperature: 0, LowestTemperature: -3, StartedAt: "2022-08-01 10:20:00", EndedAt: "2022-08-01 11:00:00", DurationSeconds: 2400},
+	}
+
+	router := setupTelemetryRouter(mockTelemetryService{excursions: excursions})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sections/1/excursions", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.TemperatureExcursion{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, excursions, responseData)
+}
+
+func setupTelemetryRouter(mockService mockTelemetryService) *gin.Engine {
+	controller := NewTelemetryController(mockService)
+
+	router := gin.Default()
+	router.POST("/api/v1/sections/:id/temperatures", controller.Create())
+	router.GET("/api/v1/sections/:id/temperatures", controller.GetHistory())
+	router.GET("/api/v1/sections/:id/excursions", controller.GetExcursions())
+
+	return router
+}
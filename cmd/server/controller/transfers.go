@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/transfers"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateTransferRequest struct {
+	ProductBatchId uint64 `json:"product_batch_id" binding:"required"`
+	ToSectionId    uint64 `json:"to_section_id" binding:"required"`
+	Quantity       uint64 `json:"quantity"`
+}
+
+type transferController struct {
+	transferService transfers.TransferService
+}
+
+func NewTransferController(s transfers.TransferService) *transferController {
+	return &transferController{
+		transferService: s,
+	}
+}
+
+// Create moves stock of a batch to another section. Without a quantity the
+// whole batch moves.
+func (c transferController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req CreateTransferRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, nil, err.Error()))
+			return
+		}
+
+		transfer, err := c.transferService.Transfer(req.ProductBatchId, req.ToSectionId, req.Quantity)
+		if err != nil {
+			status := transferErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, transfer, ""))
+	}
+}
+
+func (c transferController) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "transfer id binding error"))
+			return
+		}
+
+		transfer, err := c.transferService.Get(id)
+		if err != nil {
+			status := transferErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, transfer, ""))
+	}
+}
+
+func (c transferController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		page, err := c.transferService.GetAll(params)
+		if err != nil {
+			status := transferErrorHandler(err)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, page.Items, page.Meta))
+	}
+}
+
+func transferErrorHandler(err error) int {
+	switch err {
+	case transfers.TransferNotFoundError:
+		return http.StatusNotFound
+	case transfers.ProductBatchNotFoundError, transfers.SectionNotFoundError:
+		return http.StatusConflict
+	case transfers.SameSectionError, transfers.ProductTypeMismatchError, transfers.TemperatureMismatchError:
+		return http.StatusConflict
+	case batches.InsufficientQuantityError, sections.ErrSectionCapacityExceededError, sections.ErrSectionCapacityUnderflowError:
+		return http.StatusConflict
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
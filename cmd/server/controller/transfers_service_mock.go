@@ -0,0 +1,27 @@
+package controller
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockTransferService struct {
+	result    db.StockTransfer
+	transfers []db.StockTransfer
+	err       error
+}
+
+func (m mockTransferService) Transfer(productBatchId uint64, toSectionId uint64, quantity uint64) (db.StockTransfer, error) {
+	return m.result, m.err
+}
+
+func (m mockTransferService) Get(id uint64) (db.StockTransfer, error) {
+	return m.result, m.err
+}
+
+func (m mockTransferService) GetAll(params query.Params) (query.Page[db.StockTransfer], error) {
+	if m.err != nil {
+		return query.Page[db.StockTransfer]{}, m.err
+	}
+	return query.NewPage(m.transfers, params)
+}
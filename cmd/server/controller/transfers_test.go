@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/transfers"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedTransfer = db.StockTransfer{
+	Id: 1, ProductBatchId: 2, TargetBatchId: 3, FromSectionId: 1, ToSectionId: 2, Quantity: 4, CreatedAt: "2022-08-01 10:00:00",
+}
+
+func Test_Transfer_Create_201(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{result: expectedTransfer})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/transfers", transferRequestBody(CreateTransferRequest{ProductBatchId: 2, ToSectionId: 2, Quantity: 4}))
+	router.ServeHTTP(response, request)
+
+	responseData := db.StockTransfer{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, expectedTransfer, responseData)
+}
+
+func Test_Transfer_Create_422(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/transfers", transferRequestBody(CreateTransferRequest{ProductBatchId: 2}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func Test_Transfer_Create_409(t *testing.T) {
+
+	expectedError := transfers.ProductTypeMismatchError
+
+	router := setupTransferRouter(mockTransferService{err: expectedError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/transfers", transferRequestBody(CreateTransferRequest{ProductBatchId: 2, ToSectionId: 3}))
+	router.ServeHTTP(response, request)
+
+	responseData := web.Response{}
+	json.Unmarshal(response.Body.Bytes(), &responseData)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, expectedError.Error(), responseData.Error)
+}
+
+func Test_Transfer_Create_500(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{err: errors.New("connection error")})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/transfers", transferRequestBody(CreateTransferRequest{ProductBatchId: 2, ToSectionId: 2}))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func Test_Transfer_Get_200(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{result: expectedTransfer})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/transfers/1", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := db.StockTransfer{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, expectedTransfer, responseData)
+}
+
+func Test_Transfer_Get_400(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/transfers/abc", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Transfer_Get_404(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{err: transfers.TransferNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/transfers/1", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Transfer_GetAll_200(t *testing.T) {
+
+	router := setupTransferRouter(mockTransferService{transfers: []db.StockTransfer{expectedTransfer}})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/transfers?filter[to_section_id]=2", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := []db.StockTransfer{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, []db.StockTransfer{expectedTransfer}, responseData)
+}
+
+func transferRequestBody(request CreateTransferRequest) *bytes.Buffer {
+	jsonValue, _ := json.Marshal(request)
+	return bytes.NewBuffer(jsonValue)
+}
+
+func setupTransferRouter(mockService mockTransferService) *gin.Engine {
+	controller := NewTransferController(mockService)
+
+	router := gin.Default()
+	router.POST("/api/v1/transfers", controller.Create())
+	router.GET("/api/v1/transfers", controller.GetAll())
+	router.GET("/api/v1/transfers/:id", controller.Get())
+
+	return router
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
 	"github.com/gin-gonic/gin"
 )
@@ -40,7 +41,13 @@ func NewWarehouseController(warehouse warehouses.WarehouseService) *warehouseCon
 func (c *warehouseController) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 
-		warehouse, err := c.warehouseService.GetAll()
+		params, err := query.Parse(ctx.Request.URL.Query())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+			return
+		}
+
+		warehouse, err := c.warehouseService.GetAll(params)
 
 		if err != nil {
 			status := warehouseErrorHandler(err, ctx)
@@ -48,7 +55,7 @@ func (c *warehouseController) GetAll() gin.HandlerFunc {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, warehouse, ""))
+		ctx.JSON(http.StatusOK, web.NewPaginatedResponse(http.StatusOK, warehouse.Items, warehouse.Meta))
 	}
 }
 
@@ -149,8 +156,31 @@ func (c *warehouseController) Update() gin.HandlerFunc {
 	}
 }
 
+// GetUtilisation reports the fill percentage of each section of the
+// warehouse and of the warehouse, and which sections are below minimum.
+func (c *warehouseController) GetUtilisation() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, "warehouses id binding error"))
+			return
+		}
+
+		utilisation, err := c.warehouseService.GetUtilisation(id)
+		if err != nil {
+			status := warehouseErrorHandler(err, ctx)
+			ctx.JSON(status, web.NewResponse(status, nil, err.Error()))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, utilisation, ""))
+	}
+}
+
 func warehouseErrorHandler(err error, ctx *gin.Context) int {
 	switch err {
+	case query.ErrInvalidSort, query.ErrInvalidFilter, query.ErrInvalidCursor:
+		return http.StatusBadRequest
 	case warehouses.WarehouseNotFoundError:
 		return http.StatusNotFound
 	case warehouses.ExistsWarehouseCodeError:
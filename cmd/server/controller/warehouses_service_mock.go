@@ -2,6 +2,7 @@ package controller
 
 import (
 	database "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 
 )
 
@@ -10,11 +11,11 @@ type mockWarehouseService struct {
 	err    error
 }
 
-func (m mockWarehouseService) GetAll() ([]database.Warehouse, error) {
+func (m mockWarehouseService) GetAll(params query.Params) (query.Page[database.Warehouse], error) {
 	if m.err != nil {
-		return []database.Warehouse{}, m.err
+		return query.Page[database.Warehouse]{}, m.err
 	}
-	return m.result.([]database.Warehouse), nil
+	return query.NewPage(m.result.([]database.Warehouse), params)
 }
 
 func (m mockWarehouseService) Get(id uint64) (database.Warehouse, error) {
@@ -45,3 +46,10 @@ func (m mockWarehouseService) Update(id uint64, code string, address string, tel
 	}
 	return m.result.(database.Warehouse), nil
 }
+
+func (m mockWarehouseService) GetUtilisation(id uint64) (database.WarehouseUtilisation, error) {
+	if m.err != nil {
+		return database.WarehouseUtilisation{}, m.err
+	}
+	return m.result.(database.WarehouseUtilisation), nil
+}
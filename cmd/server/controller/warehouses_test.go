@@ -338,6 +338,55 @@ func decodeWarehouseWebResponse(response *httptest.ResponseRecorder, responseDat
 	json.Unmarshal(jsonData, &responseData)
 }
 
+func Test_Warehouse_GetUtilisation_200(t *testing.T) {
+
+	utilisation := database.WarehouseUtilisation{
+		WarehouseId:     1,
+		CurrentCapacity: 50,
+		MaximumCapacity: 200,
+		FillPercentage:  25,
+		Sections: []database.SectionUtilisation{
+			{SectionId: 1, SectionNumber: 1, CurrentCapacity: 50, MinimumCapacity: 10, MaximumCapacity: 100, FillPercentage: 50},
+			{SectionId: 2, SectionNumber: 2, CurrentCapacity: 0, MinimumCapacity: 10, MaximumCapacity: 100, BelowMinimum: true},
+		},
+		SectionsBelowMinimum: []uint64{2},
+	}
+
+	router := setupWarehouseRouter(mockWarehouseService{result: utilisation})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/warehouses/1/utilisation", nil)
+	router.ServeHTTP(response, request)
+
+	responseData := database.WarehouseUtilisation{}
+	decodeWebResponse(response, &responseData)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, utilisation, responseData)
+}
+
+func Test_Warehouse_GetUtilisation_404(t *testing.T) {
+
+	router := setupWarehouseRouter(mockWarehouseService{err: warehouses.WarehouseNotFoundError})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/warehouses/9/utilisation", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func Test_Warehouse_GetUtilisation_400(t *testing.T) {
+
+	router := setupWarehouseRouter(mockWarehouseService{})
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/warehouses/abc/utilisation", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
 func setupWarehouseRouter(mockService mockWarehouseService) *gin.Engine {
 	controller := NewWarehouseController(mockService)
 
@@ -347,6 +396,7 @@ func setupWarehouseRouter(mockService mockWarehouseService) *gin.Engine {
 	router.GET("/api/v1/warehouses/:id", controller.Get())
 	router.PATCH("/api/v1/warehouses/:id", controller.Update())
 	router.DELETE("/api/v1/warehouses/:id", controller.Delete())
+	router.GET("/api/v1/warehouses/:id/utilisation", controller.GetUtilisation())
 
 	return router
 }
\ No newline at end of file
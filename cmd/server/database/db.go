@@ -2,39 +2,88 @@ package database
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	MySQLDriver  = "mysql"
+	SQLiteDriver = "sqlite"
 )
 
 var StorageDB *sql.DB
 
 func Init() *sql.DB {
 
-	user := os.Getenv("MERCADO_FRESH_DATABASE_USER")
-	password := os.Getenv("MERCADO_FRESH_DATABASE_PASSWORD")
-	databaseName := os.Getenv("MERCADO_FRESH_DATABASE_NAME")
-	port := os.Getenv("MERCADO_FRESH_DATABASE_PORT")
+	driver := Driver()
 
-	connection := fmt.Sprintf(
-		"%s:%s@tcp(localhost%s)/%s",
-		user, password, port, databaseName,
-	)
+	var driverName, connection string
+	switch driver {
+	case MySQLDriver:
+		driverName, connection = "mysql", mysqlConnection()
+	case SQLiteDriver:
+		driverName, connection = "sqlite3", sqliteConnection()
+	default:
+		log.Fatalf("unsupported database driver %q (expected %s or %s)", driver, MySQLDriver, SQLiteDriver)
+	}
 
-	StorageDB, err := sql.Open("mysql", connection)
+	StorageDB, err := sql.Open(driverName, connection)
 	if err != nil {
 		panic(err)
 	}
 	if err = StorageDB.Ping(); err != nil {
 		panic(err)
 	}
-	log.Println("database configured")
+	log.Printf("database configured (%s)", driver)
 
 	return StorageDB
 }
 
+// Driver returns the storage driver chosen by MERCADO_FRESH_DATABASE_DRIVER,
+// defaulting to MySQL.
+func Driver() string {
+	driver := os.Getenv("MERCADO_FRESH_DATABASE_DRIVER")
+	if driver == "" {
+		return MySQLDriver
+	}
+	return driver
+}
+
+func mysqlConnection() string {
+
+	user := os.Getenv("MERCADO_FRESH_DATABASE_USER")
+	password := os.Getenv("MERCADO_FRESH_DATABASE_PASSWORD")
+	databaseName := os.Getenv("MERCADO_FRESH_DATABASE_NAME")
+	port := os.Getenv("MERCADO_FRESH_DATABASE_PORT")
+
+	host := os.Getenv("MERCADO_FRESH_DATABASE_HOST")
+	if host == "" {
+		host = "localhost"
+	}
+
+	return fmt.Sprintf(
+		"%s:%s@tcp(%s%s)/%s",
+		user, password, host, port, databaseName,
+	)
+}
+
+func sqliteConnection() string {
+
+	path := os.Getenv("MERCADO_FRESH_DATABASE_PATH")
+	if path == "" {
+		path = os.Getenv("MERCADO_FRESH_DATABASE_NAME") + ".db"
+	}
+
+	// SQLite ignores foreign keys unless asked, and a write transaction
+	// must lock the file up front to avoid deadlocking a concurrent one.
+	return fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000&_txlock=immediate", path)
+}
+
 type Seller struct {
 	Id          uint64 `json:"id"`
 	Cid         uint64 `json:"cid" binding:"required"`
@@ -54,6 +103,28 @@ type Warehouse struct {
 	LocalityID         string  `json:"locality_id" binding:"required"`
 }
 
+// SectionUtilisation is the capacity in use of a section, as a percentage
+// of its maximum capacity.
+type SectionUtilisation struct {
+	SectionId       uint64  `json:"section_id"`
+	SectionNumber   uint64  `json:"section_number"`
+	CurrentCapacity uint32  `json:"current_capacity"`
+	MinimumCapacity uint32  `json:"minimum_capacity"`
+	MaximumCapacity uint32  `json:"maximum_capacity"`
+	FillPercentage  float64 `json:"fill_percentage"`
+	BelowMinimum    bool    `json:"below_minimum"`
+}
+
+// WarehouseUtilisation sums the capacity of the sections of a warehouse.
+type WarehouseUtilisation struct {
+	WarehouseId          uint64               `json:"warehouse_id"`
+	CurrentCapacity      uint64               `json:"current_capacity"`
+	MaximumCapacity      uint64               `json:"maximum_capacity"`
+	FillPercentage       float64              `json:"fill_percentage"`
+	Sections             []SectionUtilisation `json:"sections"`
+	SectionsBelowMinimum []uint64             `json:"sections_below_minimum"`
+}
+
 type Section struct {
 	Id                 uint64  `json:"id"`
 	Number             uint64  `json:"section_number" binding:"required"`
@@ -168,6 +239,8 @@ type ProductRecord struct {
 	PurchasePrice  float32 `json:"purchase_price"`
 	SalePrice      float32 `json:"sale_price"`
 	ProductId      uint64  `json:"product_id"`
+	Promotion      bool    `json:"promotion"`
+	Margin         float32 `json:"margin"`
 }
 
 type InboundOrder struct {
@@ -177,6 +250,7 @@ type InboundOrder struct {
 	EmployeeId     uint64 `json:"employee_id"`
 	ProductBatchId uint64 `json:"product_batch_id"`
 	WarehouseId    uint64 `json:"warehouse_id"`
+	Quantity       uint64 `json:"quantity"`
 }
 
 type OrderStatus struct {
@@ -194,6 +268,15 @@ type PurchaseOrder struct {
 	ProductRecordId uint64 `json:"product_record_id"`
 }
 
+type PurchaseOrderStatusHistory struct {
+	Id              uint64 `json:"id"`
+	PurchaseOrderId uint64 `json:"purchase_order_id"`
+	FromStatusId    uint64 `json:"from_status_id"`
+	ToStatusId      uint64 `json:"to_status_id"`
+	Action          string `json:"action"`
+	ChangedAt       string `json:"changed_at"`
+}
+
 type OrderDetails struct {
 	Id                uint64  `json:"id"`
 	CleanLinessStatus string  `json:"clean_liness_status"`
@@ -211,3 +294,244 @@ type ReportInboundOrders struct {
 	WarehouseId        uint64 `json:"warehouse_id" binding:"required"`
 	InboundOrdersCount uint64 `json:"inbound_orders_count" binding:"required"`
 }
+
+type AuditEvent struct {
+	Id        uint64          `json:"id"`
+	Actor     string          `json:"actor"`
+	ActorRole string          `json:"actor_role"`
+	Method    string          `json:"method"`
+	Route     string          `json:"route"`
+	Entity    string          `json:"entity"`
+	EntityId  string          `json:"entity_id"`
+	Changes   json.RawMessage `json:"changes"`
+	CreatedAt string          `json:"created_at"`
+}
+
+type StockedBatch struct {
+	Id              uint64 `json:"id"`
+	Number          uint64 `json:"batch_number"`
+	DueDate         string `json:"due_date"`
+	CurrentQuantity uint64 `json:"current_quantity"`
+	SectionId       uint64 `json:"section_id"`
+	WarehouseId     uint64 `json:"warehouse_id"`
+}
+
+type Pick struct {
+	ProductBatchId uint64 `json:"product_batch_id"`
+	BatchNumber    uint64 `json:"batch_number"`
+	DueDate        string `json:"due_date"`
+	SectionId      uint64 `json:"section_id"`
+	WarehouseId    uint64 `json:"warehouse_id"`
+	Quantity       uint64 `json:"quantity"`
+}
+
+type PickList struct {
+	ProductId uint64 `json:"product_id"`
+	Quantity  uint64 `json:"quantity"`
+	Picks     []Pick `json:"picks"`
+}
+
+type ExpiringBatch struct {
+	Id                 uint64  `json:"id"`
+	Number             uint64  `json:"batch_number"`
+	ProductId          uint64  `json:"product_id"`
+	ProductDescription string  `json:"product_description"`
+	ExpirationRate     float32 `json:"expiration_rate"`
+	CurrentQuantity    uint64  `json:"current_quantity"`
+	DueDate            string  `json:"due_date"`
+	SectionId          uint64  `json:"-"`
+	SectionNumber      uint64  `json:"-"`
+	WarehouseId        uint64  `json:"-"`
+	WarehouseCode      string  `json:"-"`
+}
+
+type ExpiringSection struct {
+	SectionId     uint64          `json:"section_id"`
+	SectionNumber uint64          `json:"section_number"`
+	Batches       []ExpiringBatch `json:"batches"`
+}
+
+type ExpiringWarehouse struct {
+	WarehouseId   uint64            `json:"warehouse_id"`
+	WarehouseCode string            `json:"warehouse_code"`
+	Sections      []ExpiringSection `json:"sections"`
+}
+
+type ExpiryAlert struct {
+	Id              uint64 `json:"id"`
+	ProductBatchId  uint64 `json:"product_batch_id"`
+	DueDate         string `json:"due_date"`
+	CurrentQuantity uint64 `json:"current_quantity"`
+	Threshold       string `json:"threshold"`
+	CreatedAt       string `json:"created_at"`
+}
+
+type TemperatureReading struct {
+	Id          uint64  `json:"id"`
+	SectionId   uint64  `json:"section_id"`
+	RecordedAt  string  `json:"recorded_at"`
+	Temperature float32 `json:"temperature"`
+}
+
+type TemperatureRollup struct {
+	BucketStart string  `json:"bucket_start"`
+	Count       uint64  `json:"count"`
+	Minimum     float32 `json:"minimum"`
+	Maximum     float32 `json:"maximum"`
+	Average     float32 `json:"average"`
+}
+
+type TemperatureExcursion struct {
+	SectionId          uint64  `json:"section_id"`
+	ProductBatchId     uint64  `json:"product_batch_id,omitempty"`
+	MinimumTemperature float32 `json:"minimum_temperature"`
+	LowestTemperature  float32 `json:"lowest_temperature"`
+	StartedAt          string  `json:"started_at"`
+	EndedAt            string  `json:"ended_at,omitempty"`
+	DurationSeconds    uint64  `json:"duration_seconds"`
+	Ongoing            bool    `json:"ongoing"`
+}
+
+type StockTransfer struct {
+	Id             uint64 `json:"id"`
+	ProductBatchId uint64 `json:"product_batch_id"`
+	TargetBatchId  uint64 `json:"target_batch_id"`
+	FromSectionId  uint64 `json:"from_section_id"`
+	ToSectionId    uint64 `json:"to_section_id"`
+	Quantity       uint64 `json:"quantity"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type InventoryMovement struct {
+	Id             uint64 `json:"id"`
+	ProductBatchId uint64 `json:"product_batch_id"`
+	ProductId      uint64 `json:"product_id"`
+	SectionId      uint64 `json:"section_id"`
+	WarehouseId    uint64 `json:"warehouse_id"`
+	Type           string `json:"movement_type"`
+	Quantity       int64  `json:"quantity"`
+	Reason         string `json:"reason"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type StockLevel struct {
+	ProductId   uint64 `json:"product_id"`
+	WarehouseId uint64 `json:"warehouse_id"`
+	Quantity    int64  `json:"quantity"`
+}
+
+type BatchRecall struct {
+	Id             uint64 `json:"id"`
+	ProductBatchId uint64 `json:"product_batch_id"`
+	BatchNumber    uint64 `json:"batch_number"`
+	Reason         string `json:"reason"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type TraceReceipt struct {
+	InboundOrderId uint64 `json:"inbound_order_id"`
+	OrderNumber    string `json:"order_number"`
+	OrderDate      string `json:"order_date"`
+	Quantity       uint64 `json:"quantity"`
+	EmployeeId     uint64 `json:"employee_id"`
+	WarehouseId    uint64 `json:"warehouse_id"`
+	WarehouseCode  string `json:"warehouse_code"`
+}
+
+type TraceLocation struct {
+	ProductBatchId  uint64 `json:"product_batch_id"`
+	CurrentQuantity uint64 `json:"current_quantity"`
+	SectionId       uint64 `json:"section_id"`
+	SectionNumber   uint64 `json:"section_number"`
+	WarehouseId     uint64 `json:"warehouse_id"`
+	WarehouseCode   string `json:"warehouse_code"`
+}
+
+type TraceSale struct {
+	PurchaseOrderId uint64 `json:"purchase_order_id"`
+	OrderNumber     string `json:"order_number"`
+	OrderDate       string `json:"order_date"`
+	OrderStatusId   uint64 `json:"order_status_id"`
+	Quantity        uint64 `json:"quantity"`
+	BuyerId         uint64 `json:"buyer_id"`
+}
+
+type BatchTrace struct {
+	Batch     ProductBatch    `json:"batch"`
+	Product   Product         `json:"product"`
+	Seller    Seller          `json:"seller"`
+	Recall    *BatchRecall    `json:"recall"`
+	Receipts  []TraceReceipt  `json:"receipts"`
+	Locations []TraceLocation `json:"locations"`
+	Sales     []TraceSale     `json:"sales"`
+	Buyers    []Buyer         `json:"buyers"`
+}
+
+type RecallReport struct {
+	Recall    BatchRecall     `json:"recall"`
+	Locations []TraceLocation `json:"locations"`
+	Buyers    []Buyer         `json:"buyers"`
+}
+
+type ImportReport struct {
+	DryRun   bool             `json:"dry_run"`
+	Rows     int              `json:"rows"`
+	Imported int              `json:"imported"`
+	Ids      []uint64         `json:"ids"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// Amounts of the analytics reports are in cents, so they add up exactly.
+type RevenueReport struct {
+	Group        string `json:"group"`
+	Name         string `json:"name"`
+	Orders       uint64 `json:"orders"`
+	Units        uint64 `json:"units"`
+	RevenueCents int64  `json:"revenue_cents"`
+}
+
+type MarginReport struct {
+	Group        string  `json:"group"`
+	Name         string  `json:"name"`
+	Units        uint64  `json:"units"`
+	RevenueCents int64   `json:"revenue_cents"`
+	CostCents    int64   `json:"cost_cents"`
+	MarginCents  int64   `json:"margin_cents"`
+	MarginRate   float64 `json:"margin_rate"`
+}
+
+// Shipment hands a purchase order to a carrier, which delivers it from the
+// warehouse to the locality under the tracking code of the order.
+type Shipment struct {
+	Id              uint64 `json:"id"`
+	PurchaseOrderId uint64 `json:"purchase_order_id"`
+	CarrierId       uint64 `json:"carrier_id"`
+	WarehouseId     uint64 `json:"warehouse_id"`
+	LocalityId      string `json:"locality_id"`
+	TrackingCode    string `json:"tracking_code"`
+	CreatedAt       string `json:"created_at"`
+}
+
+// TrackingEvent is a scan of a shipment by its carrier.
+type TrackingEvent struct {
+	Id         uint64 `json:"id"`
+	ShipmentId uint64 `json:"shipment_id"`
+	Type       string `json:"event_type"`
+	Location   string `json:"location"`
+	OccurredAt string `json:"occurred_at"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// TrackingTimeline is the shipment of a purchase order with its scans in
+// the order they happened.
+type TrackingTimeline struct {
+	PurchaseOrderId uint64          `json:"purchase_order_id"`
+	OrderStatusId   uint64          `json:"order_status_id"`
+	Shipment        Shipment        `json:"shipment"`
+	Events          []TrackingEvent `json:"events"`
+}
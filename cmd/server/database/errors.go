@@ -0,0 +1,26 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
+)
+
+const mysqlDuplicateEntry = 1062
+
+// IsUniqueViolation tells whether err comes from a write that broke a
+// unique index, on either driver.
+func IsUniqueViolation(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlDuplicateEntry
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+	}
+
+	return false
+}
@@ -0,0 +1,362 @@
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Files holds one directory of migrations per supported driver. Both
+// directories must keep the same versions so the schemas stay in sync.
+//
+//go:embed mysql/*.sql sqlite/*.sql
+var Files embed.FS
+
+var (
+	ErrUnsupportedDriver    = errors.New("no migrations for database driver")
+	ErrInvalidMigrationName = errors.New("invalid migration file name")
+	ErrMissingDownMigration = errors.New("migration has no down file")
+	ErrNoMigrationToRevert  = errors.New("no migration to revert")
+)
+
+const (
+	CreateTrackingTableQuery = `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			applied_at VARCHAR(255) NOT NULL,
+			PRIMARY KEY (version)
+		)
+	`
+	AppliedVersionsQuery = "SELECT version, applied_at FROM schema_migrations ORDER BY version"
+	InsertVersionQuery   = "INSERT INTO schema_migrations(version, name, applied_at) VALUES(?, ?, ?)"
+	DeleteVersionQuery   = "DELETE FROM schema_migrations WHERE version = ?"
+)
+
+// Migration is a numbered pair of up/down SQL files, e.g.
+// 000003_add_column.up.sql and 000003_add_column.down.sql.
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   uint64 `json:"version"`
+	Name      string `json:"name"`
+	Applied   bool   `json:"applied"`
+	AppliedAt string `json:"applied_at,omitempty"`
+}
+
+type Migrator struct {
+	db     *sql.DB
+	source fs.FS
+}
+
+func NewMigrator(db *sql.DB, source fs.FS) *Migrator {
+	return &Migrator{
+		db:     db,
+		source: source,
+	}
+}
+
+// Up applies every pending migration in version order and returns the
+// migrations that were applied.
+func (m *Migrator) Up() ([]Migration, error) {
+	migrations, applied, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		err := m.apply(migration.Up, func(tx *sql.Tx) error {
+			_, err := tx.Exec(InsertVersionQuery, migration.Version, migration.Name, time.Now().UTC().Format(time.RFC3339))
+			return err
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		log.Printf("migration %d_%s applied", migration.Version, migration.Name)
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down reverts the most recently applied migration.
+func (m *Migrator) Down() (Migration, error) {
+	migrations, applied, err := m.load()
+	if err != nil {
+		return Migration{}, err
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		if migration.Down == "" {
+			return Migration{}, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, ErrMissingDownMigration)
+		}
+
+		err := m.apply(migration.Down, func(tx *sql.Tx) error {
+			_, err := tx.Exec(DeleteVersionQuery, migration.Version)
+			return err
+		})
+		if err != nil {
+			return Migration{}, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		log.Printf("migration %d_%s reverted", migration.Version, migration.Name)
+		return migration, nil
+	}
+
+	return Migration{}, ErrNoMigrationToRevert
+}
+
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	migrations, applied, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var status []MigrationStatus
+	for _, migration := range migrations {
+		appliedAt, ok := applied[migration.Version]
+		status = append(status, MigrationStatus{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			Applied:   ok,
+			AppliedAt: appliedAt,
+		})
+	}
+
+	return status, nil
+}
+
+// Source returns the embedded migrations written for the given driver.
+func Source(driver string) (fs.FS, error) {
+	if _, err := fs.Stat(Files, driver); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDriver, driver)
+	}
+	return fs.Sub(Files, driver)
+}
+
+func (m *Migrator) apply(script string, track func(tx *sql.Tx) error) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range splitStatements(script) {
+		if _, err := tx.Exec(statement); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := track(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) load() ([]Migration, map[uint64]string, error) {
+	if _, err := m.db.Exec(CreateTrackingTableQuery); err != nil {
+		return nil, nil, err
+	}
+
+	migrations, err := Parse(m.source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := m.db.Query(AppliedVersionsQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	applied := map[uint64]string{}
+	for rows.Next() {
+		var version uint64
+		var appliedAt string
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, nil, err
+		}
+		applied[version] = appliedAt
+	}
+
+	return migrations, applied, rows.Err()
+}
+
+// Parse reads every *.up.sql / *.down.sql file at the root of source and
+// returns the migrations sorted by version.
+func Parse(source fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(source, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, file := range files {
+		version, name, direction, err := parseFileName(path.Base(file))
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(source, file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != name {
+			return nil, fmt.Errorf("%w: %s (version %d is already used by %s)", ErrInvalidMigrationName, file, version, migration.Name)
+		}
+
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	var migrations []Migration
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("%w: version %d has no up file", ErrInvalidMigrationName, migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func parseFileName(file string) (uint64, string, string, error) {
+	base := strings.TrimSuffix(file, ".sql")
+
+	var direction string
+	switch {
+	case strings.HasSuffix(base, ".up"):
+		direction = "up"
+	case strings.HasSuffix(base, ".down"):
+		direction = "down"
+	default:
+		return 0, "", "", fmt.Errorf("%w: %s", ErrInvalidMigrationName, file)
+	}
+	base = strings.TrimSuffix(base, "."+direction)
+
+	parts := strings.SplitN(base, "_", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", "", fmt.Errorf("%w: %s", ErrInvalidMigrationName, file)
+	}
+
+	version, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || version == 0 {
+		return 0, "", "", fmt.Errorf("%w: %s", ErrInvalidMigrationName, file)
+	}
+
+	return version, parts[1], direction, nil
+}
+
+// splitStatements breaks a script into single statements, since the
+// drivers do not accept several statements in one Exec by default. A
+// semicolon only ends a statement outside of single, double or back quoted
+// text, where a doubled quote, or a backslash outside of back quotes,
+// escapes the quote, and outside of -- and /* */ comments. Comments are dropped, so a script ending in a comment does not
+// leave an empty statement behind.
+func splitStatements(script string) []string {
+	var statements []string
+	var statement strings.Builder
+
+	end := func() {
+		if text := strings.TrimSpace(statement.String()); text != "" {
+			statements = append(statements, text)
+		}
+		statement.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		switch char := script[i]; {
+		case char == '\'', char == '"', char == '`':
+			closing := closingQuote(script, i)
+			statement.WriteString(script[i : closing+1])
+			i = closing
+
+		case strings.HasPrefix(script[i:], "--"):
+			newline := strings.IndexByte(script[i:], '\n')
+			if newline < 0 {
+				i = len(script)
+			} else {
+				i += newline
+				statement.WriteByte('\n')
+			}
+
+		case strings.HasPrefix(script[i:], "/*"):
+			closing := strings.Index(script[i+2:], "*/")
+			if closing < 0 {
+				i = len(script)
+			} else {
+				i += closing + 3
+				statement.WriteByte(' ')
+			}
+
+		case char == ';':
+			end()
+
+		default:
+			statement.WriteByte(char)
+		}
+	}
+
+	end()
+
+	return statements
+}
+
+// closingQuote returns the index of the quote that closes the one at start,
+// or the last index of the script when it is never closed.
+func closingQuote(script string, start int) int {
+	quote := script[start]
+
+	for i := start + 1; i < len(script); i++ {
+		switch {
+		case script[i] == '\\' && quote != '`':
+			i++
+		case script[i] == quote && i+1 < len(script) && script[i+1] == quote:
+			i++
+		case script[i] == quote:
+			return i
+		}
+	}
+
+	return len(script) - 1
+}
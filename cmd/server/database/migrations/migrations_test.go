@@ -0,0 +1,237 @@
+package migrations
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Migrator_Up_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+
+	migrator := NewMigrator(database, TEST_MIGRATIONS)
+
+	applied, err := migrator.Up()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(applied))
+
+	_, err = database.Exec("INSERT INTO buyers(first_name, nickname) VALUES ('Carlos', 'Neto')")
+	assert.Nil(t, err)
+
+	applied, err = migrator.Up()
+	assert.Nil(t, err)
+	assert.Empty(t, applied)
+
+	util.DropDB(database)
+}
+
+func Test_Migrator_Down_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+
+	migrator := NewMigrator(database, TEST_MIGRATIONS)
+
+	_, err := migrator.Up()
+	assert.Nil(t, err)
+
+	reverted, err := migrator.Down()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), reverted.Version)
+
+	_, err = database.Exec("INSERT INTO buyers(first_name, nickname) VALUES ('Carlos', 'Neto')")
+	assert.NotNil(t, err)
+
+	reverted, err = migrator.Down()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), reverted.Version)
+
+	_, err = migrator.Down()
+	assert.Equal(t, ErrNoMigrationToRevert, err)
+
+	util.DropDB(database)
+}
+
+func Test_Migrator_Status_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+
+	migrator := NewMigrator(database, TEST_MIGRATIONS)
+
+	status, err := migrator.Status()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(status))
+	assert.False(t, status[0].Applied)
+	assert.False(t, status[1].Applied)
+
+	_, err = migrator.Up()
+	assert.Nil(t, err)
+
+	status, err = migrator.Status()
+	assert.Nil(t, err)
+	assert.True(t, status[0].Applied)
+	assert.Equal(t, "create_buyers", status[0].Name)
+	assert.True(t, status[1].Applied)
+	assert.Equal(t, "add_nickname", status[1].Name)
+
+	util.DropDB(database)
+}
+
+func Test_Migrator_Up_ShouldRollbackFailedMigration(t *testing.T) {
+
+	database := util.CreateDB()
+
+	source := fstest.MapFS{
+		"000001_create_buyers.up.sql": {Data: []byte("CREATE TABLE buyers (id INTEGER PRIMARY KEY, first_name TEXT NOT NULL);")},
+		"000002_broken.up.sql":        {Data: []byte("INSERT INTO buyers(first_name) VALUES ('Carlos'); INSERT INTO unknown_table VALUES (1);")},
+	}
+
+	migrator := NewMigrator(database, source)
+
+	applied, err := migrator.Up()
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, len(applied))
+
+	var count int
+	database.QueryRow("SELECT COUNT(*) FROM buyers").Scan(&count)
+	assert.Equal(t, 0, count)
+
+	status, _ := migrator.Status()
+	assert.False(t, status[1].Applied)
+
+	util.DropDB(database)
+}
+
+func Test_SplitStatements(t *testing.T) {
+
+	script := `
+		-- seeds the buyers; the names have semicolons
+		CREATE TABLE buyers(first_name TEXT, nickname TEXT);
+		INSERT INTO buyers VALUES ('Ana; Maria', 'it''s; me');
+		INSERT INTO buyers VALUES ("Bruno \"B;\"", 'a\';b');
+		/* one; more */ INSERT INTO ` + "`buyers`" + ` VALUES ('Carla', 'C');
+		-- trailing; comment
+	`
+
+	assert.Equal(t, []string{
+		"CREATE TABLE buyers(first_name TEXT, nickname TEXT)",
+		"INSERT INTO buyers VALUES ('Ana; Maria', 'it''s; me')",
+		`INSERT INTO buyers VALUES ("Bruno \"B;\"", 'a\';b')`,
+		"INSERT INTO `buyers` VALUES ('Carla', 'C')",
+	}, splitStatements(script))
+}
+
+func Test_Migrator_Up_ShouldRunStatementsWithSemicolonsInText(t *testing.T) {
+
+	database := util.CreateDB()
+
+	source := fstest.MapFS{
+		"000001_create_notes.up.sql": {Data: []byte(`
+			CREATE TABLE notes(text TEXT NOT NULL);
+			-- one row; with a semicolon
+			INSERT INTO notes VALUES ('keep; cold');
+		`)},
+		"000001_create_notes.down.sql": {Data: []byte("DROP TABLE notes;")},
+	}
+
+	_, err := NewMigrator(database, source).Up()
+	assert.Nil(t, err)
+
+	var text string
+	database.QueryRow("SELECT text FROM notes").Scan(&text)
+	assert.Equal(t, "keep; cold", text)
+
+	util.DropDB(database)
+}
+
+func Test_Parse_InvalidFileName(t *testing.T) {
+
+	source := fstest.MapFS{
+		"create_buyers.up.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	_, err := Parse(source)
+	assert.True(t, errors.Is(err, ErrInvalidMigrationName))
+}
+
+func Test_Parse_EmbeddedMigrations(t *testing.T) {
+
+	mysqlSource, err := Source("mysql")
+	assert.Nil(t, err)
+
+	sqliteSource, err := Source("sqlite")
+	assert.Nil(t, err)
+
+	mysqlMigrations, err := Parse(mysqlSource)
+	assert.Nil(t, err)
+
+	sqliteMigrations, err := Parse(sqliteSource)
+	assert.Nil(t, err)
+
+	assert.Equal(t, len(mysqlMigrations), len(sqliteMigrations))
+
+	for i, migration := range mysqlMigrations {
+		assert.Equal(t, uint64(i+1), migration.Version)
+		assert.NotEmpty(t, migration.Up)
+		assert.NotEmpty(t, migration.Down)
+		assert.Equal(t, migration.Version, sqliteMigrations[i].Version)
+		assert.Equal(t, migration.Name, sqliteMigrations[i].Name)
+		assert.NotEmpty(t, sqliteMigrations[i].Down)
+	}
+}
+
+func Test_Source_UnsupportedDriver(t *testing.T) {
+
+	_, err := Source("postgres")
+	assert.True(t, errors.Is(err, ErrUnsupportedDriver))
+}
+
+func Test_Migrator_SQLiteMigrations_UpAndDown(t *testing.T) {
+
+	database := util.CreateDB()
+
+	source, err := Source("sqlite")
+	assert.Nil(t, err)
+
+	migrator := NewMigrator(database, source)
+
+	applied, err := migrator.Up()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, applied)
+
+	var sellersCount int
+	err = database.QueryRow("SELECT COUNT(*) FROM sellers").Scan(&sellersCount)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, sellersCount)
+
+	for range applied {
+		_, err := migrator.Down()
+		assert.Nil(t, err)
+	}
+
+	_, err = database.Exec("SELECT * FROM sellers")
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+var TEST_MIGRATIONS = fstest.MapFS{
+	"000001_create_buyers.up.sql": {Data: []byte(`
+		CREATE TABLE buyers (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			first_name TEXT NOT NULL
+		);
+	`)},
+	"000001_create_buyers.down.sql": {Data: []byte("DROP TABLE buyers;")},
+	"000002_add_nickname.up.sql":    {Data: []byte("ALTER TABLE buyers ADD COLUMN nickname TEXT;")},
+	"000002_add_nickname.down.sql": {Data: []byte(`
+		CREATE TABLE buyers_backup (id INTEGER PRIMARY KEY AUTOINCREMENT, first_name TEXT NOT NULL);
+		INSERT INTO buyers_backup SELECT id, first_name FROM buyers;
+		DROP TABLE buyers;
+		ALTER TABLE buyers_backup RENAME TO buyers;
+	`)},
+}
@@ -0,0 +1,17 @@
+DELETE FROM order_details;
+DELETE FROM purchase_orders;
+DELETE FROM buyers;
+DELETE FROM order_status;
+DELETE FROM inbound_orders;
+DELETE FROM employees;
+DELETE FROM product_records;
+DELETE FROM product_batches;
+DELETE FROM sections;
+DELETE FROM products;
+DELETE FROM products_types;
+DELETE FROM warehouses;
+DELETE FROM carriers;
+DELETE FROM sellers;
+DELETE FROM localities;
+DELETE FROM provinces;
+DELETE FROM countries;
@@ -0,0 +1,17 @@
+INSERT INTO order_status(id, description)
+VALUES  (4, "Pendente"),
+        (5, "Entregue"),
+        (6, "Cancelado");
+
+CREATE TABLE `purchase_order_status_history`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  purchase_order_id BIGINT UNSIGNED NOT NULL,
+  from_status_id BIGINT UNSIGNED NOT NULL,
+  to_status_id BIGINT UNSIGNED NOT NULL,
+  action VARCHAR(255) NOT NULL,
+  changed_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id),
+  FOREIGN KEY (from_status_id) REFERENCES order_status(id),
+  FOREIGN KEY (to_status_id) REFERENCES order_status(id),
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
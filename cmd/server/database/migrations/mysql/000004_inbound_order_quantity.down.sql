@@ -0,0 +1 @@
+ALTER TABLE inbound_orders DROP COLUMN quantity;
@@ -0,0 +1,13 @@
+CREATE TABLE `audit_events`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  actor VARCHAR(255) NOT NULL,
+  actor_role VARCHAR(255) NOT NULL,
+  method VARCHAR(255) NOT NULL,
+  route VARCHAR(255) NOT NULL,
+  entity VARCHAR(255) NOT NULL,
+  entity_id VARCHAR(255) NOT NULL,
+  changes TEXT NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `audit_events_entity` (entity, entity_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,11 @@
+CREATE TABLE `expiry_alerts`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  product_batch_id BIGINT UNSIGNED NOT NULL,
+  due_date VARCHAR(255) NOT NULL,
+  current_quantity BIGINT UNSIGNED NOT NULL,
+  threshold VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `expiry_alerts_product_batch` (product_batch_id),
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,9 @@
+CREATE TABLE `temperature_readings`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  section_id BIGINT UNSIGNED NOT NULL,
+  recorded_at VARCHAR(255) NOT NULL,
+  temperature DECIMAL(19, 2) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `temperature_readings_section` (section_id, recorded_at),
+  FOREIGN KEY (section_id) REFERENCES sections(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
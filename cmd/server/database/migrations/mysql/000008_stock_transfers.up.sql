@@ -0,0 +1,14 @@
+CREATE TABLE `stock_transfers`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  product_batch_id BIGINT UNSIGNED NOT NULL,
+  target_batch_id BIGINT UNSIGNED NOT NULL,
+  from_section_id BIGINT UNSIGNED NOT NULL,
+  to_section_id BIGINT UNSIGNED NOT NULL,
+  quantity BIGINT UNSIGNED NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id),
+  FOREIGN KEY (target_batch_id) REFERENCES product_batches(id),
+  FOREIGN KEY (from_section_id) REFERENCES sections(id),
+  FOREIGN KEY (to_section_id) REFERENCES sections(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,10 @@
+CREATE TABLE `batch_recalls`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  product_batch_id BIGINT UNSIGNED NOT NULL,
+  batch_number BIGINT UNSIGNED NOT NULL,
+  reason VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `batch_recalls_batch_number` (batch_number),
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1 @@
+ALTER TABLE product_records DROP COLUMN promotion;
@@ -0,0 +1 @@
+ALTER TABLE product_records ADD COLUMN promotion BOOLEAN NOT NULL DEFAULT FALSE;
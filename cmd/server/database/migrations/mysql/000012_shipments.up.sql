@@ -0,0 +1,16 @@
+CREATE TABLE `shipments`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  purchase_order_id BIGINT UNSIGNED NOT NULL,
+  carrier_id BIGINT UNSIGNED NOT NULL,
+  warehouse_id BIGINT UNSIGNED NOT NULL,
+  locality_id VARCHAR(255) NOT NULL,
+  tracking_code VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `shipments_purchase_order_id` (purchase_order_id),
+  UNIQUE INDEX `shipments_tracking_code` (tracking_code),
+  FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id),
+  FOREIGN KEY (carrier_id) REFERENCES carriers(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id),
+  FOREIGN KEY (locality_id) REFERENCES localities(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
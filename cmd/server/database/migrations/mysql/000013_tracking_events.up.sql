@@ -0,0 +1,11 @@
+CREATE TABLE `tracking_events`(
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  shipment_id BIGINT UNSIGNED NOT NULL,
+  event_type VARCHAR(255) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  occurred_at VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `tracking_events_shipment_id` (shipment_id, occurred_at),
+  FOREIGN KEY (shipment_id) REFERENCES shipments(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS `order_details`;
+DROP TABLE IF EXISTS `purchase_orders`;
+DROP TABLE IF EXISTS `buyers`;
+DROP TABLE IF EXISTS `order_status`;
+DROP TABLE IF EXISTS `inbound_orders`;
+DROP TABLE IF EXISTS `employees`;
+DROP TABLE IF EXISTS `product_records`;
+DROP TABLE IF EXISTS `product_batches`;
+DROP TABLE IF EXISTS `sections`;
+DROP TABLE IF EXISTS `products`;
+DROP TABLE IF EXISTS `products_types`;
+DROP TABLE IF EXISTS `warehouses`;
+DROP TABLE IF EXISTS `carriers`;
+DROP TABLE IF EXISTS `sellers`;
+DROP TABLE IF EXISTS `localities`;
+DROP TABLE IF EXISTS `provinces`;
+DROP TABLE IF EXISTS `countries`;
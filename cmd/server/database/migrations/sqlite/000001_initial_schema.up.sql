@@ -0,0 +1,168 @@
+CREATE TABLE `countries` (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  country_name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE `provinces`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  province_name VARCHAR(255) NOT NULL,
+  id_country_fk BIGINT NOT NULL,
+  FOREIGN KEY (id_country_fk) REFERENCES countries(id)
+);
+
+CREATE TABLE `localities` (
+  id VARCHAR(255) NOT NULL,
+  locality_name VARCHAR(255) NOT NULL,
+  province_id BIGINT NOT NULL,
+  FOREIGN KEY (province_id) REFERENCES provinces(id),
+  PRIMARY KEY (`id`)
+);
+
+CREATE TABLE `sellers` (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  cid BIGINT UNIQUE NOT NULL,
+  company_name VARCHAR(255) NOT NULL,
+  address VARCHAR(255) NOT NULL,
+  telephone VARCHAR(255) NOT NULL,
+  locality_id VARCHAR(255) NOT NULL,
+  FOREIGN KEY (locality_id) REFERENCES localities(id)
+);
+
+CREATE TABLE `carriers` (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  cid VARCHAR(255) UNIQUE NOT NULL,
+  company_name VARCHAR(255) NOT NULL,
+  address VARCHAR(255) NOT NULL,
+  telephone VARCHAR(255) NOT NULL,
+  locality_id VARCHAR(255) NOT NULL,
+  FOREIGN KEY (locality_id) REFERENCES localities(id)
+);
+
+CREATE TABLE `warehouses` (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  address VARCHAR(255) NOT NULL,
+  telephone VARCHAR(255) NOT NULL,
+  warehouse_code VARCHAR(255) UNIQUE NOT NULL,
+  minimum_capacity BIGINT NOT NULL,
+  minimum_temperature DECIMAL(19, 2) NOT NULL,
+  locality_id VARCHAR(255) NOT NULL,
+  FOREIGN KEY (locality_id) REFERENCES localities(id)
+);
+
+CREATE TABLE `products_types` (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  description VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE `products`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  description VARCHAR(255) NOT NULL,
+  expiration_rate DECIMAL(19, 2) NOT NULL,
+  freezing_rate DECIMAL(19, 2) NOT NULL,
+  height DECIMAL(19, 2) NOT NULL,
+  length DECIMAL(19, 2) NOT NULL,
+  net_weight DECIMAL(19, 2) NOT NULL,
+  product_code VARCHAR(255) NOT NULL,
+  recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+  width DECIMAL(19, 2) NOT NULL,
+  product_type BIGINT NOT NULL,
+  seller_id BIGINT NOT NULL,
+  FOREIGN KEY (product_type) REFERENCES products_types(id),
+  FOREIGN KEY (seller_id) REFERENCES sellers(id)
+);
+
+CREATE TABLE `sections`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  section_number BIGINT NOT NULL,
+  current_capacity BIGINT NOT NULL,
+  current_temperature DECIMAL(19, 2) NOT NULL,
+  maximum_capacity BIGINT NOT NULL,
+  minimum_capacity BIGINT NOT NULL,
+  minimum_temperature DECIMAL(19, 2) NOT NULL,
+  product_type BIGINT NOT NULL,
+  warehouse_id BIGINT NOT NULL,
+  FOREIGN KEY (product_type) REFERENCES products_types(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
+
+CREATE TABLE `product_batches`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  batch_number BIGINT NOT NULL,
+  current_quantity BIGINT NOT NULL,
+  current_temperature DECIMAL(19, 2) NOT NULL,
+  due_date VARCHAR(255) NOT NULL,
+  initial_quantity BIGINT NOT NULL,
+  manufacturing_date VARCHAR(255) NOT NULL,
+  manufacturing_hour VARCHAR(255) NOT NULL,
+  minimum_temperature DECIMAL(19, 2) NOT NULL,
+  product_id BIGINT NOT NULL,
+  section_id BIGINT NOT NULL,
+  FOREIGN KEY (product_id) REFERENCES products(id),
+  FOREIGN KEY (section_id) REFERENCES sections(id)
+);
+
+CREATE TABLE `product_records`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  last_update_date VARCHAR(255) NOT NULL,
+  purchase_price DECIMAL(19, 2) NOT NULL,
+  sale_price DECIMAL(19, 2) NOT NULL,
+  product_id BIGINT NOT NULL,
+  FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+CREATE TABLE `employees`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  id_card_number VARCHAR(255) NOT NULL,
+  first_name VARCHAR(255) NOT NULL,
+  last_name VARCHAR(255) NOT NULL,
+  warehouse_id BIGINT NOT NULL,
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
+
+CREATE TABLE `inbound_orders`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  order_date VARCHAR(255) NOT NULL,
+  order_number VARCHAR(255) NOT NULL,
+  employee_id BIGINT NOT NULL,
+  product_batch_id BIGINT NOT NULL,
+  warehouse_id BIGINT NOT NULL,
+  FOREIGN KEY (employee_id) REFERENCES employees(id),
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
+
+CREATE TABLE `order_status`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  description VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE `buyers`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  id_card_number VARCHAR(255) NOT NULL,
+  first_name VARCHAR(255) NOT NULL,
+  last_name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE `purchase_orders`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  order_number VARCHAR(255) NOT NULL,
+  order_date VARCHAR(255) NOT NULL,
+  tracking_code VARCHAR(255) NOT NULL,
+  buyer_id BIGINT NOT NULL,
+  order_status_id BIGINT NOT NULL,
+  product_record_id BIGINT NOT NULL,
+  FOREIGN KEY (buyer_id) REFERENCES buyers(id),
+  FOREIGN KEY (order_status_id) REFERENCES order_status(id),
+  FOREIGN KEY (product_record_id) REFERENCES product_records(id)
+);
+
+CREATE TABLE `order_details`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  clean_liness_status VARCHAR(255) NOT NULL,
+  quantity BIGINT NOT NULL,
+  temperature DECIMAL(19, 2) NOT NULL,
+  product_record_id BIGINT NOT NULL,
+  purchase_order_id BIGINT NOT NULL,
+  FOREIGN KEY (product_record_id) REFERENCES product_records(id),
+  FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `purchase_order_status_history`;
+
+DELETE FROM order_status WHERE id IN (4, 5, 6);
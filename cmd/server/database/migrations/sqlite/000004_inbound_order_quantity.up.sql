@@ -0,0 +1 @@
+ALTER TABLE inbound_orders ADD COLUMN quantity BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+CREATE TABLE `expiry_alerts`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  product_batch_id BIGINT NOT NULL,
+  due_date VARCHAR(255) NOT NULL,
+  current_quantity BIGINT NOT NULL,
+  threshold VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id)
+);
+
+CREATE UNIQUE INDEX `expiry_alerts_product_batch` ON `expiry_alerts` (product_batch_id);
@@ -0,0 +1,9 @@
+CREATE TABLE `temperature_readings`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  section_id BIGINT NOT NULL,
+  recorded_at VARCHAR(255) NOT NULL,
+  temperature DECIMAL(19, 2) NOT NULL,
+  FOREIGN KEY (section_id) REFERENCES sections(id)
+);
+
+CREATE INDEX `temperature_readings_section` ON `temperature_readings` (section_id, recorded_at);
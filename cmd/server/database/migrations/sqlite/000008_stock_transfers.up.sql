@@ -0,0 +1,13 @@
+CREATE TABLE `stock_transfers`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  product_batch_id BIGINT NOT NULL,
+  target_batch_id BIGINT NOT NULL,
+  from_section_id BIGINT NOT NULL,
+  to_section_id BIGINT NOT NULL,
+  quantity BIGINT NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id),
+  FOREIGN KEY (target_batch_id) REFERENCES product_batches(id),
+  FOREIGN KEY (from_section_id) REFERENCES sections(id),
+  FOREIGN KEY (to_section_id) REFERENCES sections(id)
+);
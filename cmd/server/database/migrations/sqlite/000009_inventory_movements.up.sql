@@ -0,0 +1,22 @@
+CREATE TABLE `inventory_movements`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  product_batch_id BIGINT NOT NULL,
+  product_id BIGINT NOT NULL,
+  section_id BIGINT NOT NULL,
+  warehouse_id BIGINT NOT NULL,
+  movement_type VARCHAR(255) NOT NULL,
+  quantity BIGINT NOT NULL,
+  reason VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id),
+  FOREIGN KEY (product_id) REFERENCES products(id),
+  FOREIGN KEY (section_id) REFERENCES sections(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
+
+CREATE INDEX `inventory_movements_product_created_at` ON `inventory_movements` (product_id, created_at);
+
+INSERT INTO `inventory_movements`(product_batch_id, product_id, section_id, warehouse_id, movement_type, quantity, reason, created_at)
+SELECT pb.id, pb.product_id, pb.section_id, sc.warehouse_id, 'opening', pb.current_quantity, '', strftime('%Y-%m-%d %H:%M:%S', 'now')
+FROM product_batches pb JOIN sections sc ON sc.id = pb.section_id
+WHERE pb.current_quantity > 0;
@@ -0,0 +1,10 @@
+CREATE TABLE `batch_recalls`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  product_batch_id BIGINT NOT NULL,
+  batch_number BIGINT NOT NULL,
+  reason VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (product_batch_id) REFERENCES product_batches(id)
+);
+
+CREATE UNIQUE INDEX `batch_recalls_batch_number` ON `batch_recalls` (batch_number);
@@ -0,0 +1,16 @@
+CREATE TABLE `shipments`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  purchase_order_id BIGINT NOT NULL,
+  carrier_id BIGINT NOT NULL,
+  warehouse_id BIGINT NOT NULL,
+  locality_id VARCHAR(255) NOT NULL,
+  tracking_code VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id),
+  FOREIGN KEY (carrier_id) REFERENCES carriers(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id),
+  FOREIGN KEY (locality_id) REFERENCES localities(id)
+);
+
+CREATE UNIQUE INDEX `shipments_purchase_order_id` ON `shipments` (purchase_order_id);
+CREATE UNIQUE INDEX `shipments_tracking_code` ON `shipments` (tracking_code);
@@ -0,0 +1,11 @@
+CREATE TABLE `tracking_events`(
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shipment_id BIGINT NOT NULL,
+  event_type VARCHAR(255) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  occurred_at VARCHAR(255) NOT NULL,
+  created_at VARCHAR(255) NOT NULL,
+  FOREIGN KEY (shipment_id) REFERENCES shipments(id)
+);
+
+CREATE INDEX `tracking_events_shipment_id` ON `tracking_events` (shipment_id, occurred_at);
@@ -0,0 +1,12 @@
+package database
+
+import "database/sql"
+
+// Querier is the part of *sql.DB that the repositories use. *sql.Tx
+// implements it too, so the same repository can run inside a transaction.
+type Querier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Prepare(query string) (*sql.Stmt, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
@@ -1,25 +1,42 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"os"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/recalls"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/controller"
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/analytics"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/audit"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/buyers"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/employees"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/expiry"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/imports"
 	inboundorders "github.com/GuiTadeu/mercado-fresh-panic/internal/inboundOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/localities"
+	orderdetails "github.com/GuiTadeu/mercado-fresh-panic/internal/orderDetails"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/picking"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/productTypes"
 	productrecords "github.com/GuiTadeu/mercado-fresh-panic/internal/product_records"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/shipments"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/stock"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/telemetry"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/transfers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/openapi"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 )
@@ -31,165 +48,361 @@ func main() {
 		log.Fatal("Error to load .env", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "token" {
+		runToken(os.Args[2:])
+		return
+	}
+
 	storageDB := db.Init()
-	server := gin.Default()
 
-	sellerRepository, warehouseRepository, sectionRepository, productRepository, buyerRepository, employeeRepository, inboundOrderRepository, localityRepository, carrieRepository, batchesRepository, productRecordsRepository, purchaseOrdersRepository := buildRepositories(storageDB)
-
-	sellersHandlers(sellerRepository, server)
-	warehousesHandlers(warehouseRepository, server)
-	sectionHandlers(sectionRepository, server)
-	productHandlers(productRepository, server)
-	buyerHandlers(buyerRepository, server)
-	employeeHandlers(employeeRepository, server)
-	inboundOrderHandlers(inboundOrderRepository, employeeRepository, warehouseRepository, server)
-	localitiesHandlers(localityRepository, server)
-	carriersHandlers(carrieRepository, server)
-	productBatchesHandlers(batchesRepository, sectionRepository, productRepository, server)
-	productRecordsHandlers(productRecordsRepository, productRepository, server)
-	purchaseOrdersHandlers(purchaseOrdersRepository, server)
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrations(storageDB, os.Args[2:])
+		return
+	}
+
+	authenticator, err := auth.NewAuthenticatorFromEnv()
+	if err != nil {
+		log.Fatal("Error to configure authentication: ", err)
+	}
+
+	expiryConfig, err := expiry.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("Error to configure expiry alerts: ", err)
+	}
+
+	server := gin.Default()
+	docsHandlers(server)
+	server.Use(authenticator.Authenticate())
+
+	sellerRepository, warehouseRepository, sectionRepository, productRepository, buyerRepository, employeeRepository, localityRepository, carrieRepository, batchesRepository, productRecordsRepository, purchaseOrdersRepository, orderDetailsRepository := buildRepositories(storageDB)
+
+	inboundOrderUnitOfWork, batchesUnitOfWork, purchaseOrdersUnitOfWork, pickingUnitOfWork, telemetryUnitOfWork, transfersUnitOfWork, stockUnitOfWork, importUnitOfWork, shipmentsUnitOfWork, recallsUnitOfWork := buildUnitsOfWork(storageDB)
+
+	auditService := audit.NewAuditService(audit.NewAuditRepository(storageDB))
+
+	sellersHandlers(sellerRepository, auditService, server)
+	warehousesHandlers(warehouseRepository, auditService, server)
+	sectionHandlers(sectionRepository, auditService, server)
+	telemetryHandlers(telemetry.NewTelemetryRepository(storageDB), sectionRepository, telemetryUnitOfWork, server)
+	productHandlers(productRepository, auditService, server)
+	productTypesHandlers(productTypes.NewRepository(storageDB), auditService, server)
+	buyerHandlers(buyerRepository, auditService, server)
+	employeeHandlers(employeeRepository, auditService, server)
+	inboundOrderHandlers(inboundOrderUnitOfWork, employeeRepository, warehouseRepository, auditService, server)
+	localitiesHandlers(localityRepository, auditService, server)
+	carriersHandlers(carrieRepository, auditService, server)
+	shipmentHandlers(shipments.NewShipmentRepository(storageDB), carrieRepository, purchaseOrdersRepository, warehouseRepository, shipmentsUnitOfWork, auditService, server)
+	productBatchesHandlers(batchesRepository, batchesUnitOfWork, auditService, server)
+	pickingHandlers(pickingUnitOfWork, auditService, server)
+	transferHandlers(transfers.NewTransferRepository(storageDB), transfersUnitOfWork, auditService, server)
+	stockHandlers(ledger.NewLedgerRepository(storageDB), stockUnitOfWork, auditService, server)
+	importHandlers(importUnitOfWork, auditService, server)
+	recallHandlers(recalls.NewRecallRepository(storageDB), batchesRepository, productRepository, sellerRepository, recallsUnitOfWork, auditService, server)
+	expiryHandlers(expiry.NewExpiryRepository(storageDB), expiryConfig, server)
+	productRecordsHandlers(productRecordsRepository, productRepository, auditService, server)
+	purchaseOrdersHandlers(purchaseOrdersRepository, purchaseOrdersUnitOfWork, auditService, server)
+	orderDetailsHandlers(orderDetailsRepository, auditService, server)
+	analyticsHandlers(analytics.NewAnalyticsRepository(storageDB), server)
+	auditHandlers(auditService, server)
 
 	port := os.Getenv("MERCADO_FRESH_HOST_PORT")
 	server.Run(port)
 }
 
-func carriersHandlers(carrierRepository carries.CarrierRepository, server *gin.Engine) {
+// Every route but the docs needs an authenticated caller. Reads are open to all roles
+// unless stated otherwise; writes are limited per group below.
+var (
+	adminOnly          = auth.RequireRoles(auth.AdminRole)
+	warehouseStaffOnly = auth.RequireRoles(auth.AdminRole, auth.WarehouseOperatorRole)
+	sellersOnly        = auth.RequireRoles(auth.AdminRole, auth.SellerRole)
+	buyersOnly         = auth.RequireRoles(auth.AdminRole, auth.BuyerRole)
+	carriersOnly       = auth.RequireRoles(auth.AdminRole, auth.CarrierRole)
+)
+
+func carriersHandlers(carrierRepository carries.CarrierRepository, auditService audit.AuditService, server *gin.Engine) {
 	carrierService := carries.NewCarrierService(carrierRepository)
 	carrierController := controller.NewCarrierController(carrierService)
 
+	tracked := audit.TrackChanges(auditService, "carries", carrierService.Get)
+
 	CarrierGroup := server.Group("/api/v1/carries")
+	CarrierGroup.GET("/", carrierController.GetAll())
+	CarrierGroup.GET("/:id", carrierController.Get())
 	CarrierGroup.GET("/reportCarries", carrierController.GetAllCarrierInfo())
-	CarrierGroup.POST("/", carrierController.Create())
+	CarrierGroup.POST("/", adminOnly, tracked, carrierController.Create())
+	CarrierGroup.PATCH("/:id", adminOnly, tracked, carrierController.Update())
+	CarrierGroup.DELETE("/:id", adminOnly, tracked, carrierController.Delete())
+}
+
+func shipmentHandlers(
+	shipmentRepository shipments.ShipmentRepository,
+	carrierRepository carries.CarrierRepository,
+	purchaseOrdersRepository purchaseOrders.PurchaseOrdersRepository,
+	warehouseRepository warehouses.WarehouseRepository,
+	unitOfWork uow.UnitOfWork[shipments.Repositories],
+	auditService audit.AuditService,
+	server *gin.Engine,
+) {
+	shipmentService := shipments.NewShipmentService(shipmentRepository, carrierRepository, purchaseOrdersRepository, warehouseRepository, unitOfWork)
+	shipmentController := controller.NewShipmentController(shipmentService)
+
+	server.GET("/api/v1/shipments", shipmentController.GetAll())
+	server.GET("/api/v1/shipments/:id", shipmentController.Get())
+	server.POST("/api/v1/shipments", warehouseStaffOnly, audit.Track(auditService, "shipments"), shipmentController.Create())
+
+	// Scans come from the carriers in bulk, so they are not audited; the
+	// status changes they cause are kept in the purchase order history.
+	server.POST("/api/v1/tracking/:trackingCode/events", carriersOnly, shipmentController.RecordEvent())
+	server.GET("/api/v1/purchaseOrders/:id/tracking", shipmentController.GetTracking())
 }
 
-func sellersHandlers(sellerRepository sellers.Repository, server *gin.Engine) {
+func sellersHandlers(sellerRepository sellers.Repository, auditService audit.AuditService, server *gin.Engine) {
 	sellerService := sellers.NewService(sellerRepository)
 	sellerController := controller.NewSeller(sellerService)
 
+	tracked := audit.TrackChanges(auditService, "sellers", sellerService.FindOne)
+
 	sellerGroup := server.Group("/api/v1/sellers")
 	sellerGroup.GET("/", sellerController.FindAll())
 	sellerGroup.GET("/:id", sellerController.FindOne())
-	sellerGroup.POST("/", sellerController.Create())
-	sellerGroup.PATCH("/:id", sellerController.Update())
-	sellerGroup.DELETE("/:id", sellerController.Delete())
+	sellerGroup.POST("/", adminOnly, tracked, sellerController.Create())
+	sellerGroup.PATCH("/:id", adminOnly, tracked, sellerController.Update())
+	sellerGroup.DELETE("/:id", adminOnly, tracked, sellerController.Delete())
 }
 
-func warehousesHandlers(warehouseRepository warehouses.WarehouseRepository, server *gin.Engine) {
+func warehousesHandlers(warehouseRepository warehouses.WarehouseRepository, auditService audit.AuditService, server *gin.Engine) {
 	warehouseService := warehouses.NewService(warehouseRepository)
 	warehouseController := controller.NewWarehouseController(warehouseService)
 
+	tracked := audit.TrackChanges(auditService, "warehouses", warehouseService.Get)
+
 	warehouseGroup := server.Group("/api/v1/warehouses")
 	warehouseGroup.GET("/", warehouseController.GetAll())
 	warehouseGroup.GET("/:id", warehouseController.Get())
-	warehouseGroup.POST("/", warehouseController.Create())
-	warehouseGroup.PATCH("/:id", warehouseController.Update())
-	warehouseGroup.DELETE("/:id", warehouseController.Delete())
+	warehouseGroup.POST("/", warehouseStaffOnly, tracked, warehouseController.Create())
+	warehouseGroup.PATCH("/:id", warehouseStaffOnly, tracked, warehouseController.Update())
+	warehouseGroup.DELETE("/:id", adminOnly, tracked, warehouseController.Delete())
+	warehouseGroup.GET("/:id/utilisation", warehouseController.GetUtilisation())
+}
+
+// Readings come from sensors in bulk, so the ingestion is not audited.
+func telemetryHandlers(
+	telemetryRepository telemetry.TelemetryRepository,
+	sectionRepository sections.SectionRepository,
+	unitOfWork uow.UnitOfWork[telemetry.Repositories],
+	server *gin.Engine,
+) {
+	telemetryService := telemetry.NewTelemetryService(telemetryRepository, sectionRepository, unitOfWork)
+	telemetryController := controller.NewTelemetryController(telemetryService)
+
+	telemetryRoutes := server.Group("/api/v1/sections")
+	telemetryRoutes.POST("/:id/temperatures", warehouseStaffOnly, telemetryController.Create())
+	telemetryRoutes.GET("/:id/temperatures", telemetryController.GetHistory())
+	telemetryRoutes.GET("/:id/excursions", telemetryController.GetExcursions())
 }
 
-func productHandlers(productRepository products.ProductRepository, server *gin.Engine) {
+func productHandlers(productRepository products.ProductRepository, auditService audit.AuditService, server *gin.Engine) {
 
 	productService := products.NewProductService(productRepository)
 	productHandler := controller.NewProductController(productService)
 
+	tracked := audit.TrackChanges(auditService, "products", productService.Get)
+
 	productRoutes := server.Group("/api/v1/products")
 
 	productRoutes.GET("/", productHandler.GetAll())
 	productRoutes.GET("/:id", productHandler.Get())
 	productRoutes.GET("/reportrecords", productHandler.GetAllReportRecords())
-	productRoutes.POST("/", productHandler.Create())
-	productRoutes.PATCH("/:id", productHandler.Update())
-	productRoutes.DELETE("/:id", productHandler.Delete())
+	productRoutes.POST("/", sellersOnly, tracked, productHandler.Create())
+	productRoutes.PATCH("/:id", sellersOnly, tracked, productHandler.Update())
+	productRoutes.DELETE("/:id", sellersOnly, tracked, productHandler.Delete())
 }
 
-func productRecordsHandlers(productRecordsRepository productrecords.ProductRecordsRepository, productRepository products.ProductRepository, server *gin.Engine) {
+func productRecordsHandlers(productRecordsRepository productrecords.ProductRecordsRepository, productRepository products.ProductRepository, auditService audit.AuditService, server *gin.Engine) {
 
 	productRecordsService := productrecords.NewProductRecordsService(productRecordsRepository, productRepository)
 	productRecordsHandler := controller.NewProductRecordsController(productRecordsService)
 
 	productRecordsRoutes := server.Group("/api/v1/productRecords")
 
-	productRecordsRoutes.POST("/", productRecordsHandler.Create())
+	productRecordsRoutes.POST("/", sellersOnly, audit.Track(auditService, "productRecords"), productRecordsHandler.Create())
+
+	server.GET("/api/v1/products/:id/prices", productRecordsHandler.GetPrices())
+	server.GET("/api/v1/products/:id/prices/current", productRecordsHandler.GetPriceAt())
 }
 
-func sectionHandlers(sectionRepository sections.SectionRepository, server *gin.Engine) {
+func sectionHandlers(sectionRepository sections.SectionRepository, auditService audit.AuditService, server *gin.Engine) {
 
 	sectionService := sections.NewService(sectionRepository)
 	sectionHandler := controller.NewSectionController(sectionService)
 
+	tracked := audit.TrackChanges(auditService, "sections", sectionService.Get)
+
 	sectionRoutes := server.Group("/api/v1/sections")
 
 	sectionRoutes.GET("/", sectionHandler.GetAll())
 	sectionRoutes.GET("/:id", sectionHandler.Get())
-	sectionRoutes.POST("/", sectionHandler.Create())
-	sectionRoutes.PATCH("/:id", sectionHandler.Update())
-	sectionRoutes.DELETE("/:id", sectionHandler.Delete())
+	sectionRoutes.POST("/", warehouseStaffOnly, tracked, sectionHandler.Create())
+	sectionRoutes.PATCH("/:id", warehouseStaffOnly, tracked, sectionHandler.Update())
+	sectionRoutes.DELETE("/:id", warehouseStaffOnly, tracked, sectionHandler.Delete())
 }
 
-func employeeHandlers(employeeRepository employees.EmployeeRepository, server *gin.Engine) {
+func employeeHandlers(employeeRepository employees.EmployeeRepository, auditService audit.AuditService, server *gin.Engine) {
 
 	employeeService := employees.NewEmployeeService(employeeRepository)
 	employeeHandler := controller.NewEmployeeController(employeeService)
 
-	employeeRoutes := server.Group("/api/v1/employees")
+	tracked := audit.TrackChanges(auditService, "employees", employeeService.Get)
+
+	employeeRoutes := server.Group("/api/v1/employees", warehouseStaffOnly)
 
 	employeeRoutes.GET("/", employeeHandler.GetAll())
-	employeeRoutes.POST("/", employeeHandler.Create())
-	employeeRoutes.DELETE("/:id", employeeHandler.Delete())
+	employeeRoutes.POST("/", adminOnly, tracked, employeeHandler.Create())
+	employeeRoutes.DELETE("/:id", adminOnly, tracked, employeeHandler.Delete())
 	employeeRoutes.GET("/:id", employeeHandler.Get())
-	employeeRoutes.PATCH("/:id", employeeHandler.Update())
+	employeeRoutes.PATCH("/:id", adminOnly, tracked, employeeHandler.Update())
 	employeeRoutes.GET("/reportInboundOrders", employeeHandler.CountInboundOrders())
 }
 
-func inboundOrderHandlers(inboundOrderRepository inboundorders.InboundOrderRepository, employeeRepository employees.EmployeeRepository, warehouseRepository warehouses.WarehouseRepository, server *gin.Engine) {
+func inboundOrderHandlers(inboundOrderUnitOfWork uow.UnitOfWork[inboundorders.Repositories], employeeRepository employees.EmployeeRepository, warehouseRepository warehouses.WarehouseRepository, auditService audit.AuditService, server *gin.Engine) {
 
-	inboundOrderService := inboundorders.NewInboundOrderService(employeeRepository, warehouseRepository, inboundOrderRepository)
+	inboundOrderService := inboundorders.NewInboundOrderService(employeeRepository, warehouseRepository, inboundOrderUnitOfWork)
 
 	cInboundOrders := controller.NewInboundOrderController(inboundOrderService)
 
-	inboundOrderRoutes := server.Group("/api/v1/inboundOrders")
+	inboundOrderRoutes := server.Group("/api/v1/inboundOrders", warehouseStaffOnly)
 
-	inboundOrderRoutes.POST("/", cInboundOrders.Create())
+	inboundOrderRoutes.POST("/", audit.Track(auditService, "inboundOrders"), cInboundOrders.Create())
 }
 
-func buyerHandlers(buyerRepository buyers.BuyerRepository, server *gin.Engine) {
+func buyerHandlers(buyerRepository buyers.BuyerRepository, auditService audit.AuditService, server *gin.Engine) {
 
 	sBuyers := buyers.NewBuyerService(buyerRepository)
 	cBuyers := controller.NewBuyerController(sBuyers)
 
+	tracked := audit.TrackChanges(auditService, "buyers", sBuyers.Get)
+
 	buyerRoutes := server.Group("/api/v1/buyers")
 
 	buyerRoutes.GET("/reportPurchaseOrders", cBuyers.CountPurchaseOrdersByBuyers())
 	buyerRoutes.GET("/", cBuyers.GetAll())
 	buyerRoutes.GET("/:id", cBuyers.Get())
-	buyerRoutes.POST("/", cBuyers.Create())
-	buyerRoutes.PATCH("/:id", cBuyers.Update())
-	buyerRoutes.DELETE("/:id", cBuyers.Delete())
+	buyerRoutes.POST("/", adminOnly, tracked, cBuyers.Create())
+	buyerRoutes.PATCH("/:id", adminOnly, tracked, cBuyers.Update())
+	buyerRoutes.DELETE("/:id", adminOnly, tracked, cBuyers.Delete())
 }
 
-func localitiesHandlers(localityRepository localities.Repository, server *gin.Engine) {
+func localitiesHandlers(localityRepository localities.Repository, auditService audit.AuditService, server *gin.Engine) {
 	localityService := localities.NewService(localityRepository)
 	localityController := controller.NewLocality(localityService)
 
 	localityGroup := server.Group("/api/v1/localities")
-	localityGroup.POST("/", localityController.Create())
+	localityGroup.POST("/", adminOnly, audit.Track(auditService, "localities"), localityController.Create())
 	localityGroup.GET("/reportSellers", localityController.GetLocalityInfo())
+	localityGroup.GET("/reportGeography", localityController.GetGeoReport())
+}
+
+func productTypesHandlers(productTypeRepository productTypes.Repository, auditService audit.AuditService, server *gin.Engine) {
+	productTypeService := productTypes.NewService(productTypeRepository)
+	productTypeController := controller.NewProductType(productTypeService)
+
+	tracked := audit.TrackChanges(auditService, "products_types", productTypeService.FindOne)
+
+	productTypeGroup := server.Group("/api/v1/productTypes")
+	productTypeGroup.GET("/", productTypeController.FindAll())
+	productTypeGroup.GET("/:id", productTypeController.FindOne())
+	productTypeGroup.POST("/", adminOnly, tracked, productTypeController.Create())
+	productTypeGroup.PATCH("/:id", adminOnly, tracked, productTypeController.Update())
+	productTypeGroup.DELETE("/:id", adminOnly, tracked, productTypeController.Delete())
 }
 
 func productBatchesHandlers(
 	pbr batches.ProductBatchRepository,
-	sr sections.SectionRepository,
-	pr products.ProductRepository,
+	unitOfWork uow.UnitOfWork[batches.Repositories],
+	auditService audit.AuditService,
 	server *gin.Engine,
 ) {
-	batchesService := batches.NewProductBatchesService(pbr, sr, pr)
+	batchesService := batches.NewProductBatchesService(pbr, unitOfWork)
 	batchesController := controller.NewProductBatchController(batchesService)
 
-	batchesGroup := server.Group("/api/v1/productBatches")
-	batchesGroup.POST("/", batchesController.Create())
+	batchesGroup := server.Group("/api/v1/productBatches", warehouseStaffOnly)
+	batchesGroup.POST("/", audit.Track(auditService, "productBatches"), batchesController.Create())
 
 	server.GET("/api/v1/sections/reportProducts", batchesController.CountProductsBySections())
 }
 
+func pickingHandlers(unitOfWork uow.UnitOfWork[picking.Repositories], auditService audit.AuditService, server *gin.Engine) {
+	pickingService := picking.NewPickingService(unitOfWork)
+	pickingController := controller.NewPickingController(pickingService)
+
+	server.POST("/api/v1/pickings", warehouseStaffOnly, audit.Track(auditService, "pickings"), pickingController.Create())
+}
+
+func transferHandlers(
+	transferRepository transfers.TransferRepository,
+	unitOfWork uow.UnitOfWork[transfers.Repositories],
+	auditService audit.AuditService,
+	server *gin.Engine,
+) {
+	transferService := transfers.NewTransferService(transferRepository, unitOfWork)
+	transferController := controller.NewTransferController(transferService)
+
+	server.GET("/api/v1/transfers", transferController.GetAll())
+	server.GET("/api/v1/transfers/:id", transferController.Get())
+	server.POST("/api/v1/transfers", warehouseStaffOnly, audit.Track(auditService, "transfers"), transferController.Create())
+}
+
+func stockHandlers(
+	ledgerRepository ledger.LedgerRepository,
+	unitOfWork uow.UnitOfWork[stock.Repositories],
+	auditService audit.AuditService,
+	server *gin.Engine,
+) {
+	stockService := stock.NewStockService(ledgerRepository, unitOfWork)
+	stockController := controller.NewStockController(stockService)
+
+	server.GET("/api/v1/stock", stockController.GetStock())
+	server.GET("/api/v1/stock/movements", stockController.GetMovements())
+	server.POST("/api/v1/stock/adjustments", warehouseStaffOnly, audit.Track(auditService, "stockAdjustments"), stockController.CreateAdjustment())
+}
+
+// Imports are audited once per file, with the report as the new state.
+func importHandlers(unitOfWork uow.UnitOfWork[imports.Repositories], auditService audit.AuditService, server *gin.Engine) {
+	importService := imports.NewImportService(unitOfWork)
+	importController := controller.NewImportController(importService)
+
+	server.POST("/api/v1/products/import", sellersOnly, audit.Track(auditService, "productImports"), importController.Products())
+	server.POST("/api/v1/sellers/import", adminOnly, audit.Track(auditService, "sellerImports"), importController.Sellers())
+	server.POST("/api/v1/warehouses/import", warehouseStaffOnly, audit.Track(auditService, "warehouseImports"), importController.Warehouses())
+}
+
+func recallHandlers(
+	recallRepository recalls.RecallRepository,
+	batchRepository batches.ProductBatchRepository,
+	productRepository products.ProductRepository,
+	sellerRepository sellers.Repository,
+	unitOfWork uow.UnitOfWork[recalls.Repositories],
+	auditService audit.AuditService,
+	server *gin.Engine,
+) {
+	recallService := recalls.NewRecallService(recallRepository, batchRepository, productRepository, sellerRepository, unitOfWork)
+	recallController := controller.NewRecallController(recallService)
+
+	server.GET("/api/v1/productBatches/:id/trace", recallController.Trace())
+	server.POST("/api/v1/productBatches/:id/recall", sellersOnly, audit.Track(auditService, "batchRecalls"), recallController.Recall())
+}
+
+func expiryHandlers(expiryRepository expiry.ExpiryRepository, config expiry.Config, server *gin.Engine) {
+	expiryService := expiry.NewExpiryService(expiryRepository)
+	expiryController := controller.NewExpiryController(expiryService, config.Threshold)
+
+	expiry.StartAlerts(context.Background(), expiryService, config)
+
+	expiryGroup := server.Group("/api/v1/productBatches")
+	expiryGroup.GET("/expiring", expiryController.GetExpiring())
+	expiryGroup.GET("/expiryAlerts", expiryController.GetAlerts())
+}
+
 func buildRepositories(storageDB *sql.DB) (
 	sellers.Repository,
 	warehouses.WarehouseRepository,
@@ -197,12 +410,12 @@ func buildRepositories(storageDB *sql.DB) (
 	products.ProductRepository,
 	buyers.BuyerRepository,
 	employees.EmployeeRepository,
-	inboundorders.InboundOrderRepository,
 	localities.Repository,
 	carries.CarrierRepository,
 	batches.ProductBatchRepository,
 	productrecords.ProductRecordsRepository,
-	purchaseOrders.PurchaseOrdersRepository) {
+	purchaseOrders.PurchaseOrdersRepository,
+	orderdetails.OrderDetailsRepository) {
 
 	sellerRepository := sellers.NewRepository(storageDB)
 	warehouseRepository := warehouses.NewRepository(storageDB)
@@ -210,22 +423,177 @@ func buildRepositories(storageDB *sql.DB) (
 	productRepository := products.NewProductRepository(storageDB)
 	buyerRepository := buyers.NewBuyerRepository(storageDB)
 	employeeRepository := employees.NewRepository(storageDB)
-	inboundOrderRepository := inboundorders.NewRepository(storageDB)
 	localityRepository := localities.NewRepository(storageDB)
 	carrieRepository := carries.NewCarrierRepository(storageDB)
 	productRecordsRepository := productrecords.NewProductRecordsRepository(storageDB)
 	productBatchesRepository := batches.NewProductBatchRepository(storageDB)
 	purchaseOrdersRepository := purchaseOrders.NewPurchaseOrdersRepository(storageDB)
+	orderDetailsRepository := orderdetails.NewOrderDetailsRepository(storageDB)
 
-	return sellerRepository, warehouseRepository, sectionRepository, productRepository, buyerRepository, employeeRepository, inboundOrderRepository, localityRepository, carrieRepository, productBatchesRepository, productRecordsRepository, purchaseOrdersRepository
+	return sellerRepository, warehouseRepository, sectionRepository, productRepository, buyerRepository, employeeRepository, localityRepository, carrieRepository, productBatchesRepository, productRecordsRepository, purchaseOrdersRepository, orderDetailsRepository
 }
 
-func purchaseOrdersHandlers(purchaseOrdersRepository purchaseOrders.PurchaseOrdersRepository, server *gin.Engine) {
-	purchaseOrderService := purchaseOrders.NewPurchaseOrdersService(purchaseOrdersRepository)
+// buildUnitsOfWork wires the repositories that services run inside a single
+// transaction.
+func buildUnitsOfWork(storageDB *sql.DB) (
+	uow.UnitOfWork[inboundorders.Repositories],
+	uow.UnitOfWork[batches.Repositories],
+	uow.UnitOfWork[purchaseOrders.Repositories],
+	uow.UnitOfWork[picking.Repositories],
+	uow.UnitOfWork[telemetry.Repositories],
+	uow.UnitOfWork[transfers.Repositories],
+	uow.UnitOfWork[stock.Repositories],
+	uow.UnitOfWork[imports.Repositories],
+	uow.UnitOfWork[shipments.Repositories],
+	uow.UnitOfWork[recalls.Repositories],
+) {
+	inboundOrderUnitOfWork := uow.New(storageDB, func(q db.Querier) inboundorders.Repositories {
+		return inboundorders.Repositories{
+			InboundOrders:  inboundorders.NewRepository(q),
+			ProductBatches: batches.NewProductBatchRepository(q),
+			Sections:       sections.NewRepository(q),
+			Ledger:         ledger.NewLedgerRepository(q),
+		}
+	})
+
+	batchesUnitOfWork := uow.New(storageDB, func(q db.Querier) batches.Repositories {
+		return batches.Repositories{
+			ProductBatches: batches.NewProductBatchRepository(q),
+			Sections:       sections.NewRepository(q),
+			Products:       products.NewProductRepository(q),
+			Ledger:         ledger.NewLedgerRepository(q),
+		}
+	})
+
+	purchaseOrdersUnitOfWork := uow.New(storageDB, func(q db.Querier) purchaseOrders.Repositories {
+		return purchaseOrders.Repositories{
+			PurchaseOrders: purchaseOrders.NewPurchaseOrdersRepository(q),
+		}
+	})
+
+	pickingUnitOfWork := uow.New(storageDB, func(q db.Querier) picking.Repositories {
+		return picking.Repositories{
+			Picking:        picking.NewPickingRepository(q),
+			ProductBatches: batches.NewProductBatchRepository(q),
+			Sections:       sections.NewRepository(q),
+			Products:       products.NewProductRepository(q),
+			Ledger:         ledger.NewLedgerRepository(q),
+		}
+	})
+
+	telemetryUnitOfWork := uow.New(storageDB, func(q db.Querier) telemetry.Repositories {
+		return telemetry.Repositories{
+			Telemetry:      telemetry.NewTelemetryRepository(q),
+			Sections:       sections.NewRepository(q),
+			ProductBatches: batches.NewProductBatchRepository(q),
+		}
+	})
+
+	transfersUnitOfWork := uow.New(storageDB, func(q db.Querier) transfers.Repositories {
+		return transfers.Repositories{
+			Transfers:      transfers.NewTransferRepository(q),
+			ProductBatches: batches.NewProductBatchRepository(q),
+			Sections:       sections.NewRepository(q),
+			Products:       products.NewProductRepository(q),
+			Ledger:         ledger.NewLedgerRepository(q),
+		}
+	})
+
+	stockUnitOfWork := uow.New(storageDB, func(q db.Querier) stock.Repositories {
+		return stock.Repositories{
+			Ledger:         ledger.NewLedgerRepository(q),
+			ProductBatches: batches.NewProductBatchRepository(q),
+			Sections:       sections.NewRepository(q),
+		}
+	})
+
+	importUnitOfWork := uow.New(storageDB, func(q db.Querier) imports.Repositories {
+		return imports.Repositories{
+			Products:   products.NewProductRepository(q),
+			Sellers:    sellers.NewRepository(q),
+			Warehouses: warehouses.NewRepository(q),
+		}
+	})
+
+	shipmentsUnitOfWork := uow.New(storageDB, func(q db.Querier) shipments.Repositories {
+		return shipments.Repositories{
+			Shipments:      shipments.NewShipmentRepository(q),
+			PurchaseOrders: purchaseOrders.NewPurchaseOrdersRepository(q),
+		}
+	})
+
+	recallsUnitOfWork := uow.New(storageDB, func(q db.Querier) recalls.Repositories {
+		return recalls.Repositories{
+			Recalls:        recalls.NewRecallRepository(q),
+			ProductBatches: batches.NewProductBatchRepository(q),
+		}
+	})
+
+	return inboundOrderUnitOfWork, batchesUnitOfWork, purchaseOrdersUnitOfWork, pickingUnitOfWork, telemetryUnitOfWork, transfersUnitOfWork, stockUnitOfWork, importUnitOfWork, shipmentsUnitOfWork, recallsUnitOfWork
+}
+
+func purchaseOrdersHandlers(purchaseOrdersRepository purchaseOrders.PurchaseOrdersRepository, unitOfWork uow.UnitOfWork[purchaseOrders.Repositories], auditService audit.AuditService, server *gin.Engine) {
+	purchaseOrderService := purchaseOrders.NewPurchaseOrdersService(purchaseOrdersRepository, unitOfWork)
 	purchaseOrderHandler := controller.NewPurchaseOrderController(purchaseOrderService)
 
+	tracked := audit.TrackChanges(auditService, "purchaseOrders", purchaseOrdersRepository.Get)
+
 	purchaseOrderRoutes := server.Group("/api/v1/")
 
-	purchaseOrderRoutes.POST("/purchaseOrders", purchaseOrderHandler.Create())
+	purchaseOrderRoutes.POST("/purchaseOrders", buyersOnly, tracked, purchaseOrderHandler.Create())
+	purchaseOrderRoutes.POST("/purchaseOrders/:id/transitions", warehouseStaffOnly, tracked, purchaseOrderHandler.Transition())
+	purchaseOrderRoutes.GET("/purchaseOrders/:id/transitions", purchaseOrderHandler.GetStatusHistory())
+}
+
+func orderDetailsHandlers(orderDetailsRepository orderdetails.OrderDetailsRepository, auditService audit.AuditService, server *gin.Engine) {
+	orderDetailsService := orderdetails.NewOrderDetailsService(orderDetailsRepository)
+	orderDetailsHandler := controller.NewOrderDetailsController(orderDetailsService)
+
+	tracked := audit.TrackChanges(auditService, "orderDetails", orderDetailsService.Get)
+
+	orderDetailsRoutes := server.Group("/api/v1/orderDetails")
+
+	orderDetailsRoutes.GET("/", orderDetailsHandler.GetAll())
+	orderDetailsRoutes.GET("/:id", orderDetailsHandler.Get())
+	orderDetailsRoutes.POST("/", buyersOnly, tracked, orderDetailsHandler.Create())
+	orderDetailsRoutes.PATCH("/:id", buyersOnly, tracked, orderDetailsHandler.Update())
+	orderDetailsRoutes.DELETE("/:id", buyersOnly, tracked, orderDetailsHandler.Delete())
+
+	server.GET("/api/v1/purchaseOrders/:id/details", orderDetailsHandler.GetByPurchaseOrderId())
+}
+
+// Analytics span every seller, so they are kept to admins.
+func analyticsHandlers(analyticsRepository analytics.AnalyticsRepository, server *gin.Engine) {
+	analyticsService := analytics.NewAnalyticsService(analyticsRepository)
+	analyticsController := controller.NewAnalyticsController(analyticsService)
+
+	analyticsGroup := server.Group("/api/v1/analytics", adminOnly)
+	analyticsGroup.GET("/revenue", analyticsController.Revenue())
+	analyticsGroup.GET("/margins", analyticsController.Margins())
+}
+
+func auditHandlers(auditService audit.AuditService, server *gin.Engine) {
+	auditController := controller.NewAuditController(auditService)
+
+	server.GET("/api/v1/audit", adminOnly, auditController.GetAll())
+}
+
+// docsHandlers are registered before the authentication middleware, so the
+// specification and its page are public.
+func docsHandlers(server *gin.Engine) {
+	registry := openapi.NewRegistry()
+	controller.RegisterOperations(registry)
+	registry.Add(
+		openapi.Operation{Method: "GET", Path: "/api/v1/openapi.json", Tag: "docs", Summary: "OpenAPI specification of this API", Public: true},
+		openapi.Operation{Method: "GET", Path: "/api/v1/docs", Tag: "docs", Summary: "Page that renders the specification", Public: true},
+	)
+
+	info := openapi.Info{
+		Title:       "Mercado Fresh API",
+		Version:     "1.0.0",
+		Description: "Every response is wrapped in {\"data\": ...}, errors in {\"error\": \"...\"}.",
+	}
 
+	server.GET("/api/v1/openapi.json", registry.Handler(info, server.Routes))
+	server.GET("/api/v1/docs", openapi.DocsHandler("/api/v1/openapi.json"))
 }
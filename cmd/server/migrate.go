@@ -0,0 +1,57 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database/migrations"
+)
+
+const migrateUsage = "usage: server migrate up|down|status"
+
+func runMigrations(storageDB *sql.DB, args []string) {
+	if len(args) != 1 {
+		log.Fatal(migrateUsage)
+	}
+
+	source, err := migrations.Source(db.Driver())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	migrator := migrations.NewMigrator(storageDB, source)
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%d migration(s) applied\n", len(applied))
+
+	case "down":
+		reverted, err := migrator.Down()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("migration %d_%s reverted\n", reverted.Version, reverted.Name)
+
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, migration := range status {
+			state := "pending"
+			if migration.Applied {
+				state = "applied at " + migration.AppliedAt
+			}
+			fmt.Printf("%06d_%s\t%s\n", migration.Version, migration.Name, state)
+		}
+
+	default:
+		log.Fatal(migrateUsage)
+	}
+}
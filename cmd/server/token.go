@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+)
+
+const (
+	tokenUsage    = "usage: server token <subject> <role> [seller_id|carrier_id]"
+	tokenLifetime = 24 * time.Hour
+)
+
+// runToken prints a JWT signed with MERCADO_FRESH_JWT_KEY_FILE, so tokens
+// can be issued locally without an identity provider.
+func runToken(args []string) {
+	if len(args) < 2 || len(args) > 3 {
+		log.Fatal(tokenUsage)
+	}
+
+	tokens, err := auth.TokensFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	now := time.Now()
+	claims := auth.Claims{
+		Principal: auth.Principal{Subject: args[0], Role: auth.Role(args[1])},
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(tokenLifetime).Unix(),
+	}
+
+	if len(args) == 3 {
+		id, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			log.Fatal(tokenUsage)
+		}
+		claims.SetScope(id)
+	}
+
+	if err := claims.Principal.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	token, err := tokens.Sign(claims)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(token)
+}
@@ -0,0 +1,54 @@
+package analytics
+
+import (
+	"errors"
+	"strings"
+)
+
+var InvalidPriceError = errors.New("invalid price")
+
+// parseCents reads a decimal price, as "10.1", "10.10" or "-3", into
+// cents. Digits past the cents are rounded half away from zero, the way
+// DECIMAL(19, 2) columns round them.
+func parseCents(price string) (int64, error) {
+	price = strings.TrimSpace(price)
+
+	negative := strings.HasPrefix(price, "-")
+	price = strings.TrimLeft(price, "+-")
+
+	units, fraction, _ := strings.Cut(price, ".")
+	if units == "" && fraction == "" {
+		return 0, InvalidPriceError
+	}
+
+	var cents int64
+	for _, digit := range units {
+		if digit < '0' || digit > '9' {
+			return 0, InvalidPriceError
+		}
+		cents = cents*10 + int64(digit-'0')
+	}
+
+	for i := 0; i < len(fraction); i++ {
+		digit := fraction[i]
+		if digit < '0' || digit > '9' {
+			return 0, InvalidPriceError
+		}
+
+		switch {
+		case i < 2:
+			cents = cents*10 + int64(digit-'0')
+		case i == 2 && digit >= '5':
+			cents++
+		}
+	}
+
+	for i := len(fraction); i < 2; i++ {
+		cents *= 10
+	}
+
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
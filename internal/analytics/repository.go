@@ -0,0 +1,122 @@
+package analytics
+
+import (
+	"strings"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+)
+
+// Sale is an order detail of a sold purchase order, with the prices of
+// its product in effect at the order date. Prices are read as text, so
+// they are never rounded through a float.
+type Sale struct {
+	PurchaseOrderId uint64
+	OrderDate       string
+	Quantity        uint64
+	PurchasePrice   string
+	SalePrice       string
+	SellerId        uint64
+	SellerName      string
+	ProductTypeId   uint64
+	ProductTypeName string
+	LocalityId      string
+	LocalityName    string
+}
+
+// soldStatuses are the order statuses that count as sales: orders that
+// were approved, whether or not they have been delivered yet.
+var soldStatuses = []any{
+	purchaseOrders.ApprovedStatusId,
+	purchaseOrders.InTransitStatusId,
+	purchaseOrders.DeliveredStatusId,
+}
+
+// The price in effect is the latest record of the product updated up to
+// the order date. Orders placed before the first record of their product
+// keep the record they reference.
+const salesQuery = `
+	SELECT po.id, po.order_date, od.quantity, CAST(pr.purchase_price AS CHAR), CAST(pr.sale_price AS CHAR),
+		s.id, s.company_name, p.product_type, COALESCE(pt.description, ''),
+		s.locality_id, COALESCE(l.locality_name, '')
+	FROM order_details od
+	JOIN purchase_orders po ON po.id = od.purchase_order_id
+	JOIN product_records ordered ON ordered.id = od.product_record_id
+	JOIN products p ON p.id = ordered.product_id
+	JOIN sellers s ON s.id = p.seller_id
+	LEFT JOIN products_types pt ON pt.id = p.product_type
+	LEFT JOIN localities l ON l.id = s.locality_id
+	JOIN product_records pr ON pr.id = COALESCE((
+		SELECT r.id FROM product_records r
+		WHERE r.product_id = ordered.product_id AND r.last_update_date <= po.order_date
+		ORDER BY r.last_update_date DESC, r.id DESC
+		LIMIT 1
+	), ordered.id)
+	WHERE po.order_status_id IN (?, ?, ?)`
+
+type AnalyticsRepository interface {
+	// FindSales lists the sold order details with orders placed from the
+	// given date and before the given end. Empty bounds are open.
+	FindSales(from string, to string) ([]Sale, error)
+}
+
+type analyticsRepository struct {
+	db db.Querier
+}
+
+func NewAnalyticsRepository(database db.Querier) AnalyticsRepository {
+	return &analyticsRepository{
+		db: database,
+	}
+}
+
+func (r *analyticsRepository) FindSales(from string, to string) ([]Sale, error) {
+	var query strings.Builder
+	query.WriteString(salesQuery)
+
+	args := append([]any{}, soldStatuses...)
+
+	if from != "" {
+		query.WriteString(" AND po.order_date >= ?")
+		args = append(args, from)
+	}
+	if to != "" {
+		query.WriteString(" AND po.order_date < ?")
+		args = append(args, to)
+	}
+
+	query.WriteString(" ORDER BY po.order_date, po.id, od.id")
+
+	rows, err := r.db.Query(query.String(), args...)
+	if err != nil {
+		return []Sale{}, err
+	}
+
+	defer rows.Close()
+
+	sales := []Sale{}
+
+	for rows.Next() {
+		var sale Sale
+
+		if err := rows.Scan(
+			&sale.PurchaseOrderId,
+			&sale.OrderDate,
+			&sale.Quantity,
+			&sale.PurchasePrice,
+			&sale.SalePrice,
+			&sale.SellerId,
+			&sale.SellerName,
+			&sale.ProductTypeId,
+			&sale.ProductTypeName,
+			&sale.LocalityId,
+			&sale.LocalityName,
+		); err != nil {
+			return []Sale{}, err
+		}
+
+		sales = append(sales, sale)
+	}
+
+	return sales, rows.Err()
+}
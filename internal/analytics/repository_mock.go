@@ -0,0 +1,21 @@
+package analytics
+
+type MockAnalyticsRepository struct {
+	Sales []Sale
+	Err   error
+	From  *string
+	To    *string
+}
+
+func (m MockAnalyticsRepository) FindSales(from string, to string) ([]Sale, error) {
+	if m.From != nil {
+		*m.From = from
+	}
+	if m.To != nil {
+		*m.To = to
+	}
+	if m.Err != nil {
+		return []Sale{}, m.Err
+	}
+	return m.Sales, nil
+}
@@ -0,0 +1,201 @@
+package analytics
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_FindSales_Ok(t *testing.T) {
+
+	database := createAnalyticsDB()
+	repository := NewAnalyticsRepository(database)
+
+	sales, err := repository.FindSales("", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Sale{
+		{PurchaseOrderId: 4, OrderDate: "2021-12-01 10:00:00", Quantity: 4, PurchasePrice: "1", SalePrice: "2",
+			SellerId: 2, SellerName: "Verduras SA", ProductTypeId: 2, LocalityId: "2"},
+		{PurchaseOrderId: 1, OrderDate: "2022-01-15 10:00:00", Quantity: 3, PurchasePrice: "5", SalePrice: "10.1",
+			SellerId: 1, SellerName: "Frutas SA", ProductTypeId: 1, ProductTypeName: "Frutas", LocalityId: "1", LocalityName: "Santos"},
+		{PurchaseOrderId: 2, OrderDate: "2022-02-10 10:00:00", Quantity: 2, PurchasePrice: "6.5", SalePrice: "12.35",
+			SellerId: 1, SellerName: "Frutas SA", ProductTypeId: 1, ProductTypeName: "Frutas", LocalityId: "1", LocalityName: "Santos"},
+	}, sales)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindSales_ShouldUseThePriceInEffectAtTheOrderDate(t *testing.T) {
+
+	database := createAnalyticsDB()
+	repository := NewAnalyticsRepository(database)
+
+	sales, err := repository.FindSales("2022-02-01", "2022-03-01")
+
+	assert.Nil(t, err)
+	assert.Len(t, sales, 1)
+	assert.Equal(t, uint64(2), sales[0].PurchaseOrderId)
+	assert.Equal(t, "12.35", sales[0].SalePrice)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindSales_ShouldFailWithoutTables(t *testing.T) {
+
+	database := util.CreateDB()
+	repository := NewAnalyticsRepository(database)
+
+	sales, err := repository.FindSales("", "")
+
+	assert.NotNil(t, err)
+	assert.Empty(t, sales)
+
+	util.DropDB(database)
+}
+
+func createAnalyticsDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_LOCALITIES_TABLE)
+	util.QueryExec(database, CREATE_SELLERS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCTS_TYPES_TABLE)
+	util.QueryExec(database, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+	util.QueryExec(database, CREATE_PURCHASE_ORDERS_TABLE)
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+	util.QueryExec(database, INSERT_LOCALITIES)
+	util.QueryExec(database, INSERT_SELLERS)
+	util.QueryExec(database, INSERT_PRODUCTS_TYPES)
+	util.QueryExec(database, INSERT_PRODUCTS)
+	util.QueryExec(database, INSERT_PRODUCT_RECORDS)
+	util.QueryExec(database, INSERT_PURCHASE_ORDERS)
+	util.QueryExec(database, INSERT_ORDER_DETAILS)
+	return database
+}
+
+// The locality of Verduras SA and the type of Alface are not registered.
+const INSERT_LOCALITIES = `
+	INSERT INTO localities(id, locality_name, province_id)
+	VALUES ("1", "Santos", 1)
+`
+
+const INSERT_SELLERS = `
+	INSERT INTO sellers(cid, company_name, address, telephone, locality_id)
+	VALUES (1, "Frutas SA", "Rua 1", "1111", "1"),
+		(2, "Verduras SA", "Rua 2", "2222", "2")
+`
+
+const INSERT_PRODUCTS_TYPES = `
+	INSERT INTO products_types(description)
+	VALUES ("Frutas")
+`
+
+const INSERT_PRODUCTS = `
+	INSERT INTO products(description, expiration_rate, freezing_rate, height, length, net_weight, product_code, recommended_freezing_temperature, width, product_type, seller_id)
+	VALUES ("Banana", 0.5, 1, 1, 1, 1, "BAN", 1, 1, 1, 1),
+		("Alface", 0.5, 1, 1, 1, 1, "ALF", 1, 1, 2, 2)
+`
+
+const INSERT_PRODUCT_RECORDS = `
+	INSERT INTO product_records(last_update_date, purchase_price, sale_price, product_id)
+	VALUES ("2022-01-01 00:00:00", 5, 10.1, 1),
+		("2022-02-01 00:00:00", 6.5, 12.35, 1),
+		("2022-01-01 00:00:00", 1, 2, 2)
+`
+
+// PO-2 references the old price of Banana, PO-3 was rejected and PO-4 was
+// placed before the first price of Alface.
+const INSERT_PURCHASE_ORDERS = `
+	INSERT INTO purchase_orders(order_number, order_date, tracking_code, buyer_id, order_status_id, product_record_id)
+	VALUES ("PO-1", "2022-01-15 10:00:00", "T1", 1, 1, 1),
+		("PO-2", "2022-02-10 10:00:00", "T2", 1, 5, 1),
+		("PO-3", "2022-02-11 10:00:00", "T3", 1, 3, 1),
+		("PO-4", "2021-12-01 10:00:00", "T4", 1, 2, 3)
+`
+
+const INSERT_ORDER_DETAILS = `
+	INSERT INTO order_details(clean_liness_status, quantity, temperature, product_record_id, purchase_order_id)
+	VALUES ("ok", 3, 1, 1, 1),
+		("ok", 2, 1, 1, 2),
+		("ok", 1, 1, 1, 3),
+		("ok", 4, 1, 3, 4)
+`
+
+const CREATE_LOCALITIES_TABLE = `
+	CREATE TABLE "localities" (
+		id TEXT NOT NULL PRIMARY KEY,
+		locality_name TEXT NOT NULL,
+		province_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SELLERS_TABLE = `
+	CREATE TABLE "sellers" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		cid BIGINT NOT NULL,
+		company_name TEXT NOT NULL,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TYPES_TABLE = `
+	CREATE TABLE "products_types" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		expiration_rate DECIMAL(19, 2) NOT NULL,
+		freezing_rate DECIMAL(19, 2) NOT NULL,
+		height DECIMAL(19, 2) NOT NULL,
+		length DECIMAL(19, 2) NOT NULL,
+		net_weight DECIMAL(19, 2) NOT NULL,
+		product_code TEXT NOT NULL,
+		recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+		width DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		seller_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_RECORDS_TABLE = `
+	CREATE TABLE "product_records"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		last_update_date TEXT NOT NULL,
+		purchase_price DECIMAL(19, 2) NOT NULL,
+		sale_price DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PURCHASE_ORDERS_TABLE = `
+	CREATE TABLE "purchase_orders"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		order_number TEXT NOT NULL,
+		order_date TEXT NOT NULL,
+		tracking_code TEXT NOT NULL,
+		buyer_id BIGINT NOT NULL,
+		order_status_id BIGINT NOT NULL,
+		product_record_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_ORDER_DETAILS_TABLE = `
+	CREATE TABLE "order_details"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		clean_liness_status TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		temperature DECIMAL(19, 2) NOT NULL,
+		product_record_id BIGINT NOT NULL,
+		purchase_order_id BIGINT NOT NULL
+	);
+`
@@ -0,0 +1,215 @@
+package analytics
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+const (
+	BySeller      = "seller"
+	ByProductType = "product_type"
+	ByLocality    = "locality"
+	ByMonth       = "month"
+)
+
+// DateLayout is the layout of the from and to dates of the reports.
+const DateLayout = "2006-01-02"
+
+var (
+	InvalidGroupByError = errors.New("group_by must be seller, product_type, locality or month")
+	InvalidDateError    = errors.New("dates must be in the YYYY-MM-DD format")
+	InvalidRangeError   = errors.New("from must not be after to")
+)
+
+type AnalyticsService interface {
+	// Revenue sums the sales of the orders placed from the given date up
+	// to the given date, both included, per group. Empty dates are open.
+	Revenue(groupBy string, from string, to string) ([]db.RevenueReport, error)
+	// Margins sums the sales and their purchase cost like Revenue.
+	Margins(groupBy string, from string, to string) ([]db.MarginReport, error)
+}
+
+type analyticsService struct {
+	analyticsRepository AnalyticsRepository
+}
+
+func NewAnalyticsService(analyticsRepository AnalyticsRepository) AnalyticsService {
+	return &analyticsService{
+		analyticsRepository: analyticsRepository,
+	}
+}
+
+type total struct {
+	group   string
+	name    string
+	orders  map[uint64]bool
+	units   uint64
+	revenue int64
+	cost    int64
+}
+
+func (s *analyticsService) Revenue(groupBy string, from string, to string) ([]db.RevenueReport, error) {
+	totals, err := s.aggregate(groupBy, from, to)
+	if err != nil {
+		return []db.RevenueReport{}, err
+	}
+
+	reports := make([]db.RevenueReport, len(totals))
+	for i, t := range totals {
+		reports[i] = db.RevenueReport{
+			Group:        t.group,
+			Name:         t.name,
+			Orders:       uint64(len(t.orders)),
+			Units:        t.units,
+			RevenueCents: t.revenue,
+		}
+	}
+	return reports, nil
+}
+
+func (s *analyticsService) Margins(groupBy string, from string, to string) ([]db.MarginReport, error) {
+	totals, err := s.aggregate(groupBy, from, to)
+	if err != nil {
+		return []db.MarginReport{}, err
+	}
+
+	reports := make([]db.MarginReport, len(totals))
+	for i, t := range totals {
+		reports[i] = db.MarginReport{
+			Group:        t.group,
+			Name:         t.name,
+			Units:        t.units,
+			RevenueCents: t.revenue,
+			CostCents:    t.cost,
+			MarginCents:  t.revenue - t.cost,
+			MarginRate:   marginRate(t.revenue, t.cost),
+		}
+	}
+	return reports, nil
+}
+
+// aggregate sums the sales per group in cents. Months are listed in
+// order, other groups from the highest revenue down.
+func (s *analyticsService) aggregate(groupBy string, from string, to string) ([]*total, error) {
+	if groupBy == "" {
+		groupBy = BySeller
+	}
+
+	group, err := grouper(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err = dateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := s.analyticsRepository.FindSales(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byGroup := map[string]*total{}
+	totals := []*total{}
+
+	for _, sale := range sales {
+		salePrice, err := parseCents(sale.SalePrice)
+		if err != nil {
+			return nil, err
+		}
+		purchasePrice, err := parseCents(sale.PurchasePrice)
+		if err != nil {
+			return nil, err
+		}
+
+		key, name := group(sale)
+
+		t, ok := byGroup[key]
+		if !ok {
+			t = &total{group: key, name: name, orders: map[uint64]bool{}}
+			byGroup[key] = t
+			totals = append(totals, t)
+		}
+
+		t.orders[sale.PurchaseOrderId] = true
+		t.units += sale.Quantity
+		t.revenue += salePrice * int64(sale.Quantity)
+		t.cost += purchasePrice * int64(sale.Quantity)
+	}
+
+	sort.SliceStable(totals, func(i, j int) bool {
+		if groupBy == ByMonth || totals[i].revenue == totals[j].revenue {
+			return totals[i].group < totals[j].group
+		}
+		return totals[i].revenue > totals[j].revenue
+	})
+
+	return totals, nil
+}
+
+func grouper(groupBy string) (func(Sale) (string, string), error) {
+	switch groupBy {
+	case BySeller:
+		return func(sale Sale) (string, string) {
+			return strconv.FormatUint(sale.SellerId, 10), sale.SellerName
+		}, nil
+	case ByProductType:
+		return func(sale Sale) (string, string) {
+			return strconv.FormatUint(sale.ProductTypeId, 10), sale.ProductTypeName
+		}, nil
+	case ByLocality:
+		return func(sale Sale) (string, string) {
+			return sale.LocalityId, sale.LocalityName
+		}, nil
+	case ByMonth:
+		return func(sale Sale) (string, string) {
+			month := sale.OrderDate
+			if len(month) > len("2006-01") {
+				month = month[:len("2006-01")]
+			}
+			return month, month
+		}, nil
+	default:
+		return nil, InvalidGroupByError
+	}
+}
+
+// dateRange checks the dates and makes to exclusive, the next day, so
+// orders placed during the last day are included.
+func dateRange(from string, to string) (string, string, error) {
+	var fromDate, toDate time.Time
+	var err error
+
+	if from != "" {
+		if fromDate, err = time.Parse(DateLayout, from); err != nil {
+			return "", "", InvalidDateError
+		}
+	}
+
+	if to != "" {
+		if toDate, err = time.Parse(DateLayout, to); err != nil {
+			return "", "", InvalidDateError
+		}
+		to = toDate.AddDate(0, 0, 1).Format(DateLayout)
+	}
+
+	if from != "" && to != "" && fromDate.After(toDate) {
+		return "", "", InvalidRangeError
+	}
+
+	return from, to, nil
+}
+
+// marginRate is the share of the revenue kept as margin, to four places.
+func marginRate(revenue int64, cost int64) float64 {
+	if revenue == 0 {
+		return 0
+	}
+	return math.Round(float64(revenue-cost)/float64(revenue)*10000) / 10000
+}
@@ -0,0 +1,151 @@
+package analytics
+
+import (
+	"errors"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/stretchr/testify/assert"
+)
+
+var analyticsSales = []Sale{
+	{PurchaseOrderId: 1, OrderDate: "2022-01-15 10:00:00", Quantity: 3, PurchasePrice: "5", SalePrice: "10.1",
+		SellerId: 1, SellerName: "Frutas SA", ProductTypeId: 1, ProductTypeName: "Frutas", LocalityId: "1", LocalityName: "Santos"},
+	{PurchaseOrderId: 1, OrderDate: "2022-01-15 10:00:00", Quantity: 7, PurchasePrice: "0.07", SalePrice: "0.1",
+		SellerId: 2, SellerName: "Verduras SA", ProductTypeId: 2, ProductTypeName: "Verduras", LocalityId: "1", LocalityName: "Santos"},
+	{PurchaseOrderId: 2, OrderDate: "2022-02-10 10:00:00", Quantity: 2, PurchasePrice: "6.50", SalePrice: "12.35",
+		SellerId: 1, SellerName: "Frutas SA", ProductTypeId: 1, ProductTypeName: "Frutas", LocalityId: "1", LocalityName: "Santos"},
+	{PurchaseOrderId: 3, OrderDate: "2021-12-01 10:00:00", Quantity: 4, PurchasePrice: "1", SalePrice: "2",
+		SellerId: 2, SellerName: "Verduras SA", ProductTypeId: 2, ProductTypeName: "Verduras", LocalityId: "2", LocalityName: "Guaruja"},
+}
+
+func Test_Revenue_BySeller(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{Sales: analyticsSales})
+
+	reports, err := service.Revenue("", "", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.RevenueReport{
+		{Group: "1", Name: "Frutas SA", Orders: 2, Units: 5, RevenueCents: 5500},
+		{Group: "2", Name: "Verduras SA", Orders: 2, Units: 11, RevenueCents: 870},
+	}, reports)
+}
+
+// 10.1 and 0.1 have no exact float representation: 3 x 10.1 + 7 x 0.1
+// must still add up to 31.00.
+func Test_Revenue_ShouldAddUpPricesExactly(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{Sales: analyticsSales[:2]})
+
+	reports, err := service.Revenue(ByLocality, "", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.RevenueReport{{Group: "1", Name: "Santos", Orders: 1, Units: 10, RevenueCents: 3100}}, reports)
+}
+
+func Test_Revenue_ByMonth_ShouldBeInOrder(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{Sales: analyticsSales})
+
+	reports, err := service.Revenue(ByMonth, "", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.RevenueReport{
+		{Group: "2021-12", Name: "2021-12", Orders: 1, Units: 4, RevenueCents: 800},
+		{Group: "2022-01", Name: "2022-01", Orders: 1, Units: 10, RevenueCents: 3100},
+		{Group: "2022-02", Name: "2022-02", Orders: 1, Units: 2, RevenueCents: 2470},
+	}, reports)
+}
+
+func Test_Revenue_ShouldIncludeTheLastDay(t *testing.T) {
+
+	var from, to string
+	service := NewAnalyticsService(MockAnalyticsRepository{From: &from, To: &to})
+
+	_, err := service.Revenue(BySeller, "2022-01-01", "2022-01-31")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-01-01", from)
+	assert.Equal(t, "2022-02-01", to)
+}
+
+func Test_Revenue_ShouldReturnInvalidGroupByError(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{})
+
+	_, err := service.Revenue("buyer", "", "")
+
+	assert.Equal(t, InvalidGroupByError, err)
+}
+
+func Test_Revenue_ShouldReturnInvalidDateError(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{})
+
+	_, err := service.Revenue(BySeller, "01/01/2022", "")
+	assert.Equal(t, InvalidDateError, err)
+
+	_, err = service.Revenue(BySeller, "", "2022-13-01")
+	assert.Equal(t, InvalidDateError, err)
+}
+
+func Test_Revenue_ShouldReturnInvalidRangeError(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{})
+
+	_, err := service.Revenue(BySeller, "2022-02-01", "2022-01-01")
+
+	assert.Equal(t, InvalidRangeError, err)
+}
+
+func Test_Revenue_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := NewAnalyticsService(MockAnalyticsRepository{Err: expectedError})
+
+	_, err := service.Revenue(BySeller, "", "")
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Margins_ByProductType(t *testing.T) {
+
+	service := NewAnalyticsService(MockAnalyticsRepository{Sales: analyticsSales})
+
+	reports, err := service.Margins(ByProductType, "", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.MarginReport{
+		{Group: "1", Name: "Frutas", Units: 5, RevenueCents: 5500, CostCents: 2800, MarginCents: 2700, MarginRate: 0.4909},
+		{Group: "2", Name: "Verduras", Units: 11, RevenueCents: 870, CostCents: 449, MarginCents: 421, MarginRate: 0.4839},
+	}, reports)
+}
+
+func Test_Margins_ShouldReturnInvalidPriceError(t *testing.T) {
+
+	sales := []Sale{{PurchaseOrderId: 1, Quantity: 1, PurchasePrice: "1", SalePrice: "abc"}}
+	service := NewAnalyticsService(MockAnalyticsRepository{Sales: sales})
+
+	_, err := service.Margins(BySeller, "", "")
+
+	assert.Equal(t, InvalidPriceError, err)
+}
+
+func Test_ParseCents(t *testing.T) {
+
+	for price, cents := range map[string]int64{
+		"10.1": 1010, "10.10": 1010, "0.07": 7, "3": 300, "-3.5": -350,
+		".5": 50, "1234567.89": 123456789, "0.125": 13, "0.124": 12,
+	} {
+		parsed, err := parseCents(price)
+
+		assert.Nil(t, err)
+		assert.Equal(t, cents, parsed, price)
+	}
+
+	for _, price := range []string{"", "-", ".", "1.2.3", "1e6", "abc"} {
+		_, err := parseCents(price)
+		assert.Equal(t, InvalidPriceError, err, price)
+	}
+}
@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// Track records an audit event for every successful request of the route,
+// taking the new state of the entity from the data of the response.
+func Track(service AuditService, entity string) gin.HandlerFunc {
+	return track(service, entity, nil)
+}
+
+// TrackChanges works like Track and also loads the entity named by the :id
+// parameter before the request runs, usually with the Get of its service,
+// so the event holds what the request changed.
+func TrackChanges[T any](service AuditService, entity string, get func(id uint64) (T, error)) gin.HandlerFunc {
+	return track(service, entity, func(id uint64) (any, error) {
+		return get(id)
+	})
+}
+
+type bodyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+func track(service AuditService, entity string, get func(id uint64) (any, error)) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		entityId := ctx.Param("id")
+
+		var before any
+		if get != nil && entityId != "" {
+			if id, err := strconv.ParseUint(entityId, 10, 64); err == nil {
+				if found, err := get(id); err == nil {
+					before = found
+				}
+			}
+		}
+
+		writer := &bodyWriter{ResponseWriter: ctx.Writer}
+		ctx.Writer = writer
+
+		ctx.Next()
+
+		if writer.Status() >= http.StatusMultipleChoices {
+			return
+		}
+
+		var after any
+		if ctx.Request.Method != http.MethodDelete {
+			after = responseData(writer.body.Bytes())
+		}
+
+		if entityId == "" {
+			entityId = dataId(after)
+		}
+
+		principal, _ := auth.PrincipalFrom(ctx)
+
+		event := db.AuditEvent{
+			Actor:     principal.Subject,
+			ActorRole: string(principal.Role),
+			Method:    ctx.Request.Method,
+			Route:     ctx.FullPath(),
+			Entity:    entity,
+			EntityId:  entityId,
+		}
+
+		if _, err := service.Record(event, before, after); err != nil {
+			log.Println("audit:", err)
+		}
+	}
+}
+
+// responseData returns the data block of a web.Response body.
+func responseData(body []byte) any {
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil || len(response.Data) == 0 {
+		return nil
+	}
+	return response.Data
+}
+
+func dataId(data any) string {
+	fields, err := jsonFields(data)
+	if err != nil || fields["id"] == nil {
+		return ""
+	}
+	return fmt.Sprint(fields["id"])
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/auth"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var storedSeller = db.Seller{Id: 1, Cid: 1, CompanyName: "Nike", LocalityId: "11065001"}
+
+func Test_TrackChanges_Update(t *testing.T) {
+
+	var created []db.AuditEvent
+	router := setupAuditRouter(&created)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/sellers/1", bytes.NewBufferString(`{"company_name":"Adidas"}`))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, 1, len(created))
+	assert.Equal(t, "ops", created[0].Actor)
+	assert.Equal(t, "admin", created[0].ActorRole)
+	assert.Equal(t, "PATCH", created[0].Method)
+	assert.Equal(t, "/api/v1/sellers/:id", created[0].Route)
+	assert.Equal(t, "sellers", created[0].Entity)
+	assert.Equal(t, "1", created[0].EntityId)
+	assert.Equal(t, `{"company_name":{"before":"Nike","after":"Adidas"}}`, string(created[0].Changes))
+}
+
+func Test_TrackChanges_Create(t *testing.T) {
+
+	var created []db.AuditEvent
+	router := setupAuditRouter(&created)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/sellers", nil)
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, 1, len(created))
+	assert.Equal(t, "7", created[0].EntityId)
+}
+
+func Test_TrackChanges_Delete(t *testing.T) {
+
+	var created []db.AuditEvent
+	router := setupAuditRouter(&created)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/api/v1/sellers/1", nil)
+	router.ServeHTTP(response, request)
+
+	var changes map[string]Change
+	json.Unmarshal(created[0].Changes, &changes)
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+	assert.Equal(t, "Nike", changes["company_name"].Before)
+	assert.Nil(t, changes["company_name"].After)
+}
+
+func Test_TrackChanges_SkipsFailedRequests(t *testing.T) {
+
+	var created []db.AuditEvent
+	router := setupAuditRouter(&created)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PATCH", "/api/v1/sellers/2", bytes.NewBufferString(`{}`))
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+	assert.Empty(t, created)
+}
+
+func setupAuditRouter(created *[]db.AuditEvent) *gin.Engine {
+	service := NewAuditService(MockAuditRepository{Created: created})
+
+	get := func(id uint64) (db.Seller, error) {
+		if id != storedSeller.Id {
+			return db.Seller{}, errors.New("seller not found")
+		}
+		return storedSeller, nil
+	}
+
+	tracked := TrackChanges(service, "sellers", get)
+
+	router := gin.Default()
+	router.Use(func(ctx *gin.Context) {
+		auth.SetPrincipal(ctx, auth.Principal{Subject: "ops", Role: auth.AdminRole})
+	})
+
+	router.POST("/api/v1/sellers", tracked, func(ctx *gin.Context) {
+		ctx.JSON(http.StatusCreated, web.NewResponse(http.StatusCreated, db.Seller{Id: 7, CompanyName: "Puma"}, ""))
+	})
+	router.PATCH("/api/v1/sellers/:id", tracked, func(ctx *gin.Context) {
+		seller, err := get(1)
+		if ctx.Param("id") != "1" || err != nil {
+			ctx.JSON(http.StatusNotFound, web.NewResponse(http.StatusNotFound, nil, "seller not found"))
+			return
+		}
+		seller.CompanyName = "Adidas"
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, seller, ""))
+	})
+	router.DELETE("/api/v1/sellers/:id", tracked, func(ctx *gin.Context) {
+		ctx.JSON(http.StatusNoContent, web.NewResponse(http.StatusNoContent, nil, ""))
+	})
+
+	return router
+}
@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"database/sql"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type AuditRepository interface {
+	Create(event db.AuditEvent) (db.AuditEvent, error)
+	GetAll(params query.Params) (query.Page[db.AuditEvent], error)
+}
+
+type auditRepository struct {
+	db db.Querier
+}
+
+func NewAuditRepository(database db.Querier) AuditRepository {
+	return &auditRepository{
+		db: database,
+	}
+}
+
+var auditEventsQuery = query.NewBuilder(
+	"audit_events",
+	"id, actor, actor_role, method, route, entity, entity_id, changes, created_at",
+	map[string]string{
+		"id":         "id",
+		"actor":      "actor",
+		"method":     "method",
+		"entity":     "entity",
+		"entity_id":  "entity_id",
+		"created_at": "created_at",
+	},
+)
+
+func (r *auditRepository) Create(event db.AuditEvent) (db.AuditEvent, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO audit_events(actor, actor_role, method, route, entity, entity_id, changes, created_at)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.AuditEvent{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+	result, err = stmt.Exec(
+		event.Actor,
+		event.ActorRole,
+		event.Method,
+		event.Route,
+		event.Entity,
+		event.EntityId,
+		string(event.Changes),
+		event.CreatedAt,
+	)
+	if err != nil {
+		return db.AuditEvent{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	event.Id = uint64(insertedId)
+
+	return event, nil
+}
+
+func (r *auditRepository) GetAll(params query.Params) (query.Page[db.AuditEvent], error) {
+	statement, args, err := auditEventsQuery.Build(params)
+	if err != nil {
+		return query.Page[db.AuditEvent]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[db.AuditEvent]{}, err
+	}
+
+	defer rows.Close()
+
+	var events []db.AuditEvent
+
+	for rows.Next() {
+		var event db.AuditEvent
+		var changes string
+
+		if err := rows.Scan(
+			&event.Id,
+			&event.Actor,
+			&event.ActorRole,
+			&event.Method,
+			&event.Route,
+			&event.Entity,
+			&event.EntityId,
+			&changes,
+			&event.CreatedAt,
+		); err != nil {
+			return query.Page[db.AuditEvent]{}, err
+		}
+
+		event.Changes = []byte(changes)
+		events = append(events, event)
+	}
+
+	return query.NewPage(events, params)
+}
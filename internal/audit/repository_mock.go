@@ -0,0 +1,29 @@
+package audit
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type MockAuditRepository struct {
+	Result  any
+	Err     error
+	Created *[]db.AuditEvent
+}
+
+func (m MockAuditRepository) Create(event db.AuditEvent) (db.AuditEvent, error) {
+	if m.Err != nil {
+		return db.AuditEvent{}, m.Err
+	}
+	if m.Created != nil {
+		*m.Created = append(*m.Created, event)
+	}
+	return event, nil
+}
+
+func (m MockAuditRepository) GetAll(params query.Params) (query.Page[db.AuditEvent], error) {
+	if m.Err != nil {
+		return query.Page[db.AuditEvent]{}, m.Err
+	}
+	return query.NewPage(m.Result.([]db.AuditEvent), params)
+}
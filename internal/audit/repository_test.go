@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Create_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_AUDIT_EVENTS_TABLE)
+
+	repository := NewAuditRepository(database)
+
+	event := db.AuditEvent{
+		Actor:     "ops",
+		ActorRole: "admin",
+		Method:    "PATCH",
+		Route:     "/api/v1/products/:id",
+		Entity:    "products",
+		EntityId:  "3",
+		Changes:   json.RawMessage(`{"description":{"before":"Banana","after":"Apple"}}`),
+		CreatedAt: "2022-07-12 10:00:00",
+	}
+
+	created, err := repository.Create(event)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), created.Id)
+
+	found, err := repository.GetAll(query.Params{})
+	assert.Nil(t, err)
+	assert.Equal(t, []db.AuditEvent{created}, found.Items)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_FilterByEntity(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_AUDIT_EVENTS_TABLE)
+
+	repository := NewAuditRepository(database)
+
+	for _, entityId := range []string{"1", "2", "1"} {
+		_, err := repository.Create(db.AuditEvent{Entity: "products", EntityId: entityId, Changes: json.RawMessage("{}")})
+		assert.Nil(t, err)
+	}
+	_, err := repository.Create(db.AuditEvent{Entity: "sellers", EntityId: "1", Changes: json.RawMessage("{}")})
+	assert.Nil(t, err)
+
+	found, err := repository.GetAll(query.Params{Filters: map[string]string{"entity": "products", "entity_id": "1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(found.Items))
+	assert.Equal(t, uint64(1), found.Items[0].Id)
+	assert.Equal(t, uint64(3), found.Items[1].Id)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Create_ConnectionError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_AUDIT_EVENTS_TABLE)
+
+	repository := NewAuditRepository(database)
+
+	database.Close()
+	_, err := repository.Create(db.AuditEvent{})
+	assert.NotNil(t, err)
+
+	_, err = repository.GetAll(query.Params{})
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+const CREATE_AUDIT_EVENTS_TABLE = `
+	CREATE TABLE audit_events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		actor VARCHAR(255) NOT NULL,
+		actor_role VARCHAR(255) NOT NULL,
+		method VARCHAR(255) NOT NULL,
+		route VARCHAR(255) NOT NULL,
+		entity VARCHAR(255) NOT NULL,
+		entity_id VARCHAR(255) NOT NULL,
+		changes TEXT NOT NULL,
+		created_at VARCHAR(255) NOT NULL
+	);
+`
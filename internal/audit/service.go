@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type AuditService interface {
+	Record(event db.AuditEvent, before any, after any) (db.AuditEvent, error)
+	GetAll(params query.Params) (query.Page[db.AuditEvent], error)
+}
+
+type auditService struct {
+	auditRepository AuditRepository
+}
+
+func NewAuditService(r AuditRepository) AuditService {
+	return &auditService{
+		auditRepository: r,
+	}
+}
+
+// Change is the value of one field before and after a request. Fields that
+// did not change are left out of the event.
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Record stores event with the fields that differ between before and
+// after. Either side may be nil, as in a create or a delete.
+func (s *auditService) Record(event db.AuditEvent, before any, after any) (db.AuditEvent, error) {
+	changes, err := Diff(before, after)
+	if err != nil {
+		return db.AuditEvent{}, err
+	}
+
+	event.Changes, err = json.Marshal(changes)
+	if err != nil {
+		return db.AuditEvent{}, err
+	}
+
+	event.CreatedAt = time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	return s.auditRepository.Create(event)
+}
+
+func (s *auditService) GetAll(params query.Params) (query.Page[db.AuditEvent], error) {
+	return s.auditRepository.GetAll(params)
+}
+
+// Diff compares the JSON form of before and after field by field.
+func Diff(before any, after any) (map[string]Change, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]Change{}
+
+	for field, value := range beforeFields {
+		if !reflect.DeepEqual(value, afterFields[field]) {
+			changes[field] = Change{Before: value, After: afterFields[field]}
+		}
+	}
+
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = Change{After: value}
+		}
+	}
+
+	return changes, nil
+}
+
+// jsonFields returns the fields of value as they are sent by the API.
+// Values that are not JSON objects are kept under the "value" key.
+func jsonFields(value any) (map[string]any, error) {
+	if value == nil {
+		return map[string]any{}, nil
+	}
+
+	data, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	if fields, ok := decoded.(map[string]any); ok {
+		return fields, nil
+	}
+	if decoded == nil {
+		return map[string]any{}, nil
+	}
+	return map[string]any{"value": decoded}, nil
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Diff_Update(t *testing.T) {
+
+	before := db.Seller{Id: 1, Cid: 1, CompanyName: "Nike", LocalityId: "11065001"}
+	after := json.RawMessage(`{"id":1,"cid":1,"company_name":"Adidas","address":"","telephone":"","locality_id":"11065001"}`)
+
+	changes, err := Diff(before, after)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]Change{
+		"company_name": {Before: "Nike", After: "Adidas"},
+	}, changes)
+}
+
+func Test_Diff_CreateAndDelete(t *testing.T) {
+
+	seller := db.Seller{Id: 1, Cid: 1, CompanyName: "Nike"}
+
+	created, err := Diff(nil, seller)
+	assert.Nil(t, err)
+	assert.Equal(t, Change{After: "Nike"}, created["company_name"])
+	assert.Equal(t, Change{After: json.Number("1")}, created["id"])
+
+	deleted, err := Diff(seller, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, Change{Before: "Nike"}, deleted["company_name"])
+}
+
+func Test_Service_Record_Ok(t *testing.T) {
+
+	var created []db.AuditEvent
+
+	service := NewAuditService(MockAuditRepository{Created: &created})
+
+	event, err := service.Record(
+		db.AuditEvent{Actor: "ops", Entity: "sellers", EntityId: "1"},
+		db.Seller{Id: 1, CompanyName: "Nike"},
+		db.Seller{Id: 1, CompanyName: "Adidas"},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"company_name":{"before":"Nike","after":"Adidas"}}`, string(event.Changes))
+	assert.NotEmpty(t, event.CreatedAt)
+	assert.Equal(t, 1, len(created))
+}
+
+func Test_Service_Record_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("database unavailable")
+
+	service := NewAuditService(MockAuditRepository{Err: expectedError})
+
+	_, err := service.Record(db.AuditEvent{}, nil, db.Seller{})
+
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNoCredentialsConfigured = errors.New("no jwt key file or api keys configured")
+	ErrInvalidAPIKey           = errors.New("invalid api key entry")
+)
+
+// NewAuthenticatorFromEnv builds the Authenticator from
+//
+//	MERCADO_FRESH_JWT_ALGORITHM  HS256 (default) or RS256
+//	MERCADO_FRESH_JWT_KEY_FILE   secret (HS256) or PEM key (RS256)
+//	MERCADO_FRESH_API_KEYS       name:key:role[:seller_id|carrier_id],...
+//
+// At least one of the key file or the API keys must be set.
+func NewAuthenticatorFromEnv() (Authenticator, error) {
+	tokens, err := TokensFromEnv()
+	if err != nil && err != ErrNoCredentialsConfigured {
+		return Authenticator{}, err
+	}
+
+	apiKeys, err := ParseAPIKeys(os.Getenv("MERCADO_FRESH_API_KEYS"))
+	if err != nil {
+		return Authenticator{}, err
+	}
+
+	if tokens == nil && len(apiKeys) == 0 {
+		return Authenticator{}, ErrNoCredentialsConfigured
+	}
+
+	return NewAuthenticator(tokens, apiKeys), nil
+}
+
+func TokensFromEnv() (*Tokens, error) {
+	keyFile := os.Getenv("MERCADO_FRESH_JWT_KEY_FILE")
+	if keyFile == "" {
+		return nil, ErrNoCredentialsConfigured
+	}
+
+	algorithm := os.Getenv("MERCADO_FRESH_JWT_ALGORITHM")
+	if algorithm == "" {
+		algorithm = HS256
+	}
+
+	return LoadTokens(algorithm, keyFile)
+}
+
+// ParseAPIKeys reads a comma separated list of
+// name:key:role[:seller_id|carrier_id] entries. Seller and carrier keys take
+// the seller or carrier id as the fourth field. The name is used as the
+// subject of the requests made with the key.
+func ParseAPIKeys(value string) (map[string]Principal, error) {
+	apiKeys := map[string]Principal{}
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Split(entry, ":")
+		if len(fields) < 3 || len(fields) > 4 || fields[0] == "" || fields[1] == "" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidAPIKey, fields[0])
+		}
+
+		principal := Principal{Subject: fields[0], Role: Role(fields[2])}
+
+		if len(fields) == 4 {
+			id, err := strconv.ParseUint(fields[3], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidAPIKey, fields[0])
+			}
+			principal.SetScope(id)
+		}
+
+		if err := principal.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrInvalidAPIKey, fields[0], err)
+		}
+
+		apiKeys[fields[1]] = principal
+	}
+
+	return apiKeys, nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseAPIKeys(t *testing.T) {
+
+	apiKeys, err := ParseAPIKeys("ops:k1:warehouse_operator,nike:k2:seller:1,rapido:k3:carrier:2,")
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]Principal{
+		"k1": {Subject: "ops", Role: WarehouseOperatorRole},
+		"k2": {Subject: "nike", Role: SellerRole, SellerId: 1},
+		"k3": {Subject: "rapido", Role: CarrierRole, CarrierId: 2},
+	}, apiKeys)
+}
+
+func Test_ParseAPIKeys_Invalid(t *testing.T) {
+
+	for _, value := range []string{
+		"k1:admin",
+		"ops:k1:root",
+		"nike:k2:seller",
+		"nike:k2:seller:abc",
+		"rapido:k3:carrier",
+		"ops::admin",
+	} {
+		_, err := ParseAPIKeys(value)
+		assert.ErrorIs(t, err, ErrInvalidAPIKey, value)
+	}
+}
+
+func Test_NewAuthenticatorFromEnv(t *testing.T) {
+
+	t.Setenv("MERCADO_FRESH_JWT_KEY_FILE", "")
+	t.Setenv("MERCADO_FRESH_API_KEYS", "")
+
+	_, err := NewAuthenticatorFromEnv()
+	assert.Equal(t, ErrNoCredentialsConfigured, err)
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	os.WriteFile(secretFile, []byte("secret"), 0600)
+	t.Setenv("MERCADO_FRESH_JWT_KEY_FILE", secretFile)
+
+	authenticator, err := NewAuthenticatorFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, HS256, authenticator.tokens.algorithm)
+
+	t.Setenv("MERCADO_FRESH_JWT_KEY_FILE", "")
+	t.Setenv("MERCADO_FRESH_API_KEYS", "ops:k1:admin")
+
+	authenticator, err = NewAuthenticatorFromEnv()
+	assert.Nil(t, err)
+	assert.Nil(t, authenticator.tokens)
+	assert.Len(t, authenticator.apiKeys, 1)
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported jwt algorithm")
+	ErrInvalidKey           = errors.New("invalid jwt key")
+	ErrInvalidToken         = errors.New("invalid token")
+	ErrExpiredToken         = errors.New("token expired")
+	ErrMissingExpiration    = errors.New("token has no expiration")
+	ErrCannotSign           = errors.New("no private key to sign tokens")
+)
+
+type Claims struct {
+	Principal
+	ExpiresAt int64 `json:"exp,omitempty"`
+	NotBefore int64 `json:"nbf,omitempty"`
+	IssuedAt  int64 `json:"iat,omitempty"`
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+}
+
+// Tokens signs and verifies JWTs with a single algorithm. Tokens whose
+// header names another algorithm are rejected, so an RS256 public key can
+// never be used as an HS256 secret.
+type Tokens struct {
+	algorithm  string
+	secret     []byte
+	publicKey  *rsa.PublicKey
+	privateKey *rsa.PrivateKey
+	now        func() time.Time
+}
+
+func NewHS256Tokens(secret []byte) (*Tokens, error) {
+	if len(secret) == 0 {
+		return nil, ErrInvalidKey
+	}
+	return &Tokens{algorithm: HS256, secret: secret, now: time.Now}, nil
+}
+
+// NewRS256Tokens verifies with publicKey. privateKey may be nil when this
+// service only checks tokens signed elsewhere.
+func NewRS256Tokens(publicKey *rsa.PublicKey, privateKey *rsa.PrivateKey) (*Tokens, error) {
+	if publicKey == nil {
+		return nil, ErrInvalidKey
+	}
+	return &Tokens{algorithm: RS256, publicKey: publicKey, privateKey: privateKey, now: time.Now}, nil
+}
+
+// LoadTokens reads the key file of the given algorithm. For HS256 the file
+// holds the shared secret; for RS256 it holds a PEM public key, or a PEM
+// private key when this service also signs tokens.
+func LoadTokens(algorithm string, keyFile string) (*Tokens, error) {
+	content, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch algorithm {
+	case HS256:
+		return NewHS256Tokens([]byte(strings.TrimSpace(string(content))))
+	case RS256:
+		publicKey, privateKey, err := parseRSAKey(content)
+		if err != nil {
+			return nil, err
+		}
+		return NewRS256Tokens(publicKey, privateKey)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+func (t *Tokens) Sign(claims Claims) (string, error) {
+	if t.algorithm == RS256 && t.privateKey == nil {
+		return "", ErrCannotSign
+	}
+
+	encodedHeader, err := encodeSegment(header{Algorithm: t.algorithm, Type: "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	encodedClaims, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := encodedHeader + "." + encodedClaims
+
+	signature, err := t.sign(signingInput)
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the signature and the time claims of the token. Tokens
+// without an expiration are rejected, as they would be valid forever.
+func (t *Tokens) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var decodedHeader header
+	if err := decodeSegment(parts[0], &decodedHeader); err != nil || decodedHeader.Algorithm != t.algorithm {
+		return Claims{}, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if !t.verify(parts[0]+"."+parts[1], signature) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if claims.ExpiresAt == 0 {
+		return Claims{}, ErrMissingExpiration
+	}
+
+	now := t.now().Unix()
+	if now >= claims.ExpiresAt {
+		return Claims{}, ErrExpiredToken
+	}
+	if claims.NotBefore != 0 && now < claims.NotBefore {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if err := claims.Principal.Validate(); err != nil {
+		return Claims{}, err
+	}
+
+	return claims, nil
+}
+
+func (t *Tokens) sign(signingInput string) ([]byte, error) {
+	if t.algorithm == HS256 {
+		mac := hmac.New(sha256.New, t.secret)
+		mac.Write([]byte(signingInput))
+		return mac.Sum(nil), nil
+	}
+
+	hash := sha256.Sum256([]byte(signingInput))
+	return rsa.SignPKCS1v15(rand.Reader, t.privateKey, crypto.SHA256, hash[:])
+}
+
+func (t *Tokens) verify(signingInput string, signature []byte) bool {
+	if t.algorithm == HS256 {
+		expected, _ := t.sign(signingInput)
+		return hmac.Equal(expected, signature)
+	}
+
+	hash := sha256.Sum256([]byte(signingInput))
+	return rsa.VerifyPKCS1v15(t.publicKey, crypto.SHA256, hash[:], signature) == nil
+}
+
+func encodeSegment(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeSegment(segment string, value any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, value)
+}
+
+func parseRSAKey(content []byte) (*rsa.PublicKey, *rsa.PrivateKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, nil, ErrInvalidKey
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &privateKey.PublicKey, privateKey, nil
+
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		privateKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, nil, ErrInvalidKey
+		}
+		return &privateKey.PublicKey, privateKey, nil
+
+	case "RSA PUBLIC KEY":
+		publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		return publicKey, nil, err
+
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		publicKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, nil, ErrInvalidKey
+		}
+		return publicKey, nil, nil
+
+	default:
+		return nil, nil, ErrInvalidKey
+	}
+}
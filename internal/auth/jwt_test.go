@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var adminClaims = Claims{
+	Principal: Principal{Subject: "carlos", Role: AdminRole},
+	ExpiresAt: time.Now().Add(time.Hour).Unix(),
+}
+
+func Test_HS256_SignAndVerify(t *testing.T) {
+
+	tokens, err := NewHS256Tokens([]byte("secret"))
+	assert.Nil(t, err)
+
+	token, err := tokens.Sign(adminClaims)
+	assert.Nil(t, err)
+
+	claims, err := tokens.Verify(token)
+	assert.Nil(t, err)
+	assert.Equal(t, adminClaims, claims)
+}
+
+func Test_HS256_Verify_WrongSecret(t *testing.T) {
+
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+	otherTokens, _ := NewHS256Tokens([]byte("other secret"))
+
+	token, _ := otherTokens.Sign(adminClaims)
+
+	_, err := tokens.Verify(token)
+	assert.Equal(t, ErrInvalidToken, err)
+}
+
+func Test_HS256_Verify_TamperedClaims(t *testing.T) {
+
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+
+	buyerToken, _ := tokens.Sign(Claims{Principal: Principal{Subject: "ana", Role: BuyerRole}, ExpiresAt: adminClaims.ExpiresAt})
+	adminToken, _ := tokens.Sign(adminClaims)
+
+	buyerParts := strings.Split(buyerToken, ".")
+	adminParts := strings.Split(adminToken, ".")
+
+	_, err := tokens.Verify(buyerParts[0] + "." + adminParts[1] + "." + buyerParts[2])
+	assert.Equal(t, ErrInvalidToken, err)
+}
+
+func Test_Verify_ExpiredToken(t *testing.T) {
+
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+
+	claims := adminClaims
+	claims.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+
+	token, _ := tokens.Sign(claims)
+
+	_, err := tokens.Verify(token)
+	assert.Equal(t, ErrExpiredToken, err)
+}
+
+func Test_Verify_MissingExpiration(t *testing.T) {
+
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+
+	claims := adminClaims
+	claims.ExpiresAt = 0
+
+	token, _ := tokens.Sign(claims)
+
+	_, err := tokens.Verify(token)
+	assert.Equal(t, ErrMissingExpiration, err)
+}
+
+func Test_Verify_UnknownRole(t *testing.T) {
+
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+
+	token, _ := tokens.Sign(Claims{Principal: Principal{Subject: "carlos", Role: "root"}, ExpiresAt: adminClaims.ExpiresAt})
+
+	_, err := tokens.Verify(token)
+	assert.Equal(t, ErrUnknownRole, err)
+}
+
+func Test_RS256_SignAndVerify(t *testing.T) {
+
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	tokens, err := NewRS256Tokens(&privateKey.PublicKey, privateKey)
+	assert.Nil(t, err)
+
+	token, err := tokens.Sign(adminClaims)
+	assert.Nil(t, err)
+
+	verifyOnly, _ := NewRS256Tokens(&privateKey.PublicKey, nil)
+
+	claims, err := verifyOnly.Verify(token)
+	assert.Nil(t, err)
+	assert.Equal(t, adminClaims, claims)
+
+	_, err = verifyOnly.Sign(adminClaims)
+	assert.Equal(t, ErrCannotSign, err)
+}
+
+func Test_RS256_Verify_RejectsHS256Token(t *testing.T) {
+
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	publicKeyBytes, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+
+	rsaTokens, _ := NewRS256Tokens(&privateKey.PublicKey, nil)
+	hmacTokens, _ := NewHS256Tokens(publicKeyBytes)
+
+	token, _ := hmacTokens.Sign(adminClaims)
+
+	_, err := rsaTokens.Verify(token)
+	assert.Equal(t, ErrInvalidToken, err)
+}
+
+func Test_LoadTokens(t *testing.T) {
+
+	dir := t.TempDir()
+
+	secretFile := filepath.Join(dir, "secret")
+	os.WriteFile(secretFile, []byte("secret\n"), 0600)
+
+	hmacTokens, err := LoadTokens(HS256, secretFile)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), hmacTokens.secret)
+
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	publicKeyBytes, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+
+	publicKeyFile := filepath.Join(dir, "public.pem")
+	os.WriteFile(publicKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}), 0600)
+
+	rsaTokens, err := LoadTokens(RS256, publicKeyFile)
+	assert.Nil(t, err)
+	assert.Nil(t, rsaTokens.privateKey)
+
+	_, err = LoadTokens("none", secretFile)
+	assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	_, err = LoadTokens(RS256, secretFile)
+	assert.Equal(t, ErrInvalidKey, err)
+}
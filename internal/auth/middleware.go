@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+// Authenticator accepts either an "Authorization: Bearer <jwt>" header or
+// a static key in the X-API-Key header.
+type Authenticator struct {
+	tokens  *Tokens
+	apiKeys map[string]Principal
+}
+
+// NewAuthenticator takes a nil tokens to accept API keys only.
+func NewAuthenticator(tokens *Tokens, apiKeys map[string]Principal) Authenticator {
+	return Authenticator{
+		tokens:  tokens,
+		apiKeys: apiKeys,
+	}
+}
+
+// Authenticate rejects requests without valid credentials and stores the
+// caller so that RequireRoles and the controllers can read it.
+func (a Authenticator) Authenticate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		principal, err := a.principal(ctx.Request)
+		if err != nil {
+			ctx.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				web.NewResponse(http.StatusUnauthorized, nil, err.Error()),
+			)
+			return
+		}
+
+		SetPrincipal(ctx, principal)
+		ctx.Next()
+	}
+}
+
+// RequireRoles only lets through callers with one of the given roles. It
+// must run after Authenticate.
+func RequireRoles(roles ...Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		principal, ok := PrincipalFrom(ctx)
+		if !ok {
+			ctx.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				web.NewResponse(http.StatusUnauthorized, nil, ErrUnauthorized.Error()),
+			)
+			return
+		}
+
+		for _, role := range roles {
+			if principal.Role == role {
+				ctx.Next()
+				return
+			}
+		}
+
+		ctx.AbortWithStatusJSON(
+			http.StatusForbidden,
+			web.NewResponse(http.StatusForbidden, nil, ErrForbidden.Error()),
+		)
+	}
+}
+
+func (a Authenticator) principal(request *http.Request) (Principal, error) {
+	if key := request.Header.Get(APIKeyHeader); key != "" {
+		return a.apiKeyPrincipal(key)
+	}
+
+	authorization := request.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") || a.tokens == nil {
+		return Principal{}, ErrUnauthorized
+	}
+
+	claims, err := a.tokens.Verify(strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer ")))
+	if err != nil {
+		return Principal{}, err
+	}
+
+	return claims.Principal, nil
+}
+
+func (a Authenticator) apiKeyPrincipal(key string) (Principal, error) {
+	for candidate, principal := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(key)) == 1 {
+			return principal, nil
+		}
+	}
+	return Principal{}, ErrUnauthorized
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Authenticate_APIKey(t *testing.T) {
+
+	router := setupAuthRouter(t)
+
+	response := request(router, "GET", map[string]string{APIKeyHeader: "buyer-key"})
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, `{"sub":"shop","role":"buyer"}`, response.Body.String())
+}
+
+func Test_Authenticate_BearerToken(t *testing.T) {
+
+	router := setupAuthRouter(t)
+
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+	token, _ := tokens.Sign(Claims{Principal: Principal{Subject: "ana", Role: SellerRole, SellerId: 3}, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+
+	response := request(router, "GET", map[string]string{"Authorization": "Bearer " + token})
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, `{"sub":"ana","role":"seller","seller_id":3}`, response.Body.String())
+}
+
+func Test_Authenticate_401(t *testing.T) {
+
+	router := setupAuthRouter(t)
+
+	for _, headers := range []map[string]string{
+		{},
+		{APIKeyHeader: "unknown"},
+		{"Authorization": "Bearer invalid"},
+		{"Authorization": "Basic YWRtaW46YWRtaW4="},
+	} {
+		response := request(router, "GET", headers)
+		assert.Equal(t, http.StatusUnauthorized, response.Code)
+	}
+}
+
+func Test_RequireRoles_403(t *testing.T) {
+
+	router := setupAuthRouter(t)
+
+	response := request(router, "DELETE", map[string]string{APIKeyHeader: "buyer-key"})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = request(router, "DELETE", map[string]string{APIKeyHeader: "admin-key"})
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func Test_SellerScope(t *testing.T) {
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	_, scoped := SellerScope(ctx)
+	assert.False(t, scoped)
+
+	SetPrincipal(ctx, Principal{Subject: "carlos", Role: AdminRole})
+	_, scoped = SellerScope(ctx)
+	assert.False(t, scoped)
+
+	SetPrincipal(ctx, Principal{Subject: "ana", Role: SellerRole, SellerId: 3})
+	sellerId, scoped := SellerScope(ctx)
+	assert.True(t, scoped)
+	assert.Equal(t, uint64(3), sellerId)
+}
+
+func Test_CarrierScope(t *testing.T) {
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	SetPrincipal(ctx, Principal{Subject: "carlos", Role: AdminRole})
+	_, scoped := CarrierScope(ctx)
+	assert.False(t, scoped)
+
+	SetPrincipal(ctx, Principal{Subject: "rapido", Role: CarrierRole, CarrierId: 2})
+	carrierId, scoped := CarrierScope(ctx)
+	assert.True(t, scoped)
+	assert.Equal(t, uint64(2), carrierId)
+}
+
+func request(router *gin.Engine, method string, headers map[string]string) *httptest.ResponseRecorder {
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest(method, "/api/v1/resource", nil)
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	router.ServeHTTP(response, request)
+	return response
+}
+
+func setupAuthRouter(t *testing.T) *gin.Engine {
+	tokens, _ := NewHS256Tokens([]byte("secret"))
+
+	apiKeys, err := ParseAPIKeys("ops:admin-key:admin, shop:buyer-key:buyer")
+	assert.Nil(t, err)
+
+	authenticator := NewAuthenticator(tokens, apiKeys)
+
+	router := gin.Default()
+	router.Use(authenticator.Authenticate())
+	router.GET("/api/v1/resource", func(ctx *gin.Context) {
+		principal, _ := PrincipalFrom(ctx)
+		ctx.JSON(http.StatusOK, principal)
+	})
+	router.DELETE("/api/v1/resource", RequireRoles(AdminRole), func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	return router
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Role string
+
+const (
+	AdminRole             Role = "admin"
+	WarehouseOperatorRole Role = "warehouse_operator"
+	SellerRole            Role = "seller"
+	BuyerRole             Role = "buyer"
+	CarrierRole           Role = "carrier"
+)
+
+var (
+	ErrUnauthorized     = errors.New("missing or invalid credentials")
+	ErrForbidden        = errors.New("operation not allowed for this user")
+	ErrUnknownRole      = errors.New("unknown role")
+	ErrMissingSellerId  = errors.New("seller users must have a seller id")
+	ErrMissingCarrierId = errors.New("carrier users must have a carrier id")
+)
+
+const principalKey = "auth.principal"
+
+// Principal is the authenticated caller of a request, whether it came from
+// a JWT or an API key.
+type Principal struct {
+	Subject   string `json:"sub"`
+	Role      Role   `json:"role"`
+	SellerId  uint64 `json:"seller_id,omitempty"`
+	CarrierId uint64 `json:"carrier_id,omitempty"`
+}
+
+func (p Principal) Validate() error {
+	switch p.Role {
+	case AdminRole, WarehouseOperatorRole, BuyerRole:
+		return nil
+	case SellerRole:
+		if p.SellerId == 0 {
+			return ErrMissingSellerId
+		}
+		return nil
+	case CarrierRole:
+		if p.CarrierId == 0 {
+			return ErrMissingCarrierId
+		}
+		return nil
+	default:
+		return ErrUnknownRole
+	}
+}
+
+// SetScope sets the id a principal is limited to: the carrier id of a
+// carrier, the seller id otherwise.
+func (p *Principal) SetScope(id uint64) {
+	if p.Role == CarrierRole {
+		p.CarrierId = id
+		return
+	}
+	p.SellerId = id
+}
+
+func SetPrincipal(ctx *gin.Context, principal Principal) {
+	ctx.Set(principalKey, principal)
+}
+
+func PrincipalFrom(ctx *gin.Context) (Principal, bool) {
+	value, ok := ctx.Get(principalKey)
+	if !ok {
+		return Principal{}, false
+	}
+	principal, ok := value.(Principal)
+	return principal, ok
+}
+
+// SellerScope returns the seller a request is limited to. It returns false
+// when the caller may act on behalf of any seller.
+func SellerScope(ctx *gin.Context) (uint64, bool) {
+	principal, ok := PrincipalFrom(ctx)
+	if !ok || principal.Role != SellerRole {
+		return 0, false
+	}
+	return principal.SellerId, true
+}
+
+// CarrierScope returns the carrier a request is limited to. It returns
+// false when the caller may act on behalf of any carrier.
+func CarrierScope(ctx *gin.Context) (uint64, bool) {
+	principal, ok := PrincipalFrom(ctx)
+	if !ok || principal.Role != CarrierRole {
+		return 0, false
+	}
+	return principal.CarrierId, true
+}
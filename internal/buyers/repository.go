@@ -3,25 +3,38 @@ package buyers
 import (
 	"database/sql"
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"log"
 )
 
 type BuyerRepository interface {
 	Create(cardNumberId, firstName, lastName string) (models.Buyer, error)
 	Get(id uint64) (models.Buyer, error)
-	GetAll() ([]models.Buyer, error)
+	GetAll(params query.Params) (query.Page[models.Buyer], error)
 	CountPurchaseOrdersByBuyer(id uint64) (models.CountBuyer, error)
-	CountPurchaseOrdersByBuyers() ([]models.CountBuyer, error)
+	CountPurchaseOrdersByBuyers() report.Rows[models.CountBuyer]
 	Delete(id uint64) error
 	Update(updatedBuyer models.Buyer) (models.Buyer, error)
 	ExistsBuyerCardNumberId(cardNumberId string) (bool, error)
 }
 
+var buyersQuery = query.NewBuilder(
+	"buyers",
+	"id, id_card_number, first_name, last_name",
+	map[string]string{
+		"id":             "id",
+		"card_number_id": "id_card_number",
+		"first_name":     "first_name",
+		"last_name":      "last_name",
+	},
+)
+
 type buyerRepository struct {
-	db *sql.DB
+	db models.Querier
 }
 
-func NewBuyerRepository(db *sql.DB) BuyerRepository {
+func NewBuyerRepository(db models.Querier) BuyerRepository {
 	return &buyerRepository{
 		db: db,
 	}
@@ -64,12 +77,17 @@ func (r *buyerRepository) Get(id uint64) (models.Buyer, error) {
 	return buyer, nil
 }
 
-func (r *buyerRepository) GetAll() ([]models.Buyer, error) {
+func (r *buyerRepository) GetAll(params query.Params) (query.Page[models.Buyer], error) {
+
+	statement, args, err := buyersQuery.Build(params)
+	if err != nil {
+		return query.Page[models.Buyer]{}, err
+	}
 
-	stmt, err := r.db.Query("SELECT * FROM buyers")
+	stmt, err := r.db.Query(statement, args...)
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return query.Page[models.Buyer]{}, err
 	}
 	defer stmt.Close()
 
@@ -85,11 +103,11 @@ func (r *buyerRepository) GetAll() ([]models.Buyer, error) {
 			&buyer.LastName,
 		); err != nil {
 			log.Println(err)
-			return nil, err
+			return query.Page[models.Buyer]{}, err
 		}
 		buyers = append(buyers, buyer)
 	}
-	return buyers, nil
+	return query.NewPage(buyers, params)
 }
 
 func (r *buyerRepository) CountPurchaseOrdersByBuyer(id uint64) (models.CountBuyer, error) {
@@ -123,10 +141,9 @@ func (r *buyerRepository) CountPurchaseOrdersByBuyer(id uint64) (models.CountBuy
 	return buyer, nil
 }
 
-func (r *buyerRepository) CountPurchaseOrdersByBuyers() ([]models.CountBuyer, error) {
-	var buyers []models.CountBuyer
-
-	stmt, err := r.db.Query(`
+func (r *buyerRepository) CountPurchaseOrdersByBuyers() report.Rows[models.CountBuyer] {
+	return report.Query(func() (*sql.Rows, error) {
+		return r.db.Query(`
 	SELECT buyers.id, 
 	       id_card_number, 
 	       first_name, 
@@ -137,27 +154,15 @@ func (r *buyerRepository) CountPurchaseOrdersByBuyers() ([]models.CountBuyer, er
 	ON buyers.id = purchase_orders.buyer_id
 	GROUP BY (buyers.id)
 	`)
-
-	if err != nil {
-		return nil, err
-	}
-	defer stmt.Close()
-
-	for stmt.Next() {
-		var buyer models.CountBuyer
-
-		if err = stmt.Scan(
+	}, func(stmt *sql.Rows, buyer *models.CountBuyer) error {
+		return stmt.Scan(
 			&buyer.Id,
 			&buyer.CardNumberId,
 			&buyer.FirstName,
 			&buyer.LastName,
 			&buyer.PurchaseOrdersCount,
-		); err != nil {
-			return nil, err
-		}
-		buyers = append(buyers, buyer)
-	}
-	return buyers, nil
+		)
+	})
 }
 
 func (r *buyerRepository) Delete(id uint64) error {
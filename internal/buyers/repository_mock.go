@@ -1,6 +1,10 @@
 package buyers
 
-import db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
 
 type mockBuyerRepository struct {
 	result                  any
@@ -9,11 +13,11 @@ type mockBuyerRepository struct {
 	getById                 db.Buyer
 }
 
-func (m mockBuyerRepository) GetAll() ([]db.Buyer, error) {
+func (m mockBuyerRepository) GetAll(params query.Params) (query.Page[db.Buyer], error) {
 	if m.err != nil {
-		return []db.Buyer{}, m.err
+		return query.Page[db.Buyer]{}, m.err
 	}
-	return m.result.([]db.Buyer), nil
+	return query.NewPage(m.result.([]db.Buyer), params)
 }
 
 func (m mockBuyerRepository) Get(id uint64) (db.Buyer, error) {
@@ -55,9 +59,9 @@ func (m mockBuyerRepository) CountPurchaseOrdersByBuyer(id uint64) (db.CountBuye
 	return m.result.(db.CountBuyer), nil
 }
 
-func (m mockBuyerRepository) CountPurchaseOrdersByBuyers() ([]db.CountBuyer, error) {
+func (m mockBuyerRepository) CountPurchaseOrdersByBuyers() report.Rows[db.CountBuyer] {
 	if m.err != nil {
-		return []db.CountBuyer{}, m.err
+		return report.Err[db.CountBuyer](m.err)
 	}
-	return m.result.([]db.CountBuyer), nil
+	return report.Slice(m.result.([]db.CountBuyer))
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -61,8 +62,8 @@ func Test_Repo_GetAll_Buyer_OK(t *testing.T) {
 	_, err = repository.Create("22", "Jose", "Santos")
 	assert.Nil(t, err)
 
-	foundBuyers, _ := repository.GetAll()
-	assert.Equal(t, expectedCountRouws, len(foundBuyers))
+	foundBuyers, _ := repository.GetAll(query.Params{})
+	assert.Equal(t, expectedCountRouws, len(foundBuyers.Items))
 
 	util.DropDB(database)
 
@@ -76,7 +77,7 @@ func Test_Repo_GetAll_Buyer_ConnectionError(t *testing.T) {
 	repository := NewBuyerRepository(database)
 
 	database.Close()
-	_, err := repository.GetAll()
+	_, err := repository.GetAll(query.Params{})
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -3,6 +3,8 @@ package buyers
 import (
 	"errors"
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/imdario/mergo"
 )
 
@@ -14,9 +16,9 @@ var (
 type BuyerService interface {
 	Create(cardNumberId, firstName, lastName string) (models.Buyer, error)
 	Get(id uint64) (models.Buyer, error)
-	GetAll() ([]models.Buyer, error)
+	GetAll(params query.Params) (query.Page[models.Buyer], error)
 	CountPurchaseOrdersByBuyer(id uint64) (models.CountBuyer, error)
-	CountPurchaseOrdersByBuyers() ([]models.CountBuyer, error)
+	CountPurchaseOrdersByBuyers() report.Rows[models.CountBuyer]
 	Update(id uint64, cardNumberId, firstName, lastName string) (models.Buyer, error)
 	Delete(id uint64) error
 }
@@ -48,15 +50,15 @@ func (s *buyerService) Get(id uint64) (models.Buyer, error) {
 	return s.buyerRepository.Get(id)
 }
 
-func (s *buyerService) GetAll() ([]models.Buyer, error) {
-	return s.buyerRepository.GetAll()
+func (s *buyerService) GetAll(params query.Params) (query.Page[models.Buyer], error) {
+	return s.buyerRepository.GetAll(params)
 }
 
 func (s *buyerService) CountPurchaseOrdersByBuyer(id uint64) (models.CountBuyer, error) {
 	return s.buyerRepository.CountPurchaseOrdersByBuyer(id)
 }
 
-func (s *buyerService) CountPurchaseOrdersByBuyers() ([]models.CountBuyer, error) {
+func (s *buyerService) CountPurchaseOrdersByBuyers() report.Rows[models.CountBuyer] {
 	return s.buyerRepository.CountPurchaseOrdersByBuyers()
 }
 
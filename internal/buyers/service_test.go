@@ -2,6 +2,7 @@ package buyers
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -57,10 +58,10 @@ func Test_GetAll_Ok(t *testing.T) {
 	}
 
 	service := NewBuyerService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
 	assert.Nil(t, err)
-	assert.Equal(t, expectResult, result)
+	assert.Equal(t, expectResult, result.Items)
 }
 
 func Test_Get_Id_Non_Existent(t *testing.T) {
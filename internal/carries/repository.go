@@ -4,6 +4,21 @@ import (
 	"database/sql"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
+
+const carrierColumns = "id, cid, company_name, address, telephone, locality_id"
+
+var carriersQuery = query.NewBuilder(
+	"carriers",
+	carrierColumns,
+	map[string]string{
+		"id":           "id",
+		"cid":          "cid",
+		"company_name": "company_name",
+		"locality_id":  "locality_id",
+	},
 )
 
 type CarrierInfo struct {
@@ -15,15 +30,26 @@ type CarrierInfo struct {
 type CarrierRepository interface {
 	Create(cid string, companyName string, address string, telephone string, localityId string) (database.Carrier, error)
 	ExistsCarrierCid(cid string) (bool, error)
-	GetAllCarrierInfo(id string) ([]CarrierInfo, error)
+	// GetAllCarrierInfo counts the carriers of a locality, or of every
+	// locality when the id is empty.
+	GetAllCarrierInfo(id string) report.Rows[CarrierInfo]
 	FindLocalityId(localityId string) bool
+	GetAll(params query.Params) (query.Page[database.Carrier], error)
+	Get(id uint64) (database.Carrier, error)
+	Update(carrier database.Carrier) (database.Carrier, error)
+	Delete(id uint64) error
+	// CountShipments counts the shipments assigned to the carrier.
+	CountShipments(id uint64) (uint64, error)
+	// FindAvailable returns the carrier with the fewest shipments, in the
+	// locality or anywhere when it is empty, or sql.ErrNoRows.
+	FindAvailable(localityId string) (database.Carrier, error)
 }
 
 type carrierRepository struct {
-	db *sql.DB
+	db database.Querier
 }
 
-func NewCarrierRepository(db *sql.DB) CarrierRepository {
+func NewCarrierRepository(db database.Querier) CarrierRepository {
 	return &carrierRepository{
 		db: db,
 	}
@@ -78,43 +104,139 @@ func (r *carrierRepository) ExistsCarrierCid(cid string) (bool, error) {
 	return false, nil
 }
 
-func (r *carrierRepository) GetAllCarrierInfo(id string) ([]CarrierInfo, error) {
-	var carrierInfo []CarrierInfo	
-
-	stmt, err := r.db.Query(` SELECT localities.id, locality_name, COUNT(carriers.id)
+func (r *carrierRepository) GetAllCarrierInfo(id string) report.Rows[CarrierInfo] {
+	query := ` SELECT localities.id, locality_name, COUNT(carriers.id)
 	FROM localities
 	LEFT JOIN carriers
 	ON localities.id = carriers.locality_id
-	WHERE localities.id = ?
-	GROUP BY (localities.id);`, id)
+	WHERE ? = '' OR localities.id = ?
+	GROUP BY (localities.id)
+	ORDER BY localities.id;`
+
+	return report.Query(func() (*sql.Rows, error) {
+		return r.db.Query(query, id, id)
+	}, func(stmt *sql.Rows, carrier *CarrierInfo) error {
+		return stmt.Scan(
+			&carrier.LocalityId,
+			&carrier.LocalityName,
+			&carrier.CarriesCount,
+		)
+	})
+}
+
+func (r *carrierRepository) FindLocalityId(localityId string) bool {
+
+    var locality database.Locality
+
+    err := r.db.QueryRow("SELECT id FROM localities WHERE id = ?", localityId).Scan(&locality.Id)
+
+    return err == nil
+}
+
+func (r *carrierRepository) GetAll(params query.Params) (query.Page[database.Carrier], error) {
+	statement, args, err := carriersQuery.Build(params)
 	if err != nil {
-		return nil, err
+		return query.Page[database.Carrier]{}, err
 	}
 
-	defer stmt.Close()
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[database.Carrier]{}, err
+	}
 
-	for stmt.Next() {
-		var carrier CarrierInfo
+	defer rows.Close()
 
-		if err = stmt.Scan(
-			&carrier.LocalityId,
-			&carrier.LocalityName,
-			&carrier.CarriesCount,						
-		); err != nil {
-			return []CarrierInfo{}, err
+	var carriers []database.Carrier
+
+	for rows.Next() {
+		var carrier database.Carrier
+
+		if err := scanCarrier(rows, &carrier); err != nil {
+			return query.Page[database.Carrier]{}, err
 		}
 
-		carrierInfo = append(carrierInfo, carrier)
+		carriers = append(carriers, carrier)
 	}
 
-	return carrierInfo, nil
+	return query.NewPage(carriers, params)
 }
 
-func (r *carrierRepository) FindLocalityId(localityId string) bool {
+func (r *carrierRepository) Get(id uint64) (database.Carrier, error) {
+	var carrier database.Carrier
 
-    var locality database.Locality
+	row := r.db.QueryRow("SELECT "+carrierColumns+" FROM carriers WHERE id = ?", id)
+	if err := scanCarrier(row, &carrier); err != nil {
+		return database.Carrier{}, err
+	}
 
-    err := r.db.QueryRow("SELECT id FROM localities WHERE id = ?", localityId).Scan(&locality.Id)
+	return carrier, nil
+}
 
-    return err == nil
-}
\ No newline at end of file
+func (r *carrierRepository) Update(carrier database.Carrier) (database.Carrier, error) {
+	stmt, err := r.db.Prepare("UPDATE carriers SET cid = ?, company_name = ?, address = ?, telephone = ?, locality_id = ? WHERE id = ?")
+	if err != nil {
+		return database.Carrier{}, err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(carrier.Cid, carrier.CompanyName, carrier.Address, carrier.Telephone, carrier.LocalityID, carrier.Id)
+	if err != nil {
+		return database.Carrier{}, err
+	}
+
+	return carrier, nil
+}
+
+func (r *carrierRepository) Delete(id uint64) error {
+	stmt, err := r.db.Prepare("DELETE FROM carriers WHERE id = ?")
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(id)
+	return err
+}
+
+func (r *carrierRepository) CountShipments(id uint64) (uint64, error) {
+	var count uint64
+	err := r.db.QueryRow("SELECT COUNT(*) FROM shipments WHERE carrier_id = ?", id).Scan(&count)
+	return count, err
+}
+
+func (r *carrierRepository) FindAvailable(localityId string) (database.Carrier, error) {
+	var carrier database.Carrier
+
+	row := r.db.QueryRow(`
+		SELECT c.id, c.cid, c.company_name, c.address, c.telephone, c.locality_id
+		FROM carriers c
+		LEFT JOIN shipments s ON s.carrier_id = c.id
+		WHERE ? = '' OR c.locality_id = ?
+		GROUP BY c.id, c.cid, c.company_name, c.address, c.telephone, c.locality_id
+		ORDER BY COUNT(s.id), c.id
+		LIMIT 1`, localityId, localityId,
+	)
+
+	if err := scanCarrier(row, &carrier); err != nil {
+		return database.Carrier{}, err
+	}
+
+	return carrier, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCarrier(row scanner, carrier *database.Carrier) error {
+	return row.Scan(
+		&carrier.Id,
+		&carrier.Cid,
+		&carrier.CompanyName,
+		&carrier.Address,
+		&carrier.Telephone,
+		&carrier.LocalityID,
+	)
+}
@@ -0,0 +1,84 @@
+package carries
+
+import (
+	"database/sql"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
+
+type MockCarrierRepository struct {
+	Result      any
+	Err         error
+	GetById     database.Carrier
+	ExistsCid   bool
+	HasLocality bool
+	Shipments   uint64
+	// Available lists the carriers FindAvailable picks from, by locality.
+	Available map[string]database.Carrier
+	Updated   *database.Carrier
+	Deleted   *bool
+}
+
+func (m MockCarrierRepository) Create(cid string, companyName string, address string, telephone string, localityId string) (database.Carrier, error) {
+	if m.Err != nil {
+		return database.Carrier{}, m.Err
+	}
+	return m.Result.(database.Carrier), nil
+}
+
+func (m MockCarrierRepository) ExistsCarrierCid(cid string) (bool, error) {
+	return m.ExistsCid, nil
+}
+
+func (m MockCarrierRepository) GetAllCarrierInfo(id string) report.Rows[CarrierInfo] {
+	if m.Err != nil {
+		return report.Err[CarrierInfo](m.Err)
+	}
+	return report.Slice(m.Result.([]CarrierInfo))
+}
+
+func (m MockCarrierRepository) FindLocalityId(localityId string) bool {
+	return m.HasLocality
+}
+
+func (m MockCarrierRepository) GetAll(params query.Params) (query.Page[database.Carrier], error) {
+	if m.Err != nil {
+		return query.Page[database.Carrier]{}, m.Err
+	}
+	return query.NewPage(m.Result.([]database.Carrier), params)
+}
+
+func (m MockCarrierRepository) Get(id uint64) (database.Carrier, error) {
+	if (m.GetById == database.Carrier{}) {
+		return database.Carrier{}, sql.ErrNoRows
+	}
+	return m.GetById, nil
+}
+
+func (m MockCarrierRepository) Update(carrier database.Carrier) (database.Carrier, error) {
+	if m.Updated != nil {
+		*m.Updated = carrier
+	}
+	return carrier, m.Err
+}
+
+func (m MockCarrierRepository) Delete(id uint64) error {
+	if m.Deleted != nil {
+		*m.Deleted = true
+	}
+	return m.Err
+}
+
+func (m MockCarrierRepository) CountShipments(id uint64) (uint64, error) {
+	return m.Shipments, nil
+}
+
+func (m MockCarrierRepository) FindAvailable(localityId string) (database.Carrier, error) {
+	carrier, ok := m.Available[localityId]
+	if !ok {
+		return database.Carrier{}, sql.ErrNoRows
+	}
+	return carrier, nil
+}
@@ -1,12 +1,15 @@
 package carries
 
 import (
+    "database/sql"
     "testing"
 
     _ "github.com/mattn/go-sqlite3"
     "github.com/stretchr/testify/assert"
 
     models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+    "github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+    "github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
     util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -116,13 +119,35 @@ func Test_Repo_GetCarrierInfo_Ok(t *testing.T) {
 
     assert.Nil(t, err)
 
-    result, err := repository.GetAllCarrierInfo("11065001")
+    result, err := report.Collect(repository.GetAllCarrierInfo("11065001"))
     assert.Nil(t, err)
     assert.Equal(t, localityInfo, result)
 
     util.DropDB(database)
 }
 
+func Test_Repo_GetCarrierInfo_AllLocalities(t *testing.T) {
+
+    database := util.CreateDB()
+    util.QueryExec(database, CREATE_LOCALITY_TABLE)
+    util.QueryExec(database, CREATE_CARRIERS_TABLE)
+    util.QueryExec(database, INSERT_LOCALITY)
+    util.QueryExec(database, INSERT_OTHER_LOCALITY)
+    repository := NewCarrierRepository(database)
+
+    _, err := repository.Create("SDX", "CTX", "Rua Marselha", "1234561238", "11065001")
+    assert.Nil(t, err)
+
+    result, err := report.Collect(repository.GetAllCarrierInfo(""))
+    assert.Nil(t, err)
+    assert.Equal(t, []CarrierInfo{
+        {LocalityId: "10235001", CarriesCount: 0, LocalityName: "Campinas"},
+        {LocalityId: "11065001", CarriesCount: 1, LocalityName: "Santos"},
+    }, result)
+
+    util.DropDB(database)
+}
+
 func Test_Repo_GetCarrierInfo_InternalError(t *testing.T) {
 
     database := util.CreateDB()
@@ -133,12 +158,108 @@ func Test_Repo_GetCarrierInfo_InternalError(t *testing.T) {
 	
 	database.Close()
 
-    _, err := repository.GetAllCarrierInfo("11065001")
+    _, err := report.Collect(repository.GetAllCarrierInfo("11065001"))
     assert.Error(t, err)
 
     util.DropDB(database)
 }
 
+func Test_Repo_GetAll_ByLocality(t *testing.T) {
+
+    database := createCarriersDB()
+    repository := NewCarrierRepository(database)
+
+    page, err := repository.GetAll(query.Params{Limit: 10, Filters: map[string]string{"locality_id": "11065001"}})
+
+    assert.Nil(t, err)
+    assert.Len(t, page.Items, 2)
+    assert.Equal(t, "Rapido", page.Items[0].CompanyName)
+
+    util.DropDB(database)
+}
+
+func Test_Repo_UpdateAndGet(t *testing.T) {
+
+    database := createCarriersDB()
+    repository := NewCarrierRepository(database)
+
+    carrier, err := repository.Get(1)
+    assert.Nil(t, err)
+
+    carrier.CompanyName = "Rapido Express"
+    _, err = repository.Update(carrier)
+    assert.Nil(t, err)
+
+    found, err := repository.Get(1)
+    assert.Nil(t, err)
+    assert.Equal(t, carrier, found)
+
+    _, err = repository.Get(9)
+    assert.Equal(t, sql.ErrNoRows, err)
+
+    util.DropDB(database)
+}
+
+func Test_Repo_Delete(t *testing.T) {
+
+    database := createCarriersDB()
+    repository := NewCarrierRepository(database)
+
+    err := repository.Delete(3)
+    assert.Nil(t, err)
+
+    _, err = repository.Get(3)
+    assert.Equal(t, sql.ErrNoRows, err)
+
+    util.DropDB(database)
+}
+
+func Test_Repo_CountShipments(t *testing.T) {
+
+    database := createCarriersDB()
+    repository := NewCarrierRepository(database)
+
+    rapido, err := repository.CountShipments(1)
+    assert.Nil(t, err)
+    assert.Equal(t, uint64(2), rapido)
+
+    lento, _ := repository.CountShipments(3)
+    assert.Equal(t, uint64(0), lento)
+
+    util.DropDB(database)
+}
+
+func Test_Repo_FindAvailable_ShouldPickTheCarrierWithFewestShipments(t *testing.T) {
+
+    database := createCarriersDB()
+    repository := NewCarrierRepository(database)
+
+    santos, err := repository.FindAvailable("11065001")
+    assert.Nil(t, err)
+    assert.Equal(t, uint64(2), santos.Id)
+
+    anywhere, err := repository.FindAvailable("")
+    assert.Nil(t, err)
+    assert.Equal(t, uint64(3), anywhere.Id)
+
+    _, err = repository.FindAvailable("99999999")
+    assert.Equal(t, sql.ErrNoRows, err)
+
+    util.DropDB(database)
+}
+
+func createCarriersDB() *sql.DB {
+    database := util.CreateDB()
+    util.QueryExec(database, CREATE_LOCALITY_TABLE)
+    util.QueryExec(database, CREATE_CARRIERS_TABLE)
+    util.QueryExec(database, CREATE_SHIPMENTS_TABLE)
+    util.QueryExec(database, INSERT_LOCALITY)
+    util.QueryExec(database, INSERT_OTHER_LOCALITY)
+    util.QueryExec(database, INSERT_CARRIERS)
+    util.QueryExec(database, INSERT_SHIPMENTS)
+    return database
+}
+
 const CREATE_LOCALITY_TABLE = `
     CREATE TABLE "localities" (
         id TEXT NOT NULL,
@@ -172,4 +293,35 @@ const CREATE_CARRIERS_TABLE = `
 const INSERT_LOCALITY = `
 	INSERT INTO localities(id, locality_name, province_id)
 	VALUES  ("11065001", "Santos", 1);
-`
\ No newline at end of file
+`
+const INSERT_OTHER_LOCALITY = `
+	INSERT INTO localities(id, locality_name, province_id)
+	VALUES  ("10235001", "Campinas", 1);
+`
+
+const INSERT_CARRIERS = `
+	INSERT INTO carriers(cid, company_name, address, telephone, locality_id)
+	VALUES ("CID1", "Rapido", "Rua 1", "1111", "11065001"),
+		("CID2", "Veloz", "Rua 2", "2222", "11065001"),
+		("CID3", "Lento", "Rua 3", "3333", "10235001");
+`
+
+// Rapido has two shipments and Veloz one.
+const INSERT_SHIPMENTS = `
+	INSERT INTO shipments(purchase_order_id, carrier_id, warehouse_id, locality_id, tracking_code, created_at)
+	VALUES (1, 1, 1, "11065001", "T1", "2022-08-01 10:00:00"),
+		(2, 1, 1, "11065001", "T2", "2022-08-01 10:00:00"),
+		(3, 2, 1, "11065001", "T3", "2022-08-01 10:00:00");
+`
+
+const CREATE_SHIPMENTS_TABLE = `
+	CREATE TABLE "shipments"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		purchase_order_id BIGINT NOT NULL,
+		carrier_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		locality_id TEXT NOT NULL,
+		tracking_code TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
@@ -4,17 +4,28 @@ import (
 	"errors"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+	"github.com/imdario/mergo"
 )
 
 var (
 	ExistsCarrierCidError = errors.New("carriers cid already exists")
 	CarrierNotFoundError  = errors.New("carriers not found")
 	LocalityIdNotExistsError = errors.New("locality id does not exist")
+	CarrierHasShipmentsError = errors.New("carrier has shipments")
 )
 
 type CarrierService interface {
 	Create(Cid string, Company_Name string, Address string, Telephone string, localityId string) (database.Carrier, error)
-	GetAllCarrierInfo(id string) ([]CarrierInfo, error)
+	// GetAllCarrierInfo counts the carriers of a locality, or of every
+	// locality when the id is empty.
+	GetAllCarrierInfo(id string) (report.Rows[CarrierInfo], error)
+	GetAll(params query.Params) (query.Page[database.Carrier], error)
+	Get(id uint64) (database.Carrier, error)
+	Update(id uint64, cid string, companyName string, address string, telephone string, localityId string) (database.Carrier, error)
+	// Delete removes a carrier that was never assigned a shipment.
+	Delete(id uint64) error
 }
 
 type carrierService struct {
@@ -45,16 +56,76 @@ func (s *carrierService) Create(cid string, companyName string, address string,
 	return s.carrierRepo.Create(cid, companyName, address, telephone, localityId)
 }
 
-func (s *carrierService) GetAllCarrierInfo(id string) ([]CarrierInfo, error) {
-	isLocalityIdFound := s.carrierRepo.FindLocalityId(id)
+func (s *carrierService) GetAllCarrierInfo(id string) (report.Rows[CarrierInfo], error) {
+	isLocalityIdFound := id == "" || s.carrierRepo.FindLocalityId(id)
 
 	if !isLocalityIdFound {
-		return []CarrierInfo{}, CarrierNotFoundError
+		return nil, CarrierNotFoundError
 	}
-	carrierInfo, err := s.carrierRepo.GetAllCarrierInfo(id)
+
+	return s.carrierRepo.GetAllCarrierInfo(id), nil
+}
+
+func (s *carrierService) GetAll(params query.Params) (query.Page[database.Carrier], error) {
+	return s.carrierRepo.GetAll(params)
+}
+
+func (s *carrierService) Get(id uint64) (database.Carrier, error) {
+	carrier, err := s.carrierRepo.Get(id)
 	if err != nil {
-		return []CarrierInfo{}, err
+		return database.Carrier{}, CarrierNotFoundError
+	}
+
+	return carrier, nil
+}
+
+func (s *carrierService) Update(id uint64, cid string, companyName string, address string, telephone string, localityId string) (database.Carrier, error) {
+	foundCarrier, err := s.Get(id)
+	if err != nil {
+		return database.Carrier{}, err
+	}
+
+	if cid != "" && cid != foundCarrier.Cid {
+		isUsedCid, err := s.carrierRepo.ExistsCarrierCid(cid)
+		if err != nil {
+			return database.Carrier{}, err
+		}
+
+		if isUsedCid {
+			return database.Carrier{}, ExistsCarrierCidError
+		}
+	}
+
+	if localityId != "" && !s.carrierRepo.FindLocalityId(localityId) {
+		return database.Carrier{}, LocalityIdNotExistsError
+	}
+
+	updatedCarrier := database.Carrier{
+		Cid:         cid,
+		CompanyName: companyName,
+		Address:     address,
+		Telephone:   telephone,
+		LocalityID:  localityId,
+	}
+
+	mergo.Merge(&foundCarrier, updatedCarrier, mergo.WithOverride)
+
+	return s.carrierRepo.Update(foundCarrier)
+}
+
+func (s *carrierService) Delete(id uint64) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+
+	shipments, err := s.carrierRepo.CountShipments(id)
+	if err != nil {
+		return err
+	}
+
+	if shipments > 0 {
+		return CarrierHasShipmentsError
 	}
 
-	return carrierInfo, nil
+	return s.carrierRepo.Delete(id)
 }
@@ -0,0 +1,118 @@
+package carries
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+	"github.com/stretchr/testify/assert"
+)
+
+var rapido = database.Carrier{Id: 1, Cid: "CID1", CompanyName: "Rapido", Address: "Rua 1", Telephone: "1111", LocalityID: "11065001"}
+
+func Test_GetAllCarrierInfo_AllLocalities(t *testing.T) {
+
+	expectedResult := []CarrierInfo{{LocalityId: "11065001", CarriesCount: 1, LocalityName: "Santos"}}
+	service := NewCarrierService(MockCarrierRepository{Result: expectedResult})
+
+	rows, err := service.GetAllCarrierInfo("")
+	assert.Nil(t, err)
+
+	result, err := report.Collect(rows)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func Test_GetAllCarrierInfo_NotFound(t *testing.T) {
+
+	service := NewCarrierService(MockCarrierRepository{HasLocality: false})
+
+	_, err := service.GetAllCarrierInfo("99999999")
+
+	assert.Equal(t, CarrierNotFoundError, err)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+
+	service := NewCarrierService(MockCarrierRepository{})
+
+	_, err := service.Get(1)
+
+	assert.Equal(t, CarrierNotFoundError, err)
+}
+
+func Test_Update_Ok(t *testing.T) {
+
+	var updated database.Carrier
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, HasLocality: true, Updated: &updated})
+
+	result, err := service.Update(1, "", "Rapido Express", "", "", "10235001")
+
+	expected := rapido
+	expected.CompanyName = "Rapido Express"
+	expected.LocalityID = "10235001"
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+	assert.Equal(t, expected, updated)
+}
+
+func Test_Update_SameCidIsNotAConflict(t *testing.T) {
+
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, ExistsCid: true})
+
+	_, err := service.Update(1, "CID1", "", "", "", "")
+
+	assert.Nil(t, err)
+}
+
+func Test_Update_ExistsCarrierCidError(t *testing.T) {
+
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, ExistsCid: true})
+
+	_, err := service.Update(1, "CID2", "", "", "", "")
+
+	assert.Equal(t, ExistsCarrierCidError, err)
+}
+
+func Test_Update_LocalityIdNotExistsError(t *testing.T) {
+
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, HasLocality: false})
+
+	_, err := service.Update(1, "", "", "", "", "99999999")
+
+	assert.Equal(t, LocalityIdNotExistsError, err)
+}
+
+func Test_Delete_Ok(t *testing.T) {
+
+	var deleted bool
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, Deleted: &deleted})
+
+	err := service.Delete(1)
+
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func Test_Delete_CarrierHasShipmentsError(t *testing.T) {
+
+	var deleted bool
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, Shipments: 2, Deleted: &deleted})
+
+	err := service.Delete(1)
+
+	assert.Equal(t, CarrierHasShipmentsError, err)
+	assert.False(t, deleted)
+}
+
+func Test_Delete_RepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := NewCarrierService(MockCarrierRepository{GetById: rapido, Err: expectedError})
+
+	err := service.Delete(1)
+
+	assert.Equal(t, expectedError, err)
+}
@@ -3,25 +3,39 @@ package employees
 import (
 	"database/sql"
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type EmployeeRepository interface {
-	GetAll() ([]models.Employee, error)
+	GetAll(params query.Params) (query.Page[models.Employee], error)
 	Create(cardNumberId string, firstName string, lastName string, wareHouseId uint64) (models.Employee, error)
 	Get(id uint64) (models.Employee, error)
 	Update(updatedEmployee models.Employee) (models.Employee, error)
 	Delete(id uint64) error
 	ExistsEmployeeCardNumberId(cardNumberId string) (bool, error)
 	CountInboundOrdersByEmployeeId(id uint64) (models.ReportInboundOrders, error)
-	CountInboundOrders() ([]models.ReportInboundOrders, error)
+	CountInboundOrders() report.Rows[models.ReportInboundOrders]
 	ExistsEmployee(uint64) (bool)
 }
 
+var employeesQuery = query.NewBuilder(
+	"employees",
+	"id, id_card_number, first_name, last_name, warehouse_id",
+	map[string]string{
+		"id":             "id",
+		"card_number_id": "id_card_number",
+		"first_name":     "first_name",
+		"last_name":      "last_name",
+		"warehouse_id":   "warehouse_id",
+	},
+)
+
 type employeeRepository struct {
-	db *sql.DB
+	db models.Querier
 }
 
-func NewRepository(employees *sql.DB) EmployeeRepository {
+func NewRepository(employees models.Querier) EmployeeRepository {
 	return &employeeRepository{
 		db: employees,
 	}
@@ -96,20 +110,17 @@ func (r *employeeRepository) Get(id uint64) (models.Employee, error) {
 	return myEmployee, nil
 }
 
-func (r *employeeRepository) GetAll() ([]models.Employee, error) {
+func (r *employeeRepository) GetAll(params query.Params) (query.Page[models.Employee], error) {
 	var employees []models.Employee
 
-	stmt, err := r.db.Query(`
-		SELECT 
-		    id, 
-		    id_card_number, 
-		    first_name, 
-		    last_name, 
-		    warehouse_id 
-		FROM employees
-		`)
+	statement, args, err := employeesQuery.Build(params)
+	if err != nil {
+		return query.Page[models.Employee]{}, err
+	}
+
+	stmt, err := r.db.Query(statement, args...)
 	if err != nil {
-		return nil, err
+		return query.Page[models.Employee]{}, err
 	}
 
 	defer stmt.Close()
@@ -124,12 +135,12 @@ func (r *employeeRepository) GetAll() ([]models.Employee, error) {
 			&oneEmployee.WarehouseId,
 		)
 		if err != nil {
-			return nil, err
+			return query.Page[models.Employee]{}, err
 		}
 		employees = append(employees, oneEmployee)
 	}
 
-	return employees, nil
+	return query.NewPage(employees, params)
 }
 
 func (r *employeeRepository) Update(updatedEmployee models.Employee) (models.Employee, error) {
@@ -251,10 +262,9 @@ func (r *employeeRepository) CountInboundOrdersByEmployeeId(id uint64) (models.R
 	return report, nil
 }
 
-func (r *employeeRepository) CountInboundOrders() ([]models.ReportInboundOrders, error) {
-	var reports []models.ReportInboundOrders
-
-	stmt, err := r.db.Query(`
+func (r *employeeRepository) CountInboundOrders() report.Rows[models.ReportInboundOrders] {
+	return report.Query(func() (*sql.Rows, error) {
+		return r.db.Query(`
 	SELECT 	employees.id, 
 			id_card_number, 
 			first_name, 
@@ -266,15 +276,8 @@ func (r *employeeRepository) CountInboundOrders() ([]models.ReportInboundOrders,
 	ON employees.id = inbound_orders.employee_id
 	GROUP BY employees.id;
 		`)
-	if err != nil {
-		return nil, err
-	}
-
-	defer stmt.Close()
-
-	for stmt.Next() {
-		var oneReport models.ReportInboundOrders
-		err := stmt.Scan(
+	}, func(stmt *sql.Rows, oneReport *models.ReportInboundOrders) error {
+		return stmt.Scan(
 			&oneReport.Id,
 			&oneReport.CardNumberId,
 			&oneReport.FirstName,
@@ -282,13 +285,7 @@ func (r *employeeRepository) CountInboundOrders() ([]models.ReportInboundOrders,
 			&oneReport.WarehouseId,
 			&oneReport.InboundOrdersCount,
 		)
-		if err != nil {
-			return nil, err
-		}
-		reports = append(reports, oneReport)
-	}
-
-	return reports, nil
+	})
 }
 
 func (r *employeeRepository) ExistsEmployee(id uint64) (bool) {
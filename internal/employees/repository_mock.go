@@ -2,6 +2,8 @@ package employees
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type MockEmployeeRepository struct {
@@ -11,11 +13,11 @@ type MockEmployeeRepository struct {
 	GetById            db.Employee
 }
 
-func (m MockEmployeeRepository) GetAll() ([]db.Employee, error) {
+func (m MockEmployeeRepository) GetAll(params query.Params) (query.Page[db.Employee], error) {
 	if m.Err != nil {
-		return []db.Employee{}, m.Err
+		return query.Page[db.Employee]{}, m.Err
 	}
-	return m.Result.([]db.Employee), nil
+	return query.NewPage(m.Result.([]db.Employee), params)
 }
 
 func (m MockEmployeeRepository) Get(id uint64) (db.Employee, error) {
@@ -59,11 +61,11 @@ func (m MockEmployeeRepository) CountInboundOrdersByEmployeeId(id uint64) (db.Re
 	return m.Result.(db.ReportInboundOrders), nil
 }
 
-func (m MockEmployeeRepository) CountInboundOrders() ([]db.ReportInboundOrders, error) {
+func (m MockEmployeeRepository) CountInboundOrders() report.Rows[db.ReportInboundOrders] {
 	if m.Err != nil {
-		return []db.ReportInboundOrders{}, m.Err
+		return report.Err[db.ReportInboundOrders](m.Err)
 	}
-	return m.Result.([]db.ReportInboundOrders), nil
+	return report.Slice(m.Result.([]db.ReportInboundOrders))
 }
 
 func (m MockEmployeeRepository) ExistsEmployee(id uint64) (bool) {
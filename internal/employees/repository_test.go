@@ -2,6 +2,8 @@ package employees
 
 import (
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
@@ -114,8 +116,8 @@ func Test_Repo_GetAll_OK(t *testing.T) {
 	_, err = repository.Create("2", "Will", "Turner", 1)
 	assert.Nil(t, err)
 
-	foundEmployees, err := repository.GetAll()
-	assert.Equal(t, expectedCountRows, len(foundEmployees))
+	foundEmployees, err := repository.GetAll(query.Params{})
+	assert.Equal(t, expectedCountRows, len(foundEmployees.Items))
 
 	util.DropDB(database)
 }
@@ -128,7 +130,7 @@ func Test_Repo_GetAll_ConnectionError(t *testing.T) {
 	repository := NewRepository(database)
 
 	database.Close()
-	_, err := repository.GetAll()
+	_, err := repository.GetAll(query.Params{})
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -340,7 +342,7 @@ func Test_Repo_Count_Inbound_Orders_OK(t *testing.T) {
 	util.QueryExec(database, INSERT_INBOUND_ORDERS)
 
 	repository := NewRepository(database)
-	result, err := repository.CountInboundOrders()
+	result, err := report.Collect(repository.CountInboundOrders())
 	assert.Nil(t, err)
 	assert.Equal(t, expectedReport, result)
 
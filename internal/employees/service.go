@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/imdario/mergo"
 )
 
@@ -14,12 +16,12 @@ var (
 
 type EmployeeService interface {
 	Create(cardNumberId string, firstName string, lastName string, wareHouseId uint64) (db.Employee, error)
-	GetAll() ([]db.Employee, error)
+	GetAll(params query.Params) (query.Page[db.Employee], error)
 	Get(id uint64) (db.Employee, error)
 	Update(id uint64, cardNumberId string, firstName string, lastName string, wareHouseId uint64) (db.Employee, error)
 	Delete(id uint64) error
 	CountInboundOrdersByEmployeeId(id uint64) (db.ReportInboundOrders, error)
-	CountInboundOrders() ([]db.ReportInboundOrders, error)
+	CountInboundOrders() report.Rows[db.ReportInboundOrders]
 }
 
 type employeeService struct {
@@ -85,8 +87,8 @@ func (s *employeeService) Update(id uint64, cardNumberId string, firstName strin
 
 }
 
-func (s *employeeService) GetAll() ([]db.Employee, error) {
-	return s.employeeRepository.GetAll()
+func (s *employeeService) GetAll(params query.Params) (query.Page[db.Employee], error) {
+	return s.employeeRepository.GetAll(params)
 }
 
 func (s *employeeService) Delete(id uint64) error {
@@ -104,6 +106,6 @@ func (s *employeeService) CountInboundOrdersByEmployeeId(id uint64) (db.ReportIn
 	return db.ReportInboundOrders{}, EmployeeNotFoundError
 }
 
-func (s *employeeService) CountInboundOrders() ([]db.ReportInboundOrders, error) {
+func (s *employeeService) CountInboundOrders() report.Rows[db.ReportInboundOrders] {
 	return s.employeeRepository.CountInboundOrders()
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -85,10 +87,10 @@ func Test_GetAll_OK(t *testing.T) {
 	}
 
 	service := NewEmployeeService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
 	assert.Nil(t, err)
-	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, expectedResult, result.Items)
 }
 
 func Test_GetAll_ShouldReturnErrorWhenDatabaseFails(t *testing.T) {
@@ -102,9 +104,9 @@ func Test_GetAll_ShouldReturnErrorWhenDatabaseFails(t *testing.T) {
 	}
 
 	service := NewEmployeeService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
-	assert.Equal(t, expectedResult, result)
+	assert.Empty(t, result.Items)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -253,7 +255,7 @@ func Test_Count_Inbound_Orders_Ok(t *testing.T) {
 	}
 
 	service := NewEmployeeService(mockRepository)
-	result, err := service.CountInboundOrders()
+	result, err := report.Collect(service.CountInboundOrders())
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
@@ -0,0 +1,78 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+)
+
+var InvalidConfigError = errors.New("expiry threshold and check interval must be durations, e.g. 72h")
+
+const (
+	DefaultThreshold = 72 * time.Hour
+	DefaultInterval  = time.Hour
+)
+
+type Config struct {
+	// Threshold is how close to its due date a batch gets an alert. It is
+	// also the default of the within parameter of the report.
+	Threshold time.Duration
+	// Interval between two checks. Zero disables the job.
+	Interval time.Duration
+}
+
+// ConfigFromEnv reads
+//
+//	MERCADO_FRESH_EXPIRY_THRESHOLD       72h by default
+//	MERCADO_FRESH_EXPIRY_CHECK_INTERVAL  1h by default, 0 disables the alerts
+func ConfigFromEnv() (Config, error) {
+	config := Config{Threshold: DefaultThreshold, Interval: DefaultInterval}
+
+	if value := os.Getenv("MERCADO_FRESH_EXPIRY_THRESHOLD"); value != "" {
+		threshold, err := time.ParseDuration(value)
+		if err != nil || threshold <= 0 {
+			return Config{}, InvalidConfigError
+		}
+		config.Threshold = threshold
+	}
+
+	if value := os.Getenv("MERCADO_FRESH_EXPIRY_CHECK_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval < 0 {
+			return Config{}, InvalidConfigError
+		}
+		config.Interval = interval
+	}
+
+	return config, nil
+}
+
+// StartAlerts records the alerts right away and then on every interval,
+// in a goroutine that stops with ctx.
+func StartAlerts(ctx context.Context, service ExpiryService, config Config) {
+	if config.Interval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+
+		for {
+			alerts, err := service.RecordAlerts(config.Threshold)
+			if err != nil {
+				log.Println("expiry alerts:", err)
+			} else if len(alerts) > 0 {
+				log.Printf("expiry alerts: %d batch(es) due within %s", len(alerts), config.Threshold)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,173 @@
+package expiry
+
+import (
+	"database/sql"
+	"strings"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type ExpiryRepository interface {
+	// FindExpiring lists the batches with stock due in (dueAfter, dueBefore],
+	// ordered by warehouse, section and due date. A zero warehouseId looks
+	// in every warehouse.
+	FindExpiring(dueAfter string, dueBefore string, warehouseId uint64) ([]db.ExpiringBatch, error)
+	// FindUnalerted is FindExpiring limited to the batches without an alert.
+	FindUnalerted(dueAfter string, dueBefore string) ([]db.ExpiringBatch, error)
+
+	CreateAlert(alert db.ExpiryAlert) (db.ExpiryAlert, error)
+	GetAlerts(params query.Params) (query.Page[db.ExpiryAlert], error)
+}
+
+type expiryRepository struct {
+	db db.Querier
+}
+
+func NewExpiryRepository(database db.Querier) ExpiryRepository {
+	return &expiryRepository{
+		db: database,
+	}
+}
+
+var expiryAlertsQuery = query.NewBuilder(
+	"expiry_alerts",
+	"id, product_batch_id, due_date, current_quantity, threshold, created_at",
+	map[string]string{
+		"id":               "id",
+		"product_batch_id": "product_batch_id",
+		"due_date":         "due_date",
+		"created_at":       "created_at",
+	},
+)
+
+const expiringBatchesQuery = `
+	SELECT pb.id, pb.batch_number, pb.product_id, p.description, p.expiration_rate, pb.current_quantity, pb.due_date,
+		sc.id, sc.section_number, w.id, w.warehouse_code
+	FROM product_batches pb
+	JOIN products p ON p.id = pb.product_id
+	JOIN sections sc ON sc.id = pb.section_id
+	JOIN warehouses w ON w.id = sc.warehouse_id`
+
+func (r *expiryRepository) FindExpiring(dueAfter string, dueBefore string, warehouseId uint64) ([]db.ExpiringBatch, error) {
+	conditions := []string{"pb.current_quantity > 0", "pb.due_date > ?", "pb.due_date <= ?"}
+	args := []any{dueAfter, dueBefore}
+
+	if warehouseId != 0 {
+		conditions = append(conditions, "w.id = ?")
+		args = append(args, warehouseId)
+	}
+
+	return r.findBatches(expiringBatchesQuery, conditions, args)
+}
+
+func (r *expiryRepository) FindUnalerted(dueAfter string, dueBefore string) ([]db.ExpiringBatch, error) {
+	statement := expiringBatchesQuery + `
+	LEFT JOIN expiry_alerts ea ON ea.product_batch_id = pb.id`
+
+	conditions := []string{"pb.current_quantity > 0", "pb.due_date > ?", "pb.due_date <= ?", "ea.id IS NULL"}
+
+	return r.findBatches(statement, conditions, []any{dueAfter, dueBefore})
+}
+
+func (r *expiryRepository) findBatches(statement string, conditions []string, args []any) ([]db.ExpiringBatch, error) {
+	rows, err := r.db.Query(
+		statement+" WHERE "+strings.Join(conditions, " AND ")+" ORDER BY w.id, sc.id, pb.due_date, pb.id",
+		args...,
+	)
+	if err != nil {
+		return []db.ExpiringBatch{}, err
+	}
+
+	defer rows.Close()
+
+	var batches []db.ExpiringBatch
+
+	for rows.Next() {
+		var batch db.ExpiringBatch
+
+		if err := rows.Scan(
+			&batch.Id,
+			&batch.Number,
+			&batch.ProductId,
+			&batch.ProductDescription,
+			&batch.ExpirationRate,
+			&batch.CurrentQuantity,
+			&batch.DueDate,
+			&batch.SectionId,
+			&batch.SectionNumber,
+			&batch.WarehouseId,
+			&batch.WarehouseCode,
+		); err != nil {
+			return []db.ExpiringBatch{}, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, rows.Err()
+}
+
+func (r *expiryRepository) CreateAlert(alert db.ExpiryAlert) (db.ExpiryAlert, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO expiry_alerts(product_batch_id, due_date, current_quantity, threshold, created_at)
+		VALUES(?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.ExpiryAlert{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+	result, err = stmt.Exec(
+		alert.ProductBatchId,
+		alert.DueDate,
+		alert.CurrentQuantity,
+		alert.Threshold,
+		alert.CreatedAt,
+	)
+	if err != nil {
+		return db.ExpiryAlert{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	alert.Id = uint64(insertedId)
+
+	return alert, nil
+}
+
+func (r *expiryRepository) GetAlerts(params query.Params) (query.Page[db.ExpiryAlert], error) {
+	statement, args, err := expiryAlertsQuery.Build(params)
+	if err != nil {
+		return query.Page[db.ExpiryAlert]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[db.ExpiryAlert]{}, err
+	}
+
+	defer rows.Close()
+
+	var alerts []db.ExpiryAlert
+
+	for rows.Next() {
+		var alert db.ExpiryAlert
+
+		if err := rows.Scan(
+			&alert.Id,
+			&alert.ProductBatchId,
+			&alert.DueDate,
+			&alert.CurrentQuantity,
+			&alert.Threshold,
+			&alert.CreatedAt,
+		); err != nil {
+			return query.Page[db.ExpiryAlert]{}, err
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return query.NewPage(alerts, params)
+}
@@ -0,0 +1,43 @@
+package expiry
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type MockExpiryRepository struct {
+	Result  []db.ExpiringBatch
+	Alerts  []db.ExpiryAlert
+	Err     error
+	Created *[]db.ExpiryAlert
+	// DueAfter receives the due date passed to FindExpiring.
+	DueAfter *string
+}
+
+func (m MockExpiryRepository) FindExpiring(dueAfter string, dueBefore string, warehouseId uint64) ([]db.ExpiringBatch, error) {
+	if m.DueAfter != nil {
+		*m.DueAfter = dueAfter
+	}
+	return m.Result, m.Err
+}
+
+func (m MockExpiryRepository) FindUnalerted(dueAfter string, dueBefore string) ([]db.ExpiringBatch, error) {
+	return m.Result, m.Err
+}
+
+func (m MockExpiryRepository) CreateAlert(alert db.ExpiryAlert) (db.ExpiryAlert, error) {
+	if m.Err != nil {
+		return db.ExpiryAlert{}, m.Err
+	}
+	if m.Created != nil {
+		*m.Created = append(*m.Created, alert)
+	}
+	return alert, nil
+}
+
+func (m MockExpiryRepository) GetAlerts(params query.Params) (query.Page[db.ExpiryAlert], error) {
+	if m.Err != nil {
+		return query.Page[db.ExpiryAlert]{}, m.Err
+	}
+	return query.NewPage(m.Alerts, params)
+}
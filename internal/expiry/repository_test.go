@@ -0,0 +1,212 @@
+package expiry
+
+import (
+	"database/sql"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_FindExpiring_Ok(t *testing.T) {
+
+	database := createExpiryDB()
+	repository := NewExpiryRepository(database)
+
+	found, err := repository.FindExpiring("2022-08-01 00:00:00", "2022-08-04 00:00:00", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.ExpiringBatch{
+		{Id: 2, Number: 20, ProductId: 1, ProductDescription: "Banana", ExpirationRate: 0.5, CurrentQuantity: 20, DueDate: "2022-08-02 10:00:00",
+			SectionId: 1, SectionNumber: 11, WarehouseId: 1, WarehouseCode: "WH1"},
+		{Id: 3, Number: 30, ProductId: 1, ProductDescription: "Banana", ExpirationRate: 0.5, CurrentQuantity: 5, DueDate: "2022-08-03 10:00:00",
+			SectionId: 2, SectionNumber: 22, WarehouseId: 2, WarehouseCode: "WH2"},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindExpiring_ByWarehouse(t *testing.T) {
+
+	database := createExpiryDB()
+	repository := NewExpiryRepository(database)
+
+	found, err := repository.FindExpiring("2022-08-01 00:00:00", "2022-08-04 00:00:00", 2)
+
+	assert.Nil(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, uint64(3), found[0].Id)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindUnalerted_Ok(t *testing.T) {
+
+	database := createExpiryDB()
+	repository := NewExpiryRepository(database)
+
+	_, err := repository.CreateAlert(db.ExpiryAlert{ProductBatchId: 2, DueDate: "2022-08-02 10:00:00", CurrentQuantity: 20, Threshold: "72h0m0s", CreatedAt: "2022-08-01 00:00:00"})
+	assert.Nil(t, err)
+
+	found, err := repository.FindUnalerted("2022-08-01 00:00:00", "2022-08-04 00:00:00")
+
+	assert.Nil(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, uint64(3), found[0].Id)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindExpiring_ConnectionError(t *testing.T) {
+
+	database := createExpiryDB()
+	repository := NewExpiryRepository(database)
+
+	database.Close()
+	found, err := repository.FindExpiring("2022-08-01 00:00:00", "2022-08-04 00:00:00", 0)
+
+	assert.NotNil(t, err)
+	assert.Empty(t, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_CreateAlert_Ok(t *testing.T) {
+
+	database := createExpiryDB()
+	repository := NewExpiryRepository(database)
+
+	alert := db.ExpiryAlert{ProductBatchId: 2, DueDate: "2022-08-02 10:00:00", CurrentQuantity: 20, Threshold: "72h0m0s", CreatedAt: "2022-08-01 00:00:00"}
+
+	created, err := repository.CreateAlert(alert)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), created.Id)
+
+	found, err := repository.GetAlerts(query.Params{})
+	assert.Nil(t, err)
+	assert.Equal(t, []db.ExpiryAlert{created}, found.Items)
+
+	_, err = repository.CreateAlert(alert)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func createExpiryDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_WAREHOUSES_TABLE)
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_EXPIRY_ALERTS_TABLE)
+	util.QueryExec(database, CREATE_EXPIRY_ALERTS_INDEX)
+	util.QueryExec(database, INSERT_WAREHOUSES)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCTS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+	return database
+}
+
+// Batch 1 is expired, batch 4 is due later and batch 5 is empty.
+const INSERT_PRODUCT_BATCHES = `
+	INSERT INTO product_batches(batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+	VALUES (10, 10, 1, "2022-07-31 10:00:00", 10, "2022-01-01", "10:00:00", 1, 1, 1),
+		(20, 20, 1, "2022-08-02 10:00:00", 20, "2022-01-01", "10:00:00", 1, 1, 1),
+		(30, 5, 1, "2022-08-03 10:00:00", 5, "2022-01-01", "10:00:00", 1, 1, 2),
+		(40, 10, 1, "2022-09-01 10:00:00", 10, "2022-01-01", "10:00:00", 1, 1, 2),
+		(50, 0, 1, "2022-08-02 10:00:00", 50, "2022-01-01", "10:00:00", 1, 1, 1)
+`
+
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id)
+	VALUES (11, 80, 1, 100, 10, 1, 1, 1),
+		(22, 15, 1, 100, 10, 1, 1, 2)
+`
+
+const INSERT_WAREHOUSES = `
+	INSERT INTO warehouses(address, telephone, warehouse_code, minimum_capacity, minimum_temperature, locality_id)
+	VALUES ("Rua 1", "1111", "WH1", 10, 1, "1"),
+		("Rua 2", "2222", "WH2", 10, 1, "1")
+`
+
+const INSERT_PRODUCTS = `
+	INSERT INTO products(description, expiration_rate, freezing_rate, height, length, net_weight, product_code, recommended_freezing_temperature, width, product_type, seller_id)
+	VALUES ("Banana", 0.5, 1, 1, 1, 1, "BAN", 1, 1, 1, 1)
+`
+
+const CREATE_EXPIRY_ALERTS_TABLE = `
+	CREATE TABLE "expiry_alerts"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		due_date TEXT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		threshold TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_EXPIRY_ALERTS_INDEX = `
+	CREATE UNIQUE INDEX "expiry_alerts_product_batch" ON "expiry_alerts" (product_batch_id);
+`
+
+const CREATE_WAREHOUSES_TABLE = `
+	CREATE TABLE "warehouses" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		warehouse_code TEXT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_BATCHES_TABLE = `
+	CREATE TABLE "product_batches"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		batch_number BIGINT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		due_date TEXT NOT NULL,
+		initial_quantity BIGINT NOT NULL,
+		manufacturing_date TEXT NOT NULL,
+		manufacturing_hour TEXT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		expiration_rate DECIMAL(19, 2) NOT NULL,
+		freezing_rate DECIMAL(19, 2) NOT NULL,
+		height DECIMAL(19, 2) NOT NULL,
+		length DECIMAL(19, 2) NOT NULL,
+		net_weight DECIMAL(19, 2) NOT NULL,
+		product_code TEXT NOT NULL,
+		recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+		width DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT  NOT NULL,
+		seller_id BIGINT  NOT NULL
+	);
+`
@@ -0,0 +1,115 @@
+package expiry
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+var InvalidWithinError = errors.New("within must be a positive duration, e.g. 72h")
+
+type ExpiryService interface {
+	Expiring(within time.Duration, warehouseId uint64) ([]db.ExpiringWarehouse, error)
+	RecordAlerts(threshold time.Duration) ([]db.ExpiryAlert, error)
+	GetAlerts(params query.Params) (query.Page[db.ExpiryAlert], error)
+}
+
+type expiryService struct {
+	expiryRepository ExpiryRepository
+	now              func() time.Time
+}
+
+func NewExpiryService(r ExpiryRepository) ExpiryService {
+	return &expiryService{
+		expiryRepository: r,
+		now:              time.Now,
+	}
+}
+
+// Expiring lists the batches with stock that expire within the given
+// duration, grouped by warehouse and section. Batches already expired are
+// left out.
+func (s *expiryService) Expiring(within time.Duration, warehouseId uint64) ([]db.ExpiringWarehouse, error) {
+	if within <= 0 {
+		return []db.ExpiringWarehouse{}, InvalidWithinError
+	}
+
+	now := s.now().UTC()
+	expiring, err := s.expiryRepository.FindExpiring(now.Format(batches.DueDateLayout), now.Add(within).Format(batches.DueDateLayout), warehouseId)
+	if err != nil {
+		return []db.ExpiringWarehouse{}, err
+	}
+
+	return group(expiring), nil
+}
+
+// RecordAlerts writes one alert for each batch that entered the threshold
+// since the last run. A batch is alerted only once.
+func (s *expiryService) RecordAlerts(threshold time.Duration) ([]db.ExpiryAlert, error) {
+	if threshold <= 0 {
+		return []db.ExpiryAlert{}, InvalidWithinError
+	}
+
+	now := s.now().UTC()
+	unalerted, err := s.expiryRepository.FindUnalerted(now.Format(batches.DueDateLayout), now.Add(threshold).Format(batches.DueDateLayout))
+	if err != nil {
+		return []db.ExpiryAlert{}, err
+	}
+
+	alerts := []db.ExpiryAlert{}
+
+	for _, batch := range unalerted {
+		alert, err := s.expiryRepository.CreateAlert(db.ExpiryAlert{
+			ProductBatchId:  batch.Id,
+			DueDate:         batch.DueDate,
+			CurrentQuantity: batch.CurrentQuantity,
+			Threshold:       threshold.String(),
+			CreatedAt:       now.Format(batches.DueDateLayout),
+		})
+		if err != nil {
+			return alerts, err
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+func (s *expiryService) GetAlerts(params query.Params) (query.Page[db.ExpiryAlert], error) {
+	return s.expiryRepository.GetAlerts(params)
+}
+
+// group nests batches, ordered by warehouse and section, into their
+// warehouse and section.
+func group(batches []db.ExpiringBatch) []db.ExpiringWarehouse {
+	report := []db.ExpiringWarehouse{}
+
+	for _, batch := range batches {
+		if len(report) == 0 || report[len(report)-1].WarehouseId != batch.WarehouseId {
+			report = append(report, db.ExpiringWarehouse{
+				WarehouseId:   batch.WarehouseId,
+				WarehouseCode: batch.WarehouseCode,
+				Sections:      []db.ExpiringSection{},
+			})
+		}
+
+		warehouse := &report[len(report)-1]
+
+		if len(warehouse.Sections) == 0 || warehouse.Sections[len(warehouse.Sections)-1].SectionId != batch.SectionId {
+			warehouse.Sections = append(warehouse.Sections, db.ExpiringSection{
+				SectionId:     batch.SectionId,
+				SectionNumber: batch.SectionNumber,
+				Batches:       []db.ExpiringBatch{},
+			})
+		}
+
+		section := &warehouse.Sections[len(warehouse.Sections)-1]
+		section.Batches = append(section.Batches, batch)
+	}
+
+	return report
+}
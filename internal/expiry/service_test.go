@@ -0,0 +1,124 @@
+package expiry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/stretchr/testify/assert"
+)
+
+var expiringBatches = []db.ExpiringBatch{
+	{Id: 2, Number: 20, CurrentQuantity: 20, DueDate: "2022-08-02 10:00:00", SectionId: 1, SectionNumber: 11, WarehouseId: 1, WarehouseCode: "WH1"},
+	{Id: 6, Number: 60, CurrentQuantity: 10, DueDate: "2022-08-03 10:00:00", SectionId: 1, SectionNumber: 11, WarehouseId: 1, WarehouseCode: "WH1"},
+	{Id: 7, Number: 70, CurrentQuantity: 10, DueDate: "2022-08-02 12:00:00", SectionId: 3, SectionNumber: 33, WarehouseId: 1, WarehouseCode: "WH1"},
+	{Id: 3, Number: 30, CurrentQuantity: 5, DueDate: "2022-08-03 10:00:00", SectionId: 2, SectionNumber: 22, WarehouseId: 2, WarehouseCode: "WH2"},
+}
+
+func Test_Expiring_ShouldGroupByWarehouseAndSection(t *testing.T) {
+
+	service := NewExpiryService(MockExpiryRepository{Result: expiringBatches})
+
+	report, err := service.Expiring(72*time.Hour, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.ExpiringWarehouse{
+		{WarehouseId: 1, WarehouseCode: "WH1", Sections: []db.ExpiringSection{
+			{SectionId: 1, SectionNumber: 11, Batches: expiringBatches[0:2]},
+			{SectionId: 3, SectionNumber: 33, Batches: expiringBatches[2:3]},
+		}},
+		{WarehouseId: 2, WarehouseCode: "WH2", Sections: []db.ExpiringSection{
+			{SectionId: 2, SectionNumber: 22, Batches: expiringBatches[3:4]},
+		}},
+	}, report)
+}
+
+func Test_Expiring_ShouldReturnEmptyReport(t *testing.T) {
+
+	service := NewExpiryService(MockExpiryRepository{})
+
+	report, err := service.Expiring(72*time.Hour, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.ExpiringWarehouse{}, report)
+}
+
+func Test_Expiring_ShouldCompareDueDatesInUTC(t *testing.T) {
+
+	var dueAfter string
+	service := &expiryService{
+		expiryRepository: MockExpiryRepository{DueAfter: &dueAfter},
+		now:              func() time.Time { return time.Date(2022, 8, 1, 9, 0, 0, 0, time.FixedZone("BRT", -3*60*60)) },
+	}
+
+	_, err := service.Expiring(72*time.Hour, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-08-01 12:00:00", dueAfter)
+}
+
+func Test_Expiring_ShouldReturnInvalidWithinError(t *testing.T) {
+
+	service := NewExpiryService(MockExpiryRepository{})
+
+	_, err := service.Expiring(0, 0)
+
+	assert.Equal(t, InvalidWithinError, err)
+}
+
+func Test_Expiring_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := NewExpiryService(MockExpiryRepository{Err: expectedError})
+
+	_, err := service.Expiring(time.Hour, 0)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_RecordAlerts_Ok(t *testing.T) {
+
+	created := []db.ExpiryAlert{}
+	service := &expiryService{
+		expiryRepository: MockExpiryRepository{Result: expiringBatches[0:1], Created: &created},
+		now:              func() time.Time { return time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC) },
+	}
+
+	alerts, err := service.RecordAlerts(72 * time.Hour)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.ExpiryAlert{
+		{ProductBatchId: 2, DueDate: "2022-08-02 10:00:00", CurrentQuantity: 20, Threshold: "72h0m0s", CreatedAt: "2022-08-01 00:00:00"},
+	}, created)
+	assert.Equal(t, created, alerts)
+}
+
+func Test_RecordAlerts_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := NewExpiryService(MockExpiryRepository{Err: expectedError})
+
+	_, err := service.RecordAlerts(time.Hour)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_ConfigFromEnv(t *testing.T) {
+
+	config, err := ConfigFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, Config{Threshold: DefaultThreshold, Interval: DefaultInterval}, config)
+
+	t.Setenv("MERCADO_FRESH_EXPIRY_THRESHOLD", "48h")
+	t.Setenv("MERCADO_FRESH_EXPIRY_CHECK_INTERVAL", "0")
+
+	config, err = ConfigFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, Config{Threshold: 48 * time.Hour}, config)
+
+	t.Setenv("MERCADO_FRESH_EXPIRY_THRESHOLD", "two days")
+
+	_, err = ConfigFromEnv()
+	assert.Equal(t, InvalidConfigError, err)
+}
@@ -0,0 +1,175 @@
+package imports
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// record is a row of the CSV decoded into T, with the line it starts on.
+// err holds why the row can not be imported.
+type record[T any] struct {
+	line  int
+	value T
+	err   error
+}
+
+// decode reads a CSV whose header row names the JSON fields of T, in any
+// order. Each row is checked with the binding rules of T, the same rules
+// the JSON endpoints apply. Problems with the header are returned as
+// errors of line 1 and no row is decoded.
+func decode[T any](reader io.Reader) ([]record[T], []db.ImportRowError) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, []db.ImportRowError{{Line: 1, Error: "missing header row"}}
+	}
+	if err != nil {
+		return nil, []db.ImportRowError{{Line: 1, Error: err.Error()}}
+	}
+
+	fields, headerErrors := columns(reflect.TypeOf(*new(T)), header)
+	if len(headerErrors) > 0 {
+		return nil, headerErrors
+	}
+
+	records := []record[T]{}
+
+	for {
+		row, err := csvReader.Read()
+		if err == io.EOF {
+			return records, []db.ImportRowError{}
+		}
+
+		if parseErr, ok := err.(*csv.ParseError); ok {
+			records = append(records, record[T]{line: parseErr.StartLine, err: parseErr.Err})
+			continue
+		}
+
+		if err != nil {
+			line, _ := csvReader.FieldPos(0)
+			return records, []db.ImportRowError{{Line: line, Error: err.Error()}}
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		records = append(records, decodeRow[T](line, header, fields, row))
+	}
+}
+
+func decodeRow[T any](line int, header []string, fields []int, row []string) record[T] {
+	decoded := record[T]{line: line}
+	value := reflect.ValueOf(&decoded.value).Elem()
+
+	for i, column := range header {
+		if err := setField(value.Field(fields[i]), strings.TrimSpace(row[i])); err != nil {
+			decoded.err = fmt.Errorf("%s: %w", column, err)
+			return decoded
+		}
+	}
+
+	decoded.err = binding.Validator.ValidateStruct(&decoded.value)
+	return decoded
+}
+
+// columns maps each column of the header to the field of t with the same
+// JSON name.
+func columns(t reflect.Type, header []string) ([]int, []db.ImportRowError) {
+	byName := map[string]int{}
+	required := []string{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		byName[name] = i
+		if strings.Contains(field.Tag.Get("binding"), "required") {
+			required = append(required, name)
+		}
+	}
+
+	fields := make([]int, len(header))
+	seen := map[string]bool{}
+	errs := []db.ImportRowError{}
+
+	for i, column := range header {
+		// Spreadsheets often save CSV with a byte order mark.
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		header[i] = column
+
+		index, ok := byName[column]
+		switch {
+		case !ok:
+			errs = append(errs, db.ImportRowError{Line: 1, Error: "unknown column: " + column})
+		case seen[column]:
+			errs = append(errs, db.ImportRowError{Line: 1, Error: "duplicate column: " + column})
+		}
+
+		fields[i] = index
+		seen[column] = true
+	}
+
+	for _, name := range required {
+		if !seen[name] {
+			errs = append(errs, db.ImportRowError{Line: 1, Error: "missing column: " + name})
+		}
+	}
+
+	return fields, errs
+}
+
+// setField parses value into the kind of field. Empty values leave the
+// zero value, so the binding rules report them.
+func setField(field reflect.Value, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a positive integer", value)
+		}
+		field.SetUint(parsed)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		field.SetInt(parsed)
+
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		field.SetFloat(parsed)
+
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+		field.SetBool(parsed)
+
+	default:
+		return fmt.Errorf("unsupported column type %s", field.Type())
+	}
+
+	return nil
+}
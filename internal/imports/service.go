@@ -0,0 +1,134 @@
+package imports
+
+import (
+	"errors"
+	"io"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+)
+
+var (
+	InvalidRowsError   = errors.New("the file has invalid rows, nothing was imported")
+	OtherSellerError   = errors.New("product belongs to another seller")
+	errDryRunCompleted = errors.New("dry run completed")
+)
+
+type ImportService interface {
+	// ImportProducts imports products for any seller, or only for sellerId
+	// when it is not zero.
+	ImportProducts(reader io.Reader, sellerId uint64, dryRun bool) (db.ImportReport, error)
+	ImportSellers(reader io.Reader, dryRun bool) (db.ImportReport, error)
+	ImportWarehouses(reader io.Reader, dryRun bool) (db.ImportReport, error)
+}
+
+// Repositories are the transaction-bound repositories the services of each
+// resource are built on during an import.
+type Repositories struct {
+	Products   products.ProductRepository
+	Sellers    sellers.Repository
+	Warehouses warehouses.WarehouseRepository
+}
+
+type importService struct {
+	unitOfWork uow.UnitOfWork[Repositories]
+}
+
+func NewImportService(unitOfWork uow.UnitOfWork[Repositories]) ImportService {
+	return &importService{
+		unitOfWork: unitOfWork,
+	}
+}
+
+func (s *importService) ImportProducts(reader io.Reader, sellerId uint64, dryRun bool) (db.ImportReport, error) {
+	return importRows(s.unitOfWork, reader, dryRun, func(r Repositories, product db.Product) (uint64, error) {
+		if sellerId != 0 && product.SellerId != sellerId {
+			return 0, OtherSellerError
+		}
+
+		created, err := products.NewProductService(r.Products).Create(
+			product.Code, product.Description, product.Width, product.Height, product.Length, product.NetWeight,
+			product.ExpirationRate, product.RecommendedFreezingTemp, product.FreezingRate, product.ProductTypeId, product.SellerId,
+		)
+		return created.Id, err
+	})
+}
+
+func (s *importService) ImportSellers(reader io.Reader, dryRun bool) (db.ImportReport, error) {
+	return importRows(s.unitOfWork, reader, dryRun, func(r Repositories, seller db.Seller) (uint64, error) {
+		created, err := sellers.NewService(r.Sellers).Create(seller.Cid, seller.CompanyName, seller.Address, seller.Telephone, seller.LocalityId)
+		return created.Id, err
+	})
+}
+
+func (s *importService) ImportWarehouses(reader io.Reader, dryRun bool) (db.ImportReport, error) {
+	return importRows(s.unitOfWork, reader, dryRun, func(r Repositories, warehouse db.Warehouse) (uint64, error) {
+		created, err := warehouses.NewService(r.Warehouses).Create(
+			warehouse.Code, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimumTemperature, warehouse.LocalityID,
+		)
+		return created.Id, err
+	})
+}
+
+// importRows creates every row of the CSV with create, in one transaction.
+// Rows go through the same service as the JSON endpoints, so a row that
+// repeats a code of an earlier row fails like it would one by one. Every
+// row is tried so the report lists all the errors, and the transaction is
+// committed only when there are none and it is not a dry run.
+func importRows[T any](
+	unitOfWork uow.UnitOfWork[Repositories],
+	reader io.Reader,
+	dryRun bool,
+	create func(Repositories, T) (uint64, error),
+) (db.ImportReport, error) {
+	records, headerErrors := decode[T](reader)
+
+	report := db.ImportReport{DryRun: dryRun, Rows: len(records), Ids: []uint64{}, Errors: headerErrors}
+	if len(report.Errors) > 0 {
+		return report, InvalidRowsError
+	}
+
+	ids := []uint64{}
+
+	err := unitOfWork.Do(func(r Repositories) error {
+		for _, record := range records {
+			if record.err == nil {
+				var id uint64
+				id, record.err = create(r, record.value)
+				if record.err == nil {
+					ids = append(ids, id)
+				}
+			}
+
+			if record.err != nil {
+				report.Errors = append(report.Errors, db.ImportRowError{Line: record.line, Error: record.err.Error()})
+			}
+		}
+
+		if len(report.Errors) > 0 {
+			return InvalidRowsError
+		}
+
+		if dryRun {
+			return errDryRunCompleted
+		}
+
+		return nil
+	})
+
+	switch err {
+	case nil:
+		report.Imported = len(ids)
+		report.Ids = ids
+		return report, nil
+	case errDryRunCompleted:
+		return report, nil
+	case InvalidRowsError:
+		return report, err
+	default:
+		return db.ImportReport{}, err
+	}
+}
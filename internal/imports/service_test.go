@@ -0,0 +1,297 @@
+package imports
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+const productsHeader = "product_code,description,width,height,length,net_weight,expiration_rate,recommended_freezing_temperature,freezing_rate,product_type_id,seller_id\n"
+
+func Test_ImportProducts_Ok(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(productsHeader+
+		"BAN,Banana,1,2,3,4,0.5,-2,1,1,1\n"+
+		"MAC,Maca,1,2,3,4,0.5,-2,1,1,1\n",
+	), 0, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.ImportReport{Rows: 2, Imported: 2, Ids: []uint64{1, 2}, Errors: []db.ImportRowError{}}, report)
+	assert.Equal(t, 2, countRows(database, "products"))
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_ShouldAcceptColumnsInAnyOrder(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(
+		"\ufeffseller_id, description, product_code,width,height,length,net_weight,expiration_rate,recommended_freezing_temperature,freezing_rate,product_type_id\n"+
+			"1, Banana, BAN,1,2,3,4,0.5,-2,1,1\n",
+	), 0, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, report.Imported)
+
+	product, _ := products.NewProductRepository(database).Get(1)
+	assert.Equal(t, "BAN", product.Code)
+	assert.Equal(t, "Banana", product.Description)
+	assert.Equal(t, uint64(1), product.SellerId)
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_ShouldReportEveryInvalidRow(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(productsHeader+
+		"BAN,Banana,1,2,3,4,0.5,-2,1,1,1\n"+
+		"MAC,Maca,wide,2,3,4,0.5,-2,1,1,1\n"+
+		"UVA,,1,2,3,4,0.5,-2,1,1,1\n"+
+		"BAN,Banana prata,1,2,3,4,0.5,-2,1,1,1\n"+
+		"KIW,Kiwi,1,2\n",
+	), 0, false)
+
+	assert.Equal(t, InvalidRowsError, err)
+	assert.Equal(t, 5, report.Rows)
+	assert.Equal(t, 0, report.Imported)
+	assert.Equal(t, []db.ImportRowError{
+		{Line: 3, Error: `width: "wide" is not a number`},
+		{Line: 4, Error: "Key: 'Product.Description' Error:Field validation for 'Description' failed on the 'required' tag"},
+		{Line: 5, Error: products.ErrExistsProductCodeError.Error()},
+		{Line: 6, Error: "wrong number of fields"},
+	}, report.Errors)
+	assert.Equal(t, 0, countRows(database, "products"))
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_ShouldReportHeaderErrors(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(
+		"product_code,description,colour,description\nBAN,Banana,yellow,Banana\n",
+	), 0, false)
+
+	assert.Equal(t, InvalidRowsError, err)
+	assert.Equal(t, 0, report.Rows)
+	assert.Contains(t, report.Errors, db.ImportRowError{Line: 1, Error: "unknown column: colour"})
+	assert.Contains(t, report.Errors, db.ImportRowError{Line: 1, Error: "duplicate column: description"})
+	assert.Contains(t, report.Errors, db.ImportRowError{Line: 1, Error: "missing column: seller_id"})
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_ShouldReportMissingHeader(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(""), 0, false)
+
+	assert.Equal(t, InvalidRowsError, err)
+	assert.Equal(t, []db.ImportRowError{{Line: 1, Error: "missing header row"}}, report.Errors)
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_DryRun_ShouldNotInsert(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(productsHeader+
+		"BAN,Banana,1,2,3,4,0.5,-2,1,1,1\n",
+	), 0, true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.ImportReport{DryRun: true, Rows: 1, Ids: []uint64{}, Errors: []db.ImportRowError{}}, report)
+	assert.Equal(t, 0, countRows(database, "products"))
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_ShouldRejectProductsOfOtherSellers(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportProducts(strings.NewReader(productsHeader+
+		"BAN,Banana,1,2,3,4,0.5,-2,1,1,1\n"+
+		"MAC,Maca,1,2,3,4,0.5,-2,1,1,2\n",
+	), 1, false)
+
+	assert.Equal(t, InvalidRowsError, err)
+	assert.Equal(t, []db.ImportRowError{{Line: 3, Error: OtherSellerError.Error()}}, report.Errors)
+
+	util.DropDB(database)
+}
+
+func Test_ImportProducts_ShouldReturnUnitOfWorkError(t *testing.T) {
+
+	expectedError := errors.New("connection refused")
+	service := NewImportService(uow.MockUnitOfWork[Repositories]{Err: expectedError})
+
+	report, err := service.ImportProducts(strings.NewReader(productsHeader+"BAN,Banana,1,2,3,4,0.5,-2,1,1,1\n"), 0, false)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, db.ImportReport{}, report)
+}
+
+func Test_ImportSellers_Ok(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportSellers(strings.NewReader(
+		"cid,company_name,address,telephone,locality_id\n"+
+			"2,Mercado Sul,Rua 2,2222,1\n",
+	), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{2}, report.Ids)
+	assert.Equal(t, 2, countRows(database, "sellers"))
+
+	util.DropDB(database)
+}
+
+func Test_ImportSellers_ShouldReportExistingCid(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportSellers(strings.NewReader(
+		"cid,company_name,address,telephone,locality_id\n"+
+			"1,Mercado Sul,Rua 2,2222,1\n",
+	), false)
+
+	assert.Equal(t, InvalidRowsError, err)
+	assert.Equal(t, []db.ImportRowError{{Line: 2, Error: sellers.ExistsSellerCodeError.Error()}}, report.Errors)
+
+	util.DropDB(database)
+}
+
+func Test_ImportWarehouses_Ok(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportWarehouses(strings.NewReader(
+		"warehouse_code,address,telephone,minimum_capacity,minimum_temperature,locality_id\n"+
+			"WH1,Rua 1,1111,10,2,1\n"+
+			"WH2,Rua 2,2222,10,2,1\n",
+	), false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, report.Imported)
+	assert.Equal(t, 2, countRows(database, "warehouses"))
+
+	util.DropDB(database)
+}
+
+func Test_ImportWarehouses_ShouldReportRepeatedCode(t *testing.T) {
+
+	database := createImportsDB()
+	service := newImportService(database)
+
+	report, err := service.ImportWarehouses(strings.NewReader(
+		"warehouse_code,address,telephone,minimum_capacity,minimum_temperature,locality_id\n"+
+			"WH1,Rua 1,1111,10,2,1\n"+
+			"WH1,Rua 2,2222,10,2,1\n",
+	), false)
+
+	assert.Equal(t, InvalidRowsError, err)
+	assert.Equal(t, []db.ImportRowError{{Line: 3, Error: warehouses.ExistsWarehouseCodeError.Error()}}, report.Errors)
+	assert.Equal(t, 0, countRows(database, "warehouses"))
+
+	util.DropDB(database)
+}
+
+func newImportService(database *sql.DB) ImportService {
+	return NewImportService(uow.New(database, func(q db.Querier) Repositories {
+		return Repositories{
+			Products:   products.NewProductRepository(q),
+			Sellers:    sellers.NewRepository(q),
+			Warehouses: warehouses.NewRepository(q),
+		}
+	}))
+}
+
+func countRows(database *sql.DB, table string) int {
+	var count int
+	database.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+	return count
+}
+
+func createImportsDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(database, CREATE_SELLERS_TABLE)
+	util.QueryExec(database, CREATE_WAREHOUSES_TABLE)
+	util.QueryExec(database, INSERT_SELLERS)
+	return database
+}
+
+const INSERT_SELLERS = `
+	INSERT INTO sellers(cid, company_name, address, telephone, locality_id)
+	VALUES (1, "Frutas SA", "Rua 3", "3333", "1")
+`
+
+const CREATE_SELLERS_TABLE = `
+	CREATE TABLE "sellers" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		cid BIGINT UNIQUE NOT NULL,
+		company_name TEXT NOT NULL,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const CREATE_WAREHOUSES_TABLE = `
+	CREATE TABLE "warehouses" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		warehouse_code TEXT UNIQUE NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		expiration_rate DECIMAL(19, 2) NOT NULL,
+		freezing_rate DECIMAL(19, 2) NOT NULL,
+		height DECIMAL(19, 2) NOT NULL,
+		length DECIMAL(19, 2) NOT NULL,
+		net_weight DECIMAL(19, 2) NOT NULL,
+		product_code TEXT NOT NULL,
+		recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+		width DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT  NOT NULL,
+		seller_id BIGINT  NOT NULL
+	);
+`
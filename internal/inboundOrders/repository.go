@@ -8,30 +8,30 @@ import (
 )
 
 type InboundOrderRepository interface {
-	Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId uint64) (database.InboundOrder, error)
+	Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId, quantity uint64) (database.InboundOrder, error)
 	Get(id uint64) (database.InboundOrder, error)
 }
 
 type inboundOrderRepository struct {
-	db *sql.DB
+	db database.Querier
 }
 
-func NewRepository(db *sql.DB) InboundOrderRepository {
+func NewRepository(db database.Querier) InboundOrderRepository {
 	return &inboundOrderRepository{
 		db: db,
 	}
 }
 
-func (r *inboundOrderRepository) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId uint64) (database.InboundOrder, error) {
+func (r *inboundOrderRepository) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId, quantity uint64) (database.InboundOrder, error) {
 
-	stmt, err := r.db.Prepare("INSERT INTO inbound_orders(order_date, order_number, employee_id, product_batch_id, warehouse_id) VALUES(?,?,?,?,?)")
+	stmt, err := r.db.Prepare("INSERT INTO inbound_orders(order_date, order_number, employee_id, product_batch_id, warehouse_id, quantity) VALUES(?,?,?,?,?,?)")
 	if err != nil {
 		return database.InboundOrder{}, err
 	}
 
 	defer stmt.Close()
 	var result sql.Result
-	result, err = stmt.Exec(orderDate, orderNumber, employeeId, productBatchId, warehouseId)
+	result, err = stmt.Exec(orderDate, orderNumber, employeeId, productBatchId, warehouseId, quantity)
 	if err != nil {
 		return database.InboundOrder{}, err
 	}
@@ -42,20 +42,26 @@ func (r *inboundOrderRepository) Create(orderDate, orderNumber string, employeeI
 		OrderNumber:    orderNumber,
 		EmployeeId:     employeeId,
 		ProductBatchId: productBatchId,
-		WarehouseId:    warehouseId}
+		WarehouseId:    warehouseId,
+		Quantity:       quantity}
 
 	return inboundOrder, nil
 }
 
 func (r *inboundOrderRepository) Get(id uint64) (database.InboundOrder, error) {
 	var inboundOrder database.InboundOrder
-	rows, err := r.db.Query("SELECT * FROM inbound_orders WHERE id = ?", id)
+	rows, err := r.db.Query(`
+		SELECT id, order_date, order_number, employee_id, product_batch_id, warehouse_id, quantity
+		FROM inbound_orders WHERE id = ?
+	`, id)
 
 	if err != nil {
 		log.Println(err)
 		return inboundOrder, err
 	}
 
+	defer rows.Close()
+
 	for rows.Next() {
 
 		err := rows.Scan(
@@ -65,6 +71,7 @@ func (r *inboundOrderRepository) Get(id uint64) (database.InboundOrder, error) {
 			&inboundOrder.EmployeeId,
 			&inboundOrder.ProductBatchId,
 			&inboundOrder.WarehouseId,
+			&inboundOrder.Quantity,
 		)
 		if err != nil {
 			log.Println(err.Error())
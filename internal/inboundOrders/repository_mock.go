@@ -8,7 +8,7 @@ type MockInboundOrdersRepository struct {
 	getById db.InboundOrder
 }
 
-func (m MockInboundOrdersRepository) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId uint64) (db.InboundOrder, error)  {
+func (m MockInboundOrdersRepository) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId, quantity uint64) (db.InboundOrder, error)  {
 	if m.err != nil {
 		return db.InboundOrder{}, m.err
 	}
@@ -16,6 +16,7 @@ func Test_Repo_Create_Ok(t *testing.T) {
 		EmployeeId:     1,
 		ProductBatchId: 1,
 		WarehouseId:    1,
+		Quantity:       30,
 	}
 
 	database := util.CreateDB()
@@ -23,7 +24,7 @@ func Test_Repo_Create_Ok(t *testing.T) {
 	util.QueryExec(database, CREATE_INBOUND_ORDERS_TABLE)
 
 	repository := NewRepository(database)
-	_, err := repository.Create("2021-04-04", "order#1", 1, 1, 1)
+	_, err := repository.Create("2021-04-04", "order#1", 1, 1, 1, 30)
 	assert.Nil(t, err)
 
 	inboundOrderFounded, err := repository.Get(1)
@@ -40,7 +41,7 @@ func Test_Repo_Create_ConnectionError(t *testing.T) {
 	repository := NewRepository(database)
 
 	database.Close()
-	_, err := repository.Create("", "", 0, 0, 0)
+	_, err := repository.Create("", "", 0, 0, 0, 0)
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -59,8 +60,6 @@ func Test_Repo_Get_ConnectionError(t *testing.T) {
 	util.DropDB(database)
 }
 
-
-
 const CREATE_INBOUND_ORDERS_TABLE = `
 	CREATE TABLE "inbound_orders"(
 		id INTEGER PRIMARY KEY AUTOINCREMENT ,
@@ -69,6 +68,7 @@ const CREATE_INBOUND_ORDERS_TABLE = `
 		employee_id BIGINT  NOT NULL,
 		product_batch_id BIGINT  NOT NULL,
 		warehouse_id BIGINT  NOT NULL,
+		quantity BIGINT NOT NULL DEFAULT 0,
 		FOREIGN KEY (employee_id) REFERENCES employees(id),
 		FOREIGN KEY (product_batch_id) REFERENCES product_batches(id),
 		FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
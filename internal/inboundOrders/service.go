@@ -1,51 +1,120 @@
 package inboundorders
 
 import (
+	"database/sql"
 	"errors"
+	"time"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/employees"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
 )
 
 var (
-	WarehouseNotFoundError   = errors.New("warehouse not found")
-	EmployeeNotFoundError   = errors.New("employee not found")
-
+	WarehouseNotFoundError    = errors.New("warehouse not found")
+	EmployeeNotFoundError     = errors.New("employee not found")
+	ProductBatchNotFoundError = errors.New("product batch not found")
+	WarehouseMismatchError    = errors.New("product batch is stored in a section of another warehouse")
 )
 
 type InboundOrderService interface {
-	Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId uint64) (db.InboundOrder, error)
+	Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId, quantity uint64) (db.InboundOrder, error)
+}
+
+// Repositories are the transaction-bound repositories used by Create.
+type Repositories struct {
+	InboundOrders  InboundOrderRepository
+	ProductBatches batches.ProductBatchRepository
+	Sections       sections.SectionRepository
+	Ledger         ledger.LedgerRepository
 }
 
 type inboundOrderService struct {
-	employeeRepository employees.EmployeeRepository
+	employeeRepository  employees.EmployeeRepository
 	warehouseRepository warehouses.WarehouseRepository
-	inboundOrderRepository InboundOrderRepository
+	unitOfWork          uow.UnitOfWork[Repositories]
+	now                 func() time.Time
 }
 
-func NewInboundOrderService(employeeRepository employees.EmployeeRepository, warehouseRepository warehouses.WarehouseRepository, inboundOrderRepository InboundOrderRepository) InboundOrderService {
+func NewInboundOrderService(employeeRepository employees.EmployeeRepository, warehouseRepository warehouses.WarehouseRepository, unitOfWork uow.UnitOfWork[Repositories]) InboundOrderService {
 	return &inboundOrderService{
 		employeeRepository,
 		warehouseRepository,
-		inboundOrderRepository,
+		unitOfWork,
+		time.Now,
 	}
 }
 
-func (s *inboundOrderService) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId uint64) (db.InboundOrder, error) {
-	// TODO adicionar validaçao se productBatchId existe
-	 if !s.employeeRepository.ExistsEmployee(employeeId) {
-	 	return db.InboundOrder{}, EmployeeNotFoundError
-	 }
+// Create registers the receipt and adds its quantity to the product batch
+// stock and to the capacity in use of the batch section, all or nothing.
+// The section of the batch must belong to the receiving warehouse.
+func (s *inboundOrderService) Create(orderDate, orderNumber string, employeeId, productBatchId, warehouseId, quantity uint64) (db.InboundOrder, error) {
+	if !s.employeeRepository.ExistsEmployee(employeeId) {
+		return db.InboundOrder{}, EmployeeNotFoundError
+	}
 
-	 if _, err := s.warehouseRepository.Get(warehouseId); err != nil {
-	 	return db.InboundOrder{}, WarehouseNotFoundError
-	 }
+	_, err := s.warehouseRepository.Get(warehouseId)
+	if err == sql.ErrNoRows {
+		return db.InboundOrder{}, WarehouseNotFoundError
+	}
+
+	if err != nil {
+		return db.InboundOrder{}, err
+	}
+
+	var inboundOrder db.InboundOrder
+
+	err = s.unitOfWork.Do(func(r Repositories) error {
+		productBatch, err := r.ProductBatches.Get(productBatchId)
+		if err != nil {
+			return err
+		}
+
+		if productBatch.Id != productBatchId {
+			return ProductBatchNotFoundError
+		}
+
+		section, err := r.Sections.Get(productBatch.SectionId)
+		if err != nil {
+			return err
+		}
+
+		if section.WarehouseId != warehouseId {
+			return WarehouseMismatchError
+		}
+
+		if err := r.ProductBatches.IncreaseCurrentQuantity(productBatchId, quantity); err != nil {
+			return err
+		}
+
+		if err := r.Sections.IncreaseCurrentCapacity(productBatch.SectionId, quantity); err != nil {
+			return err
+		}
+
+		_, err = r.Ledger.Record(db.InventoryMovement{
+			ProductBatchId: productBatchId,
+			ProductId:      productBatch.ProductId,
+			SectionId:      productBatch.SectionId,
+			WarehouseId:    section.WarehouseId,
+			Type:           ledger.ReceiptMovement,
+			Quantity:       int64(quantity),
+			CreatedAt:      s.now().UTC().Format(ledger.TimeLayout),
+		})
+		if err != nil {
+			return err
+		}
+
+		inboundOrder, err = r.InboundOrders.Create(orderDate, orderNumber, employeeId, productBatchId, warehouseId, quantity)
+		return err
+	})
 
-	inboundOrder, err := s.inboundOrderRepository.Create(orderDate, orderNumber, employeeId, productBatchId, warehouseId)
 	if err != nil {
 		return db.InboundOrder{}, err
 	}
 
 	return inboundOrder, nil
-}
\ No newline at end of file
+}
@@ -1,11 +1,16 @@
 package inboundorders
 
 import (
+	"database/sql"
 	"errors"
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/employees"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,6 +23,7 @@ func Test_Create_Ok(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	mockWarehouse := db.Warehouse{
@@ -44,8 +50,8 @@ func Test_Create_Ok(t *testing.T) {
 		result: expectedResult,
 		err: nil,
 	}
-	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockInboundOrdersRepository)
-	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1)
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork(mockInboundOrdersRepository))
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
@@ -62,6 +68,7 @@ func Test_Create_Employee_Not_Found(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	mockEmployeeRepository := employees.MockEmployeeRepository{
@@ -74,8 +81,8 @@ func Test_Create_Employee_Not_Found(t *testing.T) {
 		result: expectedResult,
 		err: expectedError,
 	}
-	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockInboundOrdersRepository)
-	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1)
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork(mockInboundOrdersRepository))
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
 
 	assert.Empty(t, result)
 	assert.Equal(t, expectedError, err)
@@ -92,6 +99,7 @@ func Test_Create_Warehouse_Not_Found(t *testing.T) {
 		EmployeeId: 1,
 		ProductBatchId: 1,
 		WarehouseId: 1,
+		Quantity: 10,
 	}
 
 	mockEmployeeRepository := employees.MockEmployeeRepository{
@@ -100,15 +108,33 @@ func Test_Create_Warehouse_Not_Found(t *testing.T) {
 	}
 
 	mockWarehouseRepository := warehouses.MockWarehouseRepository{
-		Err: expectedError,
+		Err: sql.ErrNoRows,
 	}
 
 	mockInboundOrdersRepository := MockInboundOrdersRepository{
 		result: expectedResult,
 		err: expectedError,
 	}
-	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockInboundOrdersRepository)
-	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1)
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork(mockInboundOrdersRepository))
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
+
+	assert.Empty(t, result)
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Create_Warehouse_Internal_Server_Error(t *testing.T) {
+	expectedError := errors.New("connection refused")
+
+	mockEmployeeRepository := employees.MockEmployeeRepository{
+		ExistsEmployeeCode: true,
+	}
+
+	mockWarehouseRepository := warehouses.MockWarehouseRepository{
+		Err: expectedError,
+	}
+
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork(MockInboundOrdersRepository{}))
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
 
 	assert.Empty(t, result)
 	assert.Equal(t, expectedError, err)
@@ -140,9 +166,127 @@ func Test_Create_Internal_Server_Error(t *testing.T) {
 	mockInboundOrdersRepository := MockInboundOrdersRepository{
 		err: expectedError,
 	}
-	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockInboundOrdersRepository)
-	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1)
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork(mockInboundOrdersRepository))
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
 
 	assert.Empty(t, result)
 	assert.Equal(t, expectedError, err)
-}
\ No newline at end of file
+}
+
+func Test_Create_Product_Batch_Not_Found(t *testing.T) {
+	mockEmployeeRepository := employees.MockEmployeeRepository{
+		ExistsEmployeeCode: true,
+	}
+
+	mockWarehouseRepository := warehouses.MockWarehouseRepository{
+		GetById: db.Warehouse{Id: 1},
+	}
+
+	mockUnitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			InboundOrders:  MockInboundOrdersRepository{},
+			ProductBatches: batches.MockProductBatchesRepository{},
+			Sections:       sections.MockSectionRepository{},
+		},
+	}
+
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork)
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
+
+	assert.Empty(t, result)
+	assert.Equal(t, ProductBatchNotFoundError, err)
+}
+
+func Test_Create_Section_Capacity_Exceeded(t *testing.T) {
+	expectedError := sections.ErrSectionCapacityExceededError
+
+	mockEmployeeRepository := employees.MockEmployeeRepository{
+		ExistsEmployeeCode: true,
+	}
+
+	mockWarehouseRepository := warehouses.MockWarehouseRepository{
+		GetById: db.Warehouse{Id: 1},
+	}
+
+	mockUnitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			InboundOrders:  MockInboundOrdersRepository{},
+			ProductBatches: batches.MockProductBatchesRepository{GetById: db.ProductBatch{Id: 1, SectionId: 1}},
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 1, WarehouseId: 1}, UpdateErr: expectedError},
+		},
+	}
+
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork)
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
+
+	assert.Empty(t, result)
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Create_Warehouse_Mismatch(t *testing.T) {
+	mockEmployeeRepository := employees.MockEmployeeRepository{
+		ExistsEmployeeCode: true,
+	}
+
+	mockWarehouseRepository := warehouses.MockWarehouseRepository{
+		GetById: db.Warehouse{Id: 1},
+	}
+
+	var recorded []db.InventoryMovement
+	mockUnitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			InboundOrders:  MockInboundOrdersRepository{result: db.InboundOrder{Id: 1}},
+			ProductBatches: batches.MockProductBatchesRepository{GetById: db.ProductBatch{Id: 1, SectionId: 2}},
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 2, WarehouseId: 3}},
+			Ledger:         ledger.MockLedgerRepository{Recorded: &recorded},
+		},
+	}
+
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork)
+	result, err := service.Create("2022-04-04", "order#1", 1, 1, 1, 10)
+
+	assert.Empty(t, result)
+	assert.Equal(t, WarehouseMismatchError, err)
+	assert.Empty(t, recorded)
+}
+
+func Test_Create_Should_Record_Receipt(t *testing.T) {
+	mockEmployeeRepository := employees.MockEmployeeRepository{
+		ExistsEmployeeCode: true,
+	}
+
+	mockWarehouseRepository := warehouses.MockWarehouseRepository{
+		GetById: db.Warehouse{Id: 3},
+	}
+
+	var recorded []db.InventoryMovement
+	mockUnitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			InboundOrders:  MockInboundOrdersRepository{result: db.InboundOrder{Id: 1}},
+			ProductBatches: batches.MockProductBatchesRepository{GetById: db.ProductBatch{Id: 1, ProductId: 4, SectionId: 2}},
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 2, WarehouseId: 3}},
+			Ledger:         ledger.MockLedgerRepository{Recorded: &recorded},
+		},
+	}
+
+	service := NewInboundOrderService(mockEmployeeRepository, mockWarehouseRepository, mockUnitOfWork)
+	_, err := service.Create("2022-04-04", "order#1", 1, 1, 3, 10)
+
+	assert.Nil(t, err)
+	assert.Len(t, recorded, 1)
+	assert.Equal(t, ledger.ReceiptMovement, recorded[0].Type)
+	assert.Equal(t, int64(10), recorded[0].Quantity)
+	assert.Equal(t, uint64(4), recorded[0].ProductId)
+	assert.Equal(t, uint64(3), recorded[0].WarehouseId)
+}
+
+func mockUnitOfWork(inboundOrderRepository InboundOrderRepository) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			InboundOrders:  inboundOrderRepository,
+			ProductBatches: batches.MockProductBatchesRepository{GetById: db.ProductBatch{Id: 1, SectionId: 1}},
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 1, WarehouseId: 1}},
+			Ledger:         ledger.MockLedgerRepository{},
+		},
+	}
+}
@@ -0,0 +1,168 @@
+package ledger
+
+import (
+	"database/sql"
+	"strings"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+// Movement types. Quantities are signed: stock in is positive, stock out
+// is negative.
+const (
+	OpeningMovement    = "opening"
+	ReceiptMovement    = "receipt"
+	PickMovement       = "pick"
+	TransferMovement   = "transfer"
+	AdjustmentMovement = "adjustment"
+	WriteOffMovement   = "write_off"
+)
+
+// TimeLayout is the layout of inventory_movements.created_at, always in UTC.
+const TimeLayout = "2006-01-02 15:04:05"
+
+// LedgerRepository appends to the inventory movements ledger. Movements are
+// never updated or deleted; a correction is a new movement.
+type LedgerRepository interface {
+	Record(movement db.InventoryMovement) (db.InventoryMovement, error)
+	// StockAt sums the movements recorded up to at, inclusive, by product
+	// and warehouse. A zero productId or warehouseId means all of them.
+	StockAt(productId uint64, warehouseId uint64, at string) ([]db.StockLevel, error)
+	GetMovements(params query.Params) (query.Page[db.InventoryMovement], error)
+}
+
+type ledgerRepository struct {
+	db db.Querier
+}
+
+func NewLedgerRepository(database db.Querier) LedgerRepository {
+	return &ledgerRepository{
+		db: database,
+	}
+}
+
+var movementsQuery = query.NewBuilder(
+	"inventory_movements",
+	"id, product_batch_id, product_id, section_id, warehouse_id, movement_type, quantity, reason, created_at",
+	map[string]string{
+		"id":               "id",
+		"product_batch_id": "product_batch_id",
+		"product_id":       "product_id",
+		"section_id":       "section_id",
+		"warehouse_id":     "warehouse_id",
+		"movement_type":    "movement_type",
+		"created_at":       "created_at",
+	},
+)
+
+func (r *ledgerRepository) Record(movement db.InventoryMovement) (db.InventoryMovement, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO inventory_movements(product_batch_id, product_id, section_id, warehouse_id, movement_type, quantity, reason, created_at)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.InventoryMovement{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+	result, err = stmt.Exec(
+		movement.ProductBatchId,
+		movement.ProductId,
+		movement.SectionId,
+		movement.WarehouseId,
+		movement.Type,
+		movement.Quantity,
+		movement.Reason,
+		movement.CreatedAt,
+	)
+	if err != nil {
+		return db.InventoryMovement{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	movement.Id = uint64(insertedId)
+
+	return movement, nil
+}
+
+func (r *ledgerRepository) StockAt(productId uint64, warehouseId uint64, at string) ([]db.StockLevel, error) {
+	conditions := []string{"created_at <= ?"}
+	args := []any{at}
+
+	if productId != 0 {
+		conditions = append(conditions, "product_id = ?")
+		args = append(args, productId)
+	}
+
+	if warehouseId != 0 {
+		conditions = append(conditions, "warehouse_id = ?")
+		args = append(args, warehouseId)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT product_id, warehouse_id, SUM(quantity) FROM inventory_movements
+		WHERE `+strings.Join(conditions, " AND ")+`
+		GROUP BY product_id, warehouse_id ORDER BY product_id, warehouse_id`,
+		args...,
+	)
+	if err != nil {
+		return []db.StockLevel{}, err
+	}
+
+	defer rows.Close()
+
+	levels := []db.StockLevel{}
+
+	for rows.Next() {
+		var level db.StockLevel
+
+		if err := rows.Scan(&level.ProductId, &level.WarehouseId, &level.Quantity); err != nil {
+			return []db.StockLevel{}, err
+		}
+
+		levels = append(levels, level)
+	}
+
+	return levels, rows.Err()
+}
+
+func (r *ledgerRepository) GetMovements(params query.Params) (query.Page[db.InventoryMovement], error) {
+	statement, args, err := movementsQuery.Build(params)
+	if err != nil {
+		return query.Page[db.InventoryMovement]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[db.InventoryMovement]{}, err
+	}
+
+	defer rows.Close()
+
+	var movements []db.InventoryMovement
+
+	for rows.Next() {
+		var movement db.InventoryMovement
+
+		if err := rows.Scan(
+			&movement.Id,
+			&movement.ProductBatchId,
+			&movement.ProductId,
+			&movement.SectionId,
+			&movement.WarehouseId,
+			&movement.Type,
+			&movement.Quantity,
+			&movement.Reason,
+			&movement.CreatedAt,
+		); err != nil {
+			return query.Page[db.InventoryMovement]{}, err
+		}
+
+		movements = append(movements, movement)
+	}
+
+	return query.NewPage(movements, params)
+}
@@ -0,0 +1,40 @@
+package ledger
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+// MockLedgerRepository keeps the recorded movements in Recorded, when set,
+// so tests can check what a service wrote to the ledger.
+type MockLedgerRepository struct {
+	Recorded *[]db.InventoryMovement
+	Levels   []db.StockLevel
+	Err      error
+}
+
+func (m MockLedgerRepository) Record(movement db.InventoryMovement) (db.InventoryMovement, error) {
+	if m.Err != nil {
+		return db.InventoryMovement{}, m.Err
+	}
+	if m.Recorded != nil {
+		*m.Recorded = append(*m.Recorded, movement)
+		movement.Id = uint64(len(*m.Recorded))
+	}
+	return movement, nil
+}
+
+func (m MockLedgerRepository) StockAt(productId uint64, warehouseId uint64, at string) ([]db.StockLevel, error) {
+	return m.Levels, m.Err
+}
+
+func (m MockLedgerRepository) GetMovements(params query.Params) (query.Page[db.InventoryMovement], error) {
+	if m.Err != nil {
+		return query.Page[db.InventoryMovement]{}, m.Err
+	}
+	var movements []db.InventoryMovement
+	if m.Recorded != nil {
+		movements = *m.Recorded
+	}
+	return query.NewPage(movements, params)
+}
@@ -0,0 +1,123 @@
+package ledger
+
+import (
+	"database/sql"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Record_Ok(t *testing.T) {
+
+	database := createLedgerDB()
+	repository := NewLedgerRepository(database)
+
+	movement := db.InventoryMovement{
+		ProductBatchId: 1, ProductId: 1, SectionId: 1, WarehouseId: 1, Type: AdjustmentMovement, Quantity: -2, Reason: "broken", CreatedAt: "2022-08-01 10:00:00",
+	}
+
+	recorded, err := repository.Record(movement)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(6), recorded.Id)
+
+	found, err := repository.GetMovements(query.Params{Filters: map[string]string{"movement_type": AdjustmentMovement}})
+	assert.Nil(t, err)
+	assert.Equal(t, []db.InventoryMovement{recorded}, found.Items)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_StockAt_Ok(t *testing.T) {
+
+	database := createLedgerDB()
+	repository := NewLedgerRepository(database)
+
+	levels, err := repository.StockAt(0, 0, "2022-06-30 23:59:59")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockLevel{
+		{ProductId: 1, WarehouseId: 1, Quantity: 70},
+		{ProductId: 2, WarehouseId: 2, Quantity: 10},
+	}, levels)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_StockAt_ShouldIncludeMovementsAtTheMoment(t *testing.T) {
+
+	database := createLedgerDB()
+	repository := NewLedgerRepository(database)
+
+	levels, err := repository.StockAt(1, 1, "2022-07-10 08:00:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockLevel{{ProductId: 1, WarehouseId: 1, Quantity: 50}}, levels)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_StockAt_ByWarehouse(t *testing.T) {
+
+	database := createLedgerDB()
+	repository := NewLedgerRepository(database)
+
+	levels, err := repository.StockAt(0, 2, "2022-12-31 00:00:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockLevel{
+		{ProductId: 1, WarehouseId: 2, Quantity: 20},
+		{ProductId: 2, WarehouseId: 2, Quantity: 10},
+	}, levels)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_StockAt_ConnectionError(t *testing.T) {
+
+	database := createLedgerDB()
+	repository := NewLedgerRepository(database)
+
+	database.Close()
+	levels, err := repository.StockAt(0, 0, "2022-12-31 00:00:00")
+
+	assert.NotNil(t, err)
+	assert.Empty(t, levels)
+
+	util.DropDB(database)
+}
+
+func createLedgerDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_INVENTORY_MOVEMENTS_TABLE)
+	util.QueryExec(database, INSERT_INVENTORY_MOVEMENTS)
+	return database
+}
+
+// 100 units of product 1 arrive in warehouse 1 in June; 30 are picked in
+// June, 20 in July, and 20 more arrive in warehouse 2 in August.
+const INSERT_INVENTORY_MOVEMENTS = `
+	INSERT INTO inventory_movements(product_batch_id, product_id, section_id, warehouse_id, movement_type, quantity, reason, created_at)
+	VALUES (1, 1, 1, 1, "receipt", 100, "", "2022-06-01 10:00:00"),
+		(1, 1, 1, 1, "pick", -30, "", "2022-06-20 10:00:00"),
+		(2, 2, 2, 2, "receipt", 10, "", "2022-06-25 10:00:00"),
+		(1, 1, 1, 1, "pick", -20, "", "2022-07-10 08:00:00"),
+		(1, 1, 2, 2, "transfer", 20, "", "2022-08-01 10:00:00")
+`
+
+const CREATE_INVENTORY_MOVEMENTS_TABLE = `
+	CREATE TABLE "inventory_movements"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		movement_type TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
@@ -2,9 +2,10 @@ package localities
 
 import (
 	"database/sql"
-	"log"
+	"strings"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 const (
@@ -19,6 +20,31 @@ const (
 		WHERE localities.id = ?
 		GROUP BY localities.id
 	`
+	GetAllLocalityInfoQuery = `
+		SELECT localities.id, localities.locality_name, COUNT(sellers.id) AS sellers_count
+		FROM localities
+		LEFT JOIN sellers
+		ON localities.id = sellers.locality_id
+		GROUP BY localities.id
+		ORDER BY localities.id
+	`
+	// GetGeoReportQuery counts per locality, with a row without locality
+	// for each province without localities and a row without province for
+	// each country without provinces, so they are reported too.
+	GetGeoReportQuery = `
+		SELECT countries.id, countries.country_name,
+			COALESCE(provinces.id, 0), COALESCE(provinces.province_name, ''),
+			COALESCE(localities.id, ''), COALESCE(localities.locality_name, ''),
+			(SELECT COUNT(*) FROM sellers WHERE sellers.locality_id = localities.id),
+			(SELECT COUNT(*) FROM carriers WHERE carriers.locality_id = localities.id),
+			(SELECT COUNT(*) FROM warehouses WHERE warehouses.locality_id = localities.id),
+			(SELECT COUNT(*) FROM employees
+				JOIN warehouses ON warehouses.id = employees.warehouse_id
+				WHERE warehouses.locality_id = localities.id)
+		FROM countries
+		LEFT JOIN provinces ON provinces.id_country_fk = countries.id
+		LEFT JOIN localities ON localities.province_id = provinces.id
+		WHERE 1 = 1`
 )
 
 type LocalityInfo struct {
@@ -27,15 +53,44 @@ type LocalityInfo struct {
 	SellersCount uint64 `json:"sellers_count"`
 }
 
+// GeoReport counts the sellers, carriers, warehouses and employees of a
+// country, a province or a locality. Province and locality are empty on
+// the rows of the levels above them.
+type GeoReport struct {
+	CountryId       uint64 `json:"country_id"`
+	CountryName     string `json:"country_name"`
+	ProvinceId      uint64 `json:"province_id,omitempty"`
+	ProvinceName    string `json:"province_name,omitempty"`
+	LocalityId      string `json:"locality_id,omitempty"`
+	LocalityName    string `json:"locality_name,omitempty"`
+	SellersCount    uint64 `json:"sellers_count"`
+	CarriersCount   uint64 `json:"carriers_count"`
+	WarehousesCount uint64 `json:"warehouses_count"`
+	EmployeesCount  uint64 `json:"employees_count"`
+}
+
+// GeoFilter narrows the geographic report down. Zero fields are not
+// filtered on.
+type GeoFilter struct {
+	CountryId  uint64
+	ProvinceId uint64
+	LocalityId string
+}
+
 type Repository interface {
 	Create(localityId string, localityName string, provinceId uint64) (database.Locality, error)
 	FindLocalityId(localityId string) bool
-	GetLocalityInfo(localityId string) ([]LocalityInfo, error)
+	// GetLocalityInfo counts the sellers of a locality, or of every
+	// locality when the id is empty.
+	GetLocalityInfo(localityId string) report.Rows[LocalityInfo]
 	ExistsProvinceId(provinceId uint64) bool
+	// GetGeoReport lists the counts of GetGeoReportQuery, ordered by
+	// country, province and locality.
+	GetGeoReport(filter GeoFilter) ([]GeoReport, error)
 }
 
 type repository struct {
-	db *sql.DB
+	db database.Querier
 }
 
 func (r *repository) Create(localityId string, localityName string, provinceId uint64) (database.Locality, error) {
@@ -76,26 +131,69 @@ func (r *repository) ExistsProvinceId(provinceId uint64) bool {
 	return err == nil
 }
 
-func (r *repository) GetLocalityInfo(localityId string) ([]LocalityInfo, error) {
-	var localityInfos []LocalityInfo
+func (r *repository) GetLocalityInfo(localityId string) report.Rows[LocalityInfo] {
+	return report.Query(func() (*sql.Rows, error) {
+		if localityId == "" {
+			return r.db.Query(GetAllLocalityInfoQuery)
+		}
+		return r.db.Query(GetLocalityInfoQuery, localityId)
+	}, func(rows *sql.Rows, localityInfo *LocalityInfo) error {
+		return rows.Scan(&localityInfo.LocalityId, &localityInfo.LocalityName, &localityInfo.SellersCount)
+	})
+}
+
+func (r *repository) GetGeoReport(filter GeoFilter) ([]GeoReport, error) {
+	var geoQuery strings.Builder
+	geoQuery.WriteString(GetGeoReportQuery)
 
-	rows, err := r.db.Query(GetLocalityInfoQuery, localityId)
+	args := []any{}
 
+	if filter.CountryId != 0 {
+		geoQuery.WriteString(" AND countries.id = ?")
+		args = append(args, filter.CountryId)
+	}
+	if filter.ProvinceId != 0 {
+		geoQuery.WriteString(" AND provinces.id = ?")
+		args = append(args, filter.ProvinceId)
+	}
+	if filter.LocalityId != "" {
+		geoQuery.WriteString(" AND localities.id = ?")
+		args = append(args, filter.LocalityId)
+	}
+
+	geoQuery.WriteString(" ORDER BY countries.id, provinces.id, localities.id")
+
+	rows, err := r.db.Query(geoQuery.String(), args...)
 	if err != nil {
-		return []LocalityInfo{}, err
+		return []GeoReport{}, err
 	}
 
+	defer rows.Close()
+
+	reports := []GeoReport{}
+
 	for rows.Next() {
-		var localityInfo LocalityInfo
-		if err := rows.Scan(&localityInfo.LocalityId, &localityInfo.LocalityName, &localityInfo.SellersCount); err != nil {
-			log.Println(err.Error())
-			return []LocalityInfo{}, err
+		var report GeoReport
+
+		if err := rows.Scan(
+			&report.CountryId,
+			&report.CountryName,
+			&report.ProvinceId,
+			&report.ProvinceName,
+			&report.LocalityId,
+			&report.LocalityName,
+			&report.SellersCount,
+			&report.CarriersCount,
+			&report.WarehousesCount,
+			&report.EmployeesCount,
+		); err != nil {
+			return []GeoReport{}, err
 		}
 
-		localityInfos = append(localityInfos, localityInfo)
+		reports = append(reports, report)
 	}
 
-	return localityInfos, nil
+	return reports, rows.Err()
 }
 
 func createLocality(localityId string, localityName string, provinceId uint64) database.Locality {
@@ -106,7 +204,7 @@ func createLocality(localityId string, localityName string, provinceId uint64) d
 	}
 }
 
-func NewRepository(db *sql.DB) Repository {
+func NewRepository(db database.Querier) Repository {
 	return &repository{
 		db: db,
 	}
@@ -1,12 +1,17 @@
 package localities
 
-import "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+import (
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
+)
 
 type mockLocalityRepository struct {
 	result         any
 	err            error
 	findProvinceId bool
 	findLocalityId bool
+	geo            []GeoReport
+	geoFilter      *GeoFilter
 }
 
 func (m mockLocalityRepository) FindCid(cid uint64) bool {
@@ -20,11 +25,11 @@ func (m mockLocalityRepository) Create(localityId string, localityName string, p
 	return m.result.(database.Locality), nil
 }
 
-func (m mockLocalityRepository) GetLocalityInfo(localityId string) ([]LocalityInfo, error) {
+func (m mockLocalityRepository) GetLocalityInfo(localityId string) report.Rows[LocalityInfo] {
 	if m.err != nil {
-		return []LocalityInfo{}, m.err
+		return report.Err[LocalityInfo](m.err)
 	}
-	return m.result.([]LocalityInfo), nil
+	return report.Slice(m.result.([]LocalityInfo))
 }
 
 func (m mockLocalityRepository) FindLocalityId(localityId string) bool {
@@ -34,3 +39,13 @@ func (m mockLocalityRepository) FindLocalityId(localityId string) bool {
 func (m mockLocalityRepository) ExistsProvinceId(provinceId uint64) bool {
 	return m.findProvinceId
 }
+
+func (m mockLocalityRepository) GetGeoReport(filter GeoFilter) ([]GeoReport, error) {
+	if m.geoFilter != nil {
+		*m.geoFilter = filter
+	}
+	if m.err != nil {
+		return []GeoReport{}, m.err
+	}
+	return m.geo, nil
+}
@@ -1,12 +1,14 @@
 package localities
 
 import (
+	"database/sql"
 	"testing"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -94,7 +96,7 @@ func Test_Repo_GetLocalityInfo_Ok(t *testing.T) {
 
 	assert.Nil(t, err)
 
-	result, err := repository.GetLocalityInfo("11065001")
+	result, err := report.Collect(repository.GetLocalityInfo("11065001"))
 
 	assert.Nil(t, err)
 	assert.Equal(t, localityInfo, result)
@@ -115,13 +117,95 @@ func Test_Repo_GetLocalityInfo_InternalError(t *testing.T) {
 	assert.Nil(t, err)
 
 	database.Close()
-	_, err = repository.GetLocalityInfo("11065001")
+	_, err = report.Collect(repository.GetLocalityInfo("11065001"))
 
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
 }
 
+func Test_Repo_GetLocalityInfo_AllLocalities(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_LOCALITY_TABLE)
+	util.QueryExec(database, CREATE_SELLERS_TABLE)
+	util.QueryExec(database, INSERT_LOCALITIES)
+	util.QueryExec(database, INSERT_SELLER)
+
+	repository := NewRepository(database)
+
+	result, err := report.Collect(repository.GetLocalityInfo(""))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []LocalityInfo{
+		{LocalityId: "10235001", LocalityName: "Campinas", SellersCount: 0},
+		{LocalityId: "11065001", LocalityName: "Santos", SellersCount: 1},
+		{LocalityId: "11223001", LocalityName: "Buenas Aires", SellersCount: 0},
+	}, result)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetGeoReport_Ok(t *testing.T) {
+
+	database := createGeoDB()
+	repository := NewRepository(database)
+
+	result, err := repository.GetGeoReport(GeoFilter{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []GeoReport{
+		{CountryId: 1, CountryName: "Brasil", ProvinceId: 1, ProvinceName: "São Paulo", LocalityId: "10235001", LocalityName: "Campinas"},
+		{CountryId: 1, CountryName: "Brasil", ProvinceId: 1, ProvinceName: "São Paulo", LocalityId: "11065001", LocalityName: "Santos",
+			SellersCount: 1, CarriersCount: 1, WarehousesCount: 1, EmployeesCount: 2},
+		{CountryId: 1, CountryName: "Brasil", ProvinceId: 2, ProvinceName: "Rio de Janeiro"},
+		{CountryId: 2, CountryName: "Argentina", ProvinceId: 4, ProvinceName: "Buenas Aires", LocalityId: "11223001", LocalityName: "Buenas Aires"},
+		{CountryId: 3, CountryName: "Chile"},
+	}, result)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetGeoReport_Filtered(t *testing.T) {
+
+	database := createGeoDB()
+	repository := NewRepository(database)
+
+	byProvince, err := repository.GetGeoReport(GeoFilter{CountryId: 1, ProvinceId: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []GeoReport{{CountryId: 1, CountryName: "Brasil", ProvinceId: 2, ProvinceName: "Rio de Janeiro"}}, byProvince)
+
+	byLocality, err := repository.GetGeoReport(GeoFilter{LocalityId: "11065001"})
+	assert.Nil(t, err)
+	assert.Len(t, byLocality, 1)
+	assert.Equal(t, uint64(2), byLocality[0].EmployeesCount)
+
+	unknown, err := repository.GetGeoReport(GeoFilter{CountryId: 2, ProvinceId: 1})
+	assert.Nil(t, err)
+	assert.Empty(t, unknown)
+
+	util.DropDB(database)
+}
+
+func createGeoDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_COUNTRIES_TABLE)
+	util.QueryExec(database, CREATE_PROVINCE_TABLE)
+	util.QueryExec(database, CREATE_LOCALITY_TABLE)
+	util.QueryExec(database, CREATE_SELLERS_TABLE)
+	util.QueryExec(database, CREATE_CARRIERS_TABLE)
+	util.QueryExec(database, CREATE_WAREHOUSES_TABLE)
+	util.QueryExec(database, CREATE_EMPLOYEES_TABLE)
+	util.QueryExec(database, INSERT_COUNTRIES)
+	util.QueryExec(database, INSERT_PROVINCES)
+	util.QueryExec(database, INSERT_LOCALITIES)
+	util.QueryExec(database, INSERT_SELLER)
+	util.QueryExec(database, INSERT_CARRIER)
+	util.QueryExec(database, INSERT_WAREHOUSE)
+	util.QueryExec(database, INSERT_EMPLOYEES)
+	return database
+}
+
 const CREATE_LOCALITY_TABLE = `
 	CREATE TABLE "localities" (
 		id TEXT NOT NULL,
@@ -160,3 +244,73 @@ const INSERT_SELLER = `
 	INSERT INTO sellers(cid, company_name, address, telephone, locality_id)  
 	VALUES  (1, "Nike", "Rua Pedro Américo, 212", "13990984533", "11065001");
 `
+
+const INSERT_LOCALITIES = `
+	INSERT INTO localities(id, locality_name, province_id)
+	VALUES ("11065001", "Santos", 1), ("10235001", "Campinas", 1), ("11223001", "Buenas Aires", 4);
+`
+
+const CREATE_CARRIERS_TABLE = `
+	CREATE TABLE "carriers" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		cid TEXT UNIQUE NOT NULL,
+		company_name TEXT NOT NULL,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const INSERT_CARRIER = `
+	INSERT INTO carriers(cid, company_name, address, telephone, locality_id)
+	VALUES ("CID1", "Rapido", "Rua 1", "1111", "11065001");
+`
+
+const CREATE_WAREHOUSES_TABLE = `
+	CREATE TABLE "warehouses" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		warehouse_code TEXT UNIQUE NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const INSERT_WAREHOUSE = `
+	INSERT INTO warehouses(address, telephone, warehouse_code, minimum_capacity, minimum_temperature, locality_id)
+	VALUES ("Rua 2", "2222", "WH1", 10, 2, "11065001");
+`
+
+const CREATE_COUNTRIES_TABLE = `
+	CREATE TABLE "countries"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		country_name TEXT NOT NULL
+	);
+`
+
+const INSERT_COUNTRIES = `
+	INSERT INTO countries(country_name) VALUES ("Brasil"), ("Argentina"), ("Chile");
+`
+
+// Rio de Janeiro has no localities and Chile has no provinces.
+const INSERT_PROVINCES = `
+	INSERT INTO provinces(id, province_name, id_country_fk)
+	VALUES (1, "São Paulo", 1), (2, "Rio de Janeiro", 1), (4, "Buenas Aires", 2);
+`
+
+const CREATE_EMPLOYEES_TABLE = `
+	CREATE TABLE "employees"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		id_card_number TEXT NOT NULL,
+		first_name TEXT NOT NULL,
+		last_name TEXT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
+
+const INSERT_EMPLOYEES = `
+	INSERT INTO employees(id_card_number, first_name, last_name, warehouse_id)
+	VALUES ("111", "Ana", "Lima", 1), ("222", "Bruno", "Souza", 1);
+`
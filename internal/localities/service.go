@@ -4,17 +4,34 @@ import (
 	"errors"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 var (
 	ExistsLocalityId      = errors.New("locality id already exists")
 	ExistsProvinceIdError = errors.New("province id does not exist")
 	LocalityNotFoundError = errors.New("locality not found")
+	CountryNotFoundError  = errors.New("country not found")
+	ProvinceNotFoundError = errors.New("province not found")
+	InvalidGeoLevelError  = errors.New("level must be country, province or locality, and not above the filter")
 )
 
+// Levels of the geographic report, from the top.
+const (
+	CountryLevel  = "country"
+	ProvinceLevel = "province"
+	LocalityLevel = "locality"
+)
+
+var geoLevels = map[string]int{CountryLevel: 0, ProvinceLevel: 1, LocalityLevel: 2}
+
 type Service interface {
 	Create(localityId string, localityName string, provinceId uint64) (database.Locality, error)
-	GetLocalityInfo(localityId string) ([]LocalityInfo, error)
+	GetLocalityInfo(localityId string) (report.Rows[LocalityInfo], error)
+	// GetGeoReport rolls the counts up to the given level, within the
+	// filter. Without a level, it drills down one level below the most
+	// specific filter: countries, then their provinces, then localities.
+	GetGeoReport(level string, filter GeoFilter) ([]GeoReport, error)
 }
 
 type service struct {
@@ -43,20 +60,95 @@ func (s service) Create(localityId string, localityName string, provinceId uint6
 	return localityData, nil
 }
 
-func (s service) GetLocalityInfo(localityId string) ([]LocalityInfo, error) {
-	isLocalityIdFound := s.repo.FindLocalityId(localityId)
+func (s service) GetLocalityInfo(localityId string) (report.Rows[LocalityInfo], error) {
+	isLocalityIdFound := localityId == "" || s.repo.FindLocalityId(localityId)
 
 	if !isLocalityIdFound {
-		return []LocalityInfo{}, LocalityNotFoundError
+		return nil, LocalityNotFoundError
+	}
+
+	return s.repo.GetLocalityInfo(localityId), nil
+}
+
+func (s service) GetGeoReport(level string, filter GeoFilter) ([]GeoReport, error) {
+	filterLevel := CountryLevel
+	drillDown := CountryLevel
+
+	switch {
+	case filter.LocalityId != "":
+		filterLevel, drillDown = LocalityLevel, LocalityLevel
+	case filter.ProvinceId != 0:
+		filterLevel, drillDown = ProvinceLevel, LocalityLevel
+	case filter.CountryId != 0:
+		filterLevel, drillDown = CountryLevel, ProvinceLevel
+	}
+
+	if level == "" {
+		level = drillDown
 	}
 
-	localityInfo, err := s.repo.GetLocalityInfo(localityId)
+	depth, ok := geoLevels[level]
+	if !ok || depth < geoLevels[filterLevel] {
+		return []GeoReport{}, InvalidGeoLevelError
+	}
 
+	rows, err := s.repo.GetGeoReport(filter)
 	if err != nil {
-		return []LocalityInfo{}, err
+		return []GeoReport{}, err
+	}
+
+	if len(rows) == 0 {
+		switch {
+		case filter.LocalityId != "":
+			return []GeoReport{}, LocalityNotFoundError
+		case filter.ProvinceId != 0:
+			return []GeoReport{}, ProvinceNotFoundError
+		case filter.CountryId != 0:
+			return []GeoReport{}, CountryNotFoundError
+		}
 	}
 
-	return localityInfo, nil
+	return rollUp(rows, level), nil
+}
+
+// rollUp sums the rows, ordered by country, province and locality, into
+// one report per entity of the level.
+func rollUp(rows []GeoReport, level string) []GeoReport {
+	reports := []GeoReport{}
+
+	for _, row := range rows {
+		switch level {
+		case CountryLevel:
+			row.ProvinceId, row.ProvinceName = 0, ""
+			row.LocalityId, row.LocalityName = "", ""
+		case ProvinceLevel:
+			if row.ProvinceId == 0 {
+				continue
+			}
+			row.LocalityId, row.LocalityName = "", ""
+		case LocalityLevel:
+			if row.LocalityId == "" {
+				continue
+			}
+		}
+
+		last := len(reports) - 1
+		if last < 0 || !sameGeo(reports[last], row) {
+			reports = append(reports, row)
+			continue
+		}
+
+		reports[last].SellersCount += row.SellersCount
+		reports[last].CarriersCount += row.CarriersCount
+		reports[last].WarehousesCount += row.WarehousesCount
+		reports[last].EmployeesCount += row.EmployeesCount
+	}
+
+	return reports
+}
+
+func sameGeo(a GeoReport, b GeoReport) bool {
+	return a.CountryId == b.CountryId && a.ProvinceId == b.ProvinceId && a.LocalityId == b.LocalityId
 }
 
 func NewService(r Repository) Service {
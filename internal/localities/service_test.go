@@ -5,9 +5,19 @@ import (
 	"testing"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/stretchr/testify/assert"
 )
 
+// collect reads the rows of a report, or returns the error of the service
+// with no rows.
+func collect(rows report.Rows[LocalityInfo], err error) ([]LocalityInfo, error) {
+	if err != nil {
+		return []LocalityInfo{}, err
+	}
+	return report.Collect(rows)
+}
+
 func Test_GetLocalityInfo_OK(t *testing.T) {
 
 	expectedResult := []LocalityInfo{
@@ -25,7 +35,7 @@ func Test_GetLocalityInfo_OK(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetLocalityInfo("11065001")
+	result, err := collect(service.GetLocalityInfo("11065001"))
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
@@ -44,7 +54,7 @@ func Test_GetLocalityInfo_NotFoundError(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetLocalityInfo("11065000")
+	result, err := collect(service.GetLocalityInfo("11065000"))
 
 	assert.Equal(t, expectedResult, result)
 	assert.Equal(t, expectedError, err)
@@ -62,7 +72,7 @@ func Test_GetLocalityInfo_InternalServerError(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetLocalityInfo("11065000")
+	result, err := collect(service.GetLocalityInfo("11065000"))
 
 	assert.Equal(t, expectedResult, result)
 	assert.Equal(t, expectedError, err)
@@ -85,7 +95,7 @@ func Test_CreateLocality_OK(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetLocalityInfo("11065001")
+	result, err := collect(service.GetLocalityInfo("11065001"))
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
@@ -150,3 +160,100 @@ func Test_Create_OK(t *testing.T) {
 	assert.Equal(t, expectedResult, result)
 	assert.Nil(t, err)
 }
+
+var buenosAires = database.Locality{Id: "11223001", Name: "Buenas Aires", ProvinceId: 4}
+
+func Test_GetLocalityInfo_AllLocalities(t *testing.T) {
+
+	expectedResult := []LocalityInfo{
+		{LocalityId: "10235001", LocalityName: "Campinas", SellersCount: 0},
+		{LocalityId: "11065001", LocalityName: "Santos", SellersCount: 1},
+	}
+
+	service := NewService(mockLocalityRepository{result: expectedResult, findLocalityId: false})
+	result, err := collect(service.GetLocalityInfo(""))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+var geoRows = []GeoReport{
+	{CountryId: 1, CountryName: "Brasil", ProvinceId: 1, ProvinceName: "São Paulo", LocalityId: "10235001", LocalityName: "Campinas", SellersCount: 2},
+	{CountryId: 1, CountryName: "Brasil", ProvinceId: 1, ProvinceName: "São Paulo", LocalityId: "11065001", LocalityName: "Santos",
+		SellersCount: 1, CarriersCount: 1, WarehousesCount: 1, EmployeesCount: 2},
+	{CountryId: 1, CountryName: "Brasil", ProvinceId: 2, ProvinceName: "Rio de Janeiro"},
+	{CountryId: 3, CountryName: "Chile"},
+}
+
+func Test_GetGeoReport_ShouldRollUpToCountries(t *testing.T) {
+
+	service := NewService(mockLocalityRepository{geo: geoRows})
+
+	result, err := service.GetGeoReport("", GeoFilter{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []GeoReport{
+		{CountryId: 1, CountryName: "Brasil", SellersCount: 3, CarriersCount: 1, WarehousesCount: 1, EmployeesCount: 2},
+		{CountryId: 3, CountryName: "Chile"},
+	}, result)
+}
+
+func Test_GetGeoReport_ShouldDrillDownToProvinces(t *testing.T) {
+
+	var filter GeoFilter
+	service := NewService(mockLocalityRepository{geo: geoRows[:3], geoFilter: &filter})
+
+	result, err := service.GetGeoReport("", GeoFilter{CountryId: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, GeoFilter{CountryId: 1}, filter)
+	assert.Equal(t, []GeoReport{
+		{CountryId: 1, CountryName: "Brasil", ProvinceId: 1, ProvinceName: "São Paulo", SellersCount: 3, CarriersCount: 1, WarehousesCount: 1, EmployeesCount: 2},
+		{CountryId: 1, CountryName: "Brasil", ProvinceId: 2, ProvinceName: "Rio de Janeiro"},
+	}, result)
+}
+
+func Test_GetGeoReport_ShouldListLocalities(t *testing.T) {
+
+	service := NewService(mockLocalityRepository{geo: geoRows})
+
+	result, err := service.GetGeoReport(LocalityLevel, GeoFilter{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, geoRows[:2], result)
+}
+
+func Test_GetGeoReport_InvalidLevel(t *testing.T) {
+
+	service := NewService(mockLocalityRepository{geo: geoRows})
+
+	_, err := service.GetGeoReport("city", GeoFilter{})
+	assert.Equal(t, InvalidGeoLevelError, err)
+
+	_, err = service.GetGeoReport(CountryLevel, GeoFilter{ProvinceId: 1})
+	assert.Equal(t, InvalidGeoLevelError, err)
+}
+
+func Test_GetGeoReport_NotFound(t *testing.T) {
+
+	service := NewService(mockLocalityRepository{geo: []GeoReport{}})
+
+	_, err := service.GetGeoReport("", GeoFilter{CountryId: 9})
+	assert.Equal(t, CountryNotFoundError, err)
+
+	_, err = service.GetGeoReport("", GeoFilter{CountryId: 1, ProvinceId: 9})
+	assert.Equal(t, ProvinceNotFoundError, err)
+
+	_, err = service.GetGeoReport("", GeoFilter{LocalityId: "99999999"})
+	assert.Equal(t, LocalityNotFoundError, err)
+}
+
+func Test_GetGeoReport_RepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := NewService(mockLocalityRepository{err: expectedError})
+
+	_, err := service.GetGeoReport("", GeoFilter{})
+
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,235 @@
+package orderdetails
+
+import (
+	"database/sql"
+	"log"
+
+	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+const (
+	GetAllQuery = `
+		SELECT id, clean_liness_status, quantity, temperature, product_record_id, purchase_order_id
+		FROM order_details
+	`
+	GetQuery                  = GetAllQuery + " WHERE id = ?"
+	GetByPurchaseOrderIdQuery = GetAllQuery + " WHERE purchase_order_id = ?"
+	CreateQuery               = `
+		INSERT INTO order_details(
+			clean_liness_status,
+			quantity,
+			temperature,
+			product_record_id,
+			purchase_order_id
+		) VALUES (?, ?, ?, ?, ?)
+	`
+	UpdateQuery = `
+		UPDATE order_details SET
+			clean_liness_status = ?,
+			quantity = ?,
+			temperature = ?,
+			product_record_id = ?,
+			purchase_order_id = ?
+		WHERE id = ?
+	`
+	DeleteQuery                = "DELETE FROM order_details WHERE id = ?"
+	ExistsProductRecordIdQuery = "SELECT id FROM product_records WHERE id = ?"
+	ExistsPurchaseOrderIdQuery = "SELECT id FROM purchase_orders WHERE id = ?"
+)
+
+type OrderDetailsRepository interface {
+	Create(cleanLinessStatus string, quantity uint64, temperature float32, productRecordId uint64, purchaseOrderId uint64) (models.OrderDetails, error)
+	Get(id uint64) (models.OrderDetails, error)
+	GetAll(params query.Params) (query.Page[models.OrderDetails], error)
+	GetByPurchaseOrderId(purchaseOrderId uint64) ([]models.OrderDetails, error)
+	Update(updatedOrderDetails models.OrderDetails) (models.OrderDetails, error)
+	Delete(id uint64) error
+	ExistsProductRecordId(productRecordId uint64) bool
+	ExistsPurchaseOrderId(purchaseOrderId uint64) bool
+}
+
+var orderDetailsQuery = query.NewBuilder(
+	"order_details",
+	"id, clean_liness_status, quantity, temperature, product_record_id, purchase_order_id",
+	map[string]string{
+		"id":                  "id",
+		"clean_liness_status": "clean_liness_status",
+		"quantity":            "quantity",
+		"temperature":         "temperature",
+		"product_record_id":   "product_record_id",
+		"purchase_order_id":   "purchase_order_id",
+	},
+)
+
+type orderDetailsRepository struct {
+	db models.Querier
+}
+
+func NewOrderDetailsRepository(db models.Querier) OrderDetailsRepository {
+	return &orderDetailsRepository{
+		db: db,
+	}
+}
+
+func (r *orderDetailsRepository) Create(
+	cleanLinessStatus string, quantity uint64, temperature float32, productRecordId uint64, purchaseOrderId uint64,
+) (models.OrderDetails, error) {
+
+	stmt, err := r.db.Prepare(CreateQuery)
+	if err != nil {
+		return models.OrderDetails{}, err
+	}
+
+	defer stmt.Close()
+	var result sql.Result
+	result, err = stmt.Exec(
+		cleanLinessStatus,
+		quantity,
+		temperature,
+		productRecordId,
+		purchaseOrderId,
+	)
+
+	if err != nil {
+		return models.OrderDetails{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	orderDetails := models.OrderDetails{
+		Id:                uint64(insertedId),
+		CleanLinessStatus: cleanLinessStatus,
+		Quantity:          quantity,
+		Temperature:       temperature,
+		ProductRecordId:   productRecordId,
+		PurchaseOrderId:   purchaseOrderId,
+	}
+
+	return orderDetails, nil
+}
+
+func (r *orderDetailsRepository) Get(id uint64) (models.OrderDetails, error) {
+
+	var orderDetails models.OrderDetails
+	err := r.db.QueryRow(GetQuery, id).Scan(
+		&orderDetails.Id,
+		&orderDetails.CleanLinessStatus,
+		&orderDetails.Quantity,
+		&orderDetails.Temperature,
+		&orderDetails.ProductRecordId,
+		&orderDetails.PurchaseOrderId,
+	)
+
+	if err != nil {
+		log.Println(err)
+		return models.OrderDetails{}, err
+	}
+
+	return orderDetails, nil
+}
+
+func (r *orderDetailsRepository) GetAll(params query.Params) (query.Page[models.OrderDetails], error) {
+	statement, args, err := orderDetailsQuery.Build(params)
+	if err != nil {
+		return query.Page[models.OrderDetails]{}, err
+	}
+
+	orderDetails, err := r.query(statement, args...)
+	if err != nil {
+		return query.Page[models.OrderDetails]{}, err
+	}
+
+	return query.NewPage(orderDetails, params)
+}
+
+func (r *orderDetailsRepository) GetByPurchaseOrderId(purchaseOrderId uint64) ([]models.OrderDetails, error) {
+	return r.query(GetByPurchaseOrderIdQuery, purchaseOrderId)
+}
+
+func (r *orderDetailsRepository) Update(updatedOrderDetails models.OrderDetails) (models.OrderDetails, error) {
+
+	stmt, err := r.db.Prepare(UpdateQuery)
+	if err != nil {
+		return models.OrderDetails{}, err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		updatedOrderDetails.CleanLinessStatus,
+		updatedOrderDetails.Quantity,
+		updatedOrderDetails.Temperature,
+		updatedOrderDetails.ProductRecordId,
+		updatedOrderDetails.PurchaseOrderId,
+		updatedOrderDetails.Id,
+	)
+
+	if err != nil {
+		return models.OrderDetails{}, err
+	}
+
+	return updatedOrderDetails, nil
+}
+
+func (r *orderDetailsRepository) Delete(id uint64) error {
+
+	stmt, err := r.db.Prepare(DeleteQuery)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	if _, err = stmt.Exec(id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *orderDetailsRepository) ExistsProductRecordId(productRecordId uint64) bool {
+	var id uint64
+	err := r.db.QueryRow(ExistsProductRecordIdQuery, productRecordId).Scan(&id)
+	return err == nil
+}
+
+func (r *orderDetailsRepository) ExistsPurchaseOrderId(purchaseOrderId uint64) bool {
+	var id uint64
+	err := r.db.QueryRow(ExistsPurchaseOrderIdQuery, purchaseOrderId).Scan(&id)
+	return err == nil
+}
+
+func (r *orderDetailsRepository) query(query string, args ...any) ([]models.OrderDetails, error) {
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var orderDetails []models.OrderDetails
+	for rows.Next() {
+
+		var orderDetail models.OrderDetails
+
+		err := rows.Scan(
+			&orderDetail.Id,
+			&orderDetail.CleanLinessStatus,
+			&orderDetail.Quantity,
+			&orderDetail.Temperature,
+			&orderDetail.ProductRecordId,
+			&orderDetail.PurchaseOrderId,
+		)
+
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+
+		orderDetails = append(orderDetails, orderDetail)
+	}
+
+	return orderDetails, nil
+}
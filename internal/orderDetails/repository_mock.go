@@ -0,0 +1,63 @@
+package orderdetails
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type MockOrderDetailsRepository struct {
+	Result              any
+	Err                 error
+	GetById             db.OrderDetails
+	ExistsProductRecord bool
+	ExistsPurchaseOrder bool
+}
+
+func (m MockOrderDetailsRepository) Create(
+	cleanLinessStatus string, quantity uint64, temperature float32, productRecordId uint64, purchaseOrderId uint64,
+) (db.OrderDetails, error) {
+	if m.Err != nil {
+		return db.OrderDetails{}, m.Err
+	}
+	return m.Result.(db.OrderDetails), nil
+}
+
+func (m MockOrderDetailsRepository) Get(id uint64) (db.OrderDetails, error) {
+	if (m.GetById == db.OrderDetails{} && m.Err != nil) {
+		return db.OrderDetails{}, m.Err
+	}
+	return m.GetById, nil
+}
+
+func (m MockOrderDetailsRepository) GetAll(params query.Params) (query.Page[db.OrderDetails], error) {
+	if m.Err != nil {
+		return query.Page[db.OrderDetails]{}, m.Err
+	}
+	return query.NewPage(m.Result.([]db.OrderDetails), params)
+}
+
+func (m MockOrderDetailsRepository) GetByPurchaseOrderId(purchaseOrderId uint64) ([]db.OrderDetails, error) {
+	if m.Err != nil {
+		return []db.OrderDetails{}, m.Err
+	}
+	return m.Result.([]db.OrderDetails), nil
+}
+
+func (m MockOrderDetailsRepository) Update(updatedOrderDetails db.OrderDetails) (db.OrderDetails, error) {
+	if m.Err != nil {
+		return db.OrderDetails{}, m.Err
+	}
+	return updatedOrderDetails, nil
+}
+
+func (m MockOrderDetailsRepository) Delete(id uint64) error {
+	return m.Err
+}
+
+func (m MockOrderDetailsRepository) ExistsProductRecordId(productRecordId uint64) bool {
+	return m.ExistsProductRecord
+}
+
+func (m MockOrderDetailsRepository) ExistsPurchaseOrderId(purchaseOrderId uint64) bool {
+	return m.ExistsPurchaseOrder
+}
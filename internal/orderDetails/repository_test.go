@@ -0,0 +1,239 @@
+package orderdetails
+
+import (
+	"testing"
+
+	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Create_Ok(t *testing.T) {
+
+	expectedOrderDetails := models.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Aprovado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   1,
+		PurchaseOrderId:   1,
+	}
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+	_, err := repository.Create("Aprovado", 10, 12.5, 1, 1)
+	assert.Nil(t, err)
+
+	foundOrderDetails, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOrderDetails, foundOrderDetails)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Create_ConnectionError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+
+	database.Close()
+	_, err := repository.Create("", 0, 0, 0, 0)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Get_NotFound(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+
+	foundOrderDetails, err := repository.Get(1)
+	assert.NotNil(t, err)
+	assert.Empty(t, foundOrderDetails)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+	repository.Create("Aprovado", 10, 12.5, 1, 1)
+	repository.Create("Rejeitado", 20, 10.5, 2, 2)
+
+	foundOrderDetails, err := repository.GetAll(query.Params{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(foundOrderDetails.Items))
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_ShouldFilterAndPaginate(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+	repository.Create("Aprovado", 10, 12.5, 1, 1)
+	repository.Create("Rejeitado", 20, 10.5, 2, 2)
+	repository.Create("Aprovado", 30, 11.5, 1, 2)
+
+	firstPage, err := repository.GetAll(query.Params{Limit: 1, Filters: map[string]string{"purchase_order_id": "2"}})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), firstPage.Items[0].Id)
+	assert.NotEmpty(t, firstPage.Meta.NextCursor)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetByPurchaseOrderId_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+	repository.Create("Aprovado", 10, 12.5, 1, 1)
+	repository.Create("Rejeitado", 20, 10.5, 2, 2)
+	repository.Create("Aprovado", 30, 11.5, 1, 2)
+
+	foundOrderDetails, err := repository.GetByPurchaseOrderId(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(foundOrderDetails))
+	assert.Equal(t, uint64(2), foundOrderDetails[0].PurchaseOrderId)
+	assert.Equal(t, uint64(2), foundOrderDetails[1].PurchaseOrderId)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_ConnectionError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+
+	database.Close()
+	_, err := repository.GetAll(query.Params{})
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Update_Ok(t *testing.T) {
+
+	expectedOrderDetails := models.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Rejeitado",
+		Quantity:          15,
+		Temperature:       9.5,
+		ProductRecordId:   2,
+		PurchaseOrderId:   1,
+	}
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+	repository.Create("Aprovado", 10, 12.5, 1, 1)
+
+	_, err := repository.Update(expectedOrderDetails)
+	assert.Nil(t, err)
+
+	foundOrderDetails, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOrderDetails, foundOrderDetails)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Delete_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+
+	repository := NewOrderDetailsRepository(database)
+	repository.Create("Aprovado", 10, 12.5, 1, 1)
+
+	err := repository.Delete(1)
+	assert.Nil(t, err)
+
+	_, err = repository.Get(1)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_ExistsReferences(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+	util.QueryExec(database, CREATE_PURCHASE_ORDERS_TABLE)
+	util.QueryExec(database, INSERT_PRODUCT_RECORD)
+	util.QueryExec(database, INSERT_PURCHASE_ORDER)
+
+	repository := NewOrderDetailsRepository(database)
+
+	assert.True(t, repository.ExistsProductRecordId(1))
+	assert.False(t, repository.ExistsProductRecordId(2))
+	assert.True(t, repository.ExistsPurchaseOrderId(1))
+	assert.False(t, repository.ExistsPurchaseOrderId(2))
+
+	util.DropDB(database)
+}
+
+const CREATE_ORDER_DETAILS_TABLE = `
+	CREATE TABLE "order_details"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		clean_liness_status TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		temperature DECIMAL(19, 2) NOT NULL,
+		product_record_id BIGINT NOT NULL,
+		purchase_order_id BIGINT NOT NULL,
+		FOREIGN KEY (product_record_id) REFERENCES product_records(id),
+		FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id)
+	);
+`
+
+const CREATE_PRODUCT_RECORDS_TABLE = `
+	CREATE TABLE "product_records"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		last_update_date TEXT NOT NULL,
+		purchase_price DECIMAL(19, 2) NOT NULL,
+		sale_price DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PURCHASE_ORDERS_TABLE = `
+	CREATE TABLE "purchase_orders"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		order_number TEXT NOT NULL,
+		order_date TEXT NOT NULL,
+		tracking_code TEXT NOT NULL,
+		buyer_id BIGINT NOT NULL,
+		order_status_id BIGINT NOT NULL,
+		product_record_id BIGINT NOT NULL
+	);
+`
+
+const INSERT_PRODUCT_RECORD = `
+	INSERT INTO product_records(last_update_date, purchase_price, sale_price, product_id)
+	VALUES ("2022-07-04 09:16:12", 10.5, 15.5, 1);
+`
+
+const INSERT_PURCHASE_ORDER = `
+	INSERT INTO purchase_orders(order_number, order_date, tracking_code, buyer_id, order_status_id, product_record_id)
+	VALUES ("1234", "2021-02-27 18:11:32", "ABCD", 1, 1, 1);
+`
@@ -0,0 +1,121 @@
+package orderdetails
+
+import (
+	"errors"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/imdario/mergo"
+)
+
+var (
+	OrderDetailsNotFoundError  = errors.New("order details not found")
+	ProductRecordNotFoundError = errors.New("product record not found")
+	PurchaseOrderNotFoundError = errors.New("purchase order not found")
+)
+
+type OrderDetailsService interface {
+	Create(cleanLinessStatus string, quantity uint64, temperature float32, productRecordId uint64, purchaseOrderId uint64) (db.OrderDetails, error)
+	Get(id uint64) (db.OrderDetails, error)
+	GetAll(params query.Params) (query.Page[db.OrderDetails], error)
+	GetByPurchaseOrderId(purchaseOrderId uint64) ([]db.OrderDetails, error)
+	Delete(id uint64) error
+
+	Update(id uint64, cleanLinessStatus string, quantity uint64, temperature float32,
+		productRecordId uint64, purchaseOrderId uint64) (db.OrderDetails, error)
+}
+
+type orderDetailsService struct {
+	orderDetailsRepository OrderDetailsRepository
+}
+
+func NewOrderDetailsService(r OrderDetailsRepository) OrderDetailsService {
+	return &orderDetailsService{
+		orderDetailsRepository: r,
+	}
+}
+
+func (s *orderDetailsService) Create(
+	cleanLinessStatus string, quantity uint64, temperature float32, productRecordId uint64, purchaseOrderId uint64,
+) (db.OrderDetails, error) {
+
+	if err := s.validateReferences(productRecordId, purchaseOrderId); err != nil {
+		return db.OrderDetails{}, err
+	}
+
+	return s.orderDetailsRepository.Create(cleanLinessStatus, quantity, temperature, productRecordId, purchaseOrderId)
+}
+
+func (s *orderDetailsService) Get(id uint64) (db.OrderDetails, error) {
+	orderDetails, err := s.orderDetailsRepository.Get(id)
+	if err != nil {
+		return db.OrderDetails{}, OrderDetailsNotFoundError
+	}
+
+	return orderDetails, nil
+}
+
+func (s *orderDetailsService) GetAll(params query.Params) (query.Page[db.OrderDetails], error) {
+	return s.orderDetailsRepository.GetAll(params)
+}
+
+func (s *orderDetailsService) GetByPurchaseOrderId(purchaseOrderId uint64) ([]db.OrderDetails, error) {
+	if !s.orderDetailsRepository.ExistsPurchaseOrderId(purchaseOrderId) {
+		return []db.OrderDetails{}, PurchaseOrderNotFoundError
+	}
+
+	return s.orderDetailsRepository.GetByPurchaseOrderId(purchaseOrderId)
+}
+
+func (s *orderDetailsService) Update(
+	id uint64, cleanLinessStatus string, quantity uint64, temperature float32,
+	productRecordId uint64, purchaseOrderId uint64,
+) (db.OrderDetails, error) {
+
+	foundOrderDetails, err := s.Get(id)
+	if err != nil {
+		return db.OrderDetails{}, err
+	}
+
+	updatedOrderDetails := db.OrderDetails{
+		Id:                id,
+		CleanLinessStatus: cleanLinessStatus,
+		Quantity:          quantity,
+		Temperature:       temperature,
+		ProductRecordId:   productRecordId,
+		PurchaseOrderId:   purchaseOrderId,
+	}
+
+	err = mergo.Merge(&foundOrderDetails, updatedOrderDetails, mergo.WithOverride)
+	if err != nil {
+		return db.OrderDetails{}, err
+	}
+
+	err = s.validateReferences(foundOrderDetails.ProductRecordId, foundOrderDetails.PurchaseOrderId)
+	if err != nil {
+		return db.OrderDetails{}, err
+	}
+
+	return s.orderDetailsRepository.Update(foundOrderDetails)
+}
+
+func (s *orderDetailsService) Delete(id uint64) error {
+	_, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	return s.orderDetailsRepository.Delete(id)
+}
+
+func (s *orderDetailsService) validateReferences(productRecordId uint64, purchaseOrderId uint64) error {
+	if !s.orderDetailsRepository.ExistsProductRecordId(productRecordId) {
+		return ProductRecordNotFoundError
+	}
+
+	if !s.orderDetailsRepository.ExistsPurchaseOrderId(purchaseOrderId) {
+		return PurchaseOrderNotFoundError
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package orderdetails
+
+import (
+	"errors"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Create_Ok(t *testing.T) {
+
+	expectedResult := db.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Aprovado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   1,
+		PurchaseOrderId:   1,
+	}
+
+	mockRepository := MockOrderDetailsRepository{
+		Result:              expectedResult,
+		ExistsProductRecord: true,
+		ExistsPurchaseOrder: true,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Create("Aprovado", 10, 12.5, 1, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func Test_Create_ProductRecordNotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		ExistsProductRecord: false,
+		ExistsPurchaseOrder: true,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Create("Aprovado", 10, 12.5, 1, 1)
+
+	assert.Empty(t, result)
+	assert.Equal(t, ProductRecordNotFoundError, err)
+}
+
+func Test_Create_PurchaseOrderNotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		ExistsProductRecord: true,
+		ExistsPurchaseOrder: false,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Create("Aprovado", 10, 12.5, 1, 1)
+
+	assert.Empty(t, result)
+	assert.Equal(t, PurchaseOrderNotFoundError, err)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		Err: errors.New("sql: no rows in result set"),
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Get(1)
+
+	assert.Empty(t, result)
+	assert.Equal(t, OrderDetailsNotFoundError, err)
+}
+
+func Test_GetByPurchaseOrderId_Ok(t *testing.T) {
+
+	expectedResult := []db.OrderDetails{
+		{Id: 1, CleanLinessStatus: "Aprovado", Quantity: 10, ProductRecordId: 1, PurchaseOrderId: 2},
+		{Id: 2, CleanLinessStatus: "Rejeitado", Quantity: 20, ProductRecordId: 2, PurchaseOrderId: 2},
+	}
+
+	mockRepository := MockOrderDetailsRepository{
+		Result:              expectedResult,
+		ExistsPurchaseOrder: true,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.GetByPurchaseOrderId(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func Test_GetByPurchaseOrderId_PurchaseOrderNotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		ExistsPurchaseOrder: false,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.GetByPurchaseOrderId(2)
+
+	assert.Empty(t, result)
+	assert.Equal(t, PurchaseOrderNotFoundError, err)
+}
+
+func Test_Update_Ok(t *testing.T) {
+
+	foundOrderDetails := db.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Aprovado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   1,
+		PurchaseOrderId:   1,
+	}
+
+	expectedResult := db.OrderDetails{
+		Id:                1,
+		CleanLinessStatus: "Rejeitado",
+		Quantity:          10,
+		Temperature:       12.5,
+		ProductRecordId:   1,
+		PurchaseOrderId:   1,
+	}
+
+	mockRepository := MockOrderDetailsRepository{
+		GetById:             foundOrderDetails,
+		ExistsProductRecord: true,
+		ExistsPurchaseOrder: true,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Update(1, "Rejeitado", 0, 0, 0, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func Test_Update_NotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		Err: errors.New("sql: no rows in result set"),
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Update(1, "Rejeitado", 0, 0, 0, 0)
+
+	assert.Empty(t, result)
+	assert.Equal(t, OrderDetailsNotFoundError, err)
+}
+
+func Test_Update_ProductRecordNotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		GetById:             db.OrderDetails{Id: 1, ProductRecordId: 1, PurchaseOrderId: 1},
+		ExistsProductRecord: false,
+		ExistsPurchaseOrder: true,
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	result, err := service.Update(1, "", 0, 0, 99, 0)
+
+	assert.Empty(t, result)
+	assert.Equal(t, ProductRecordNotFoundError, err)
+}
+
+func Test_Delete_Ok(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		GetById: db.OrderDetails{Id: 1},
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	err := service.Delete(1)
+
+	assert.Nil(t, err)
+}
+
+func Test_Delete_NotFound(t *testing.T) {
+
+	mockRepository := MockOrderDetailsRepository{
+		Err: errors.New("sql: no rows in result set"),
+	}
+
+	service := NewOrderDetailsService(mockRepository)
+	err := service.Delete(1)
+
+	assert.Equal(t, OrderDetailsNotFoundError, err)
+}
@@ -0,0 +1,72 @@
+package picking
+
+import (
+	"strings"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type PickingRepository interface {
+	// FindStockedBatches lists the batches of the product that have stock
+	// and are due after dueAfter, first expired first. Recalled batch
+	// numbers are left out. A zero warehouseId looks in every warehouse.
+	FindStockedBatches(productId uint64, warehouseId uint64, dueAfter string) ([]db.StockedBatch, error)
+}
+
+type pickingRepository struct {
+	db db.Querier
+}
+
+func NewPickingRepository(db db.Querier) PickingRepository {
+	return &pickingRepository{
+		db: db,
+	}
+}
+
+func (r *pickingRepository) FindStockedBatches(productId uint64, warehouseId uint64, dueAfter string) ([]db.StockedBatch, error) {
+	conditions := []string{
+		"pb.product_id = ?",
+		"pb.current_quantity > 0",
+		"pb.due_date > ?",
+		"NOT EXISTS (SELECT 1 FROM batch_recalls br WHERE br.batch_number = pb.batch_number)",
+	}
+	args := []any{productId, dueAfter}
+
+	if warehouseId != 0 {
+		conditions = append(conditions, "sc.warehouse_id = ?")
+		args = append(args, warehouseId)
+	}
+
+	rows, err := r.db.Query(`
+		SELECT pb.id, pb.batch_number, pb.due_date, pb.current_quantity, pb.section_id, sc.warehouse_id
+		FROM product_batches pb JOIN sections sc ON sc.id = pb.section_id
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY pb.due_date, pb.id`, args...,
+	)
+	if err != nil {
+		return []db.StockedBatch{}, err
+	}
+	defer rows.Close()
+
+	var batches []db.StockedBatch
+
+	for rows.Next() {
+		var batch db.StockedBatch
+
+		err := rows.Scan(
+			&batch.Id,
+			&batch.Number,
+			&batch.DueDate,
+			&batch.CurrentQuantity,
+			&batch.SectionId,
+			&batch.WarehouseId,
+		)
+		if err != nil {
+			return []db.StockedBatch{}, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, rows.Err()
+}
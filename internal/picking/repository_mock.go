@@ -0,0 +1,19 @@
+package picking
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type MockPickingRepository struct {
+	Result []db.StockedBatch
+	Err    error
+	// DueAfter receives the due date passed to FindStockedBatches.
+	DueAfter *string
+}
+
+func (m MockPickingRepository) FindStockedBatches(productId uint64, warehouseId uint64, dueAfter string) ([]db.StockedBatch, error) {
+	if m.DueAfter != nil {
+		*m.DueAfter = dueAfter
+	}
+	return m.Result, m.Err
+}
@@ -0,0 +1,178 @@
+package picking
+
+import (
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_FindStockedBatches_FirstExpiredFirst(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_TABLE)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+
+	repository := NewPickingRepository(database)
+	found, err := repository.FindStockedBatches(1, 0, "2022-08-01 00:00:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockedBatch{
+		{Id: 3, Number: 30, DueDate: "2022-08-10 10:00:00", CurrentQuantity: 5, SectionId: 2, WarehouseId: 2},
+		{Id: 2, Number: 20, DueDate: "2022-09-01 10:00:00", CurrentQuantity: 20, SectionId: 1, WarehouseId: 1},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindStockedBatches_ByWarehouse(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_TABLE)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+
+	repository := NewPickingRepository(database)
+	found, err := repository.FindStockedBatches(1, 1, "2022-08-01 00:00:00")
+
+	assert.Nil(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, uint64(2), found[0].Id)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindStockedBatches_ShouldSkipRecalledBatches(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_TABLE)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+	util.QueryExec(database, INSERT_BATCH_RECALLS)
+
+	repository := NewPickingRepository(database)
+	found, err := repository.FindStockedBatches(1, 0, "2022-08-01 00:00:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockedBatch{
+		{Id: 2, Number: 20, DueDate: "2022-09-01 10:00:00", CurrentQuantity: 20, SectionId: 1, WarehouseId: 1},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindStockedBatches_ConnectionError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_TABLE)
+
+	repository := NewPickingRepository(database)
+
+	database.Close()
+	found, err := repository.FindStockedBatches(1, 0, "2022-08-01 00:00:00")
+
+	assert.NotNil(t, err)
+	assert.Empty(t, found)
+
+	util.DropDB(database)
+}
+
+// Batch 1 is expired, batch 4 is empty and batch 5 is of another product.
+const INSERT_PRODUCT_BATCHES = `
+	INSERT INTO product_batches(batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+	VALUES (10, 10, 1, "2022-07-01 10:00:00", 10, "2022-01-01", "10:00:00", 1, 1, 1),
+		(20, 20, 1, "2022-09-01 10:00:00", 20, "2022-01-01", "10:00:00", 1, 1, 1),
+		(30, 5, 1, "2022-08-10 10:00:00", 5, "2022-01-01", "10:00:00", 1, 1, 2),
+		(40, 0, 1, "2022-08-05 10:00:00", 10, "2022-01-01", "10:00:00", 1, 1, 2),
+		(50, 50, 1, "2022-08-02 10:00:00", 50, "2022-01-01", "10:00:00", 1, 2, 1)
+`
+
+const INSERT_BATCH_RECALLS = `
+	INSERT INTO batch_recalls(product_batch_id, batch_number, reason, created_at)
+	VALUES (3, 30, "Listeria", "2022-08-01 10:00:00")
+`
+
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id)
+	VALUES (1, 80, 1, 100, 10, 1, 1, 1),
+		(2, 15, 1, 100, 10, 1, 1, 2)
+`
+
+const CREATE_PRODUCT_BATCHES_TABLE = `
+	CREATE TABLE "product_batches"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		batch_number BIGINT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		due_date TEXT NOT NULL,
+		initial_quantity BIGINT NOT NULL,
+		manufacturing_date TEXT NOT NULL,
+		manufacturing_hour TEXT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL,
+		FOREIGN KEY (product_id) REFERENCES products(id),
+		FOREIGN KEY (section_id) REFERENCES sections(id)
+	);
+`
+
+const CREATE_BATCH_RECALLS_TABLE = `
+	CREATE TABLE "batch_recalls"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		batch_number BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		FOREIGN KEY (product_type) REFERENCES products_types(id),
+		FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		expiration_rate DECIMAL(19, 2) NOT NULL,
+		freezing_rate DECIMAL(19, 2) NOT NULL,
+		height DECIMAL(19, 2) NOT NULL,
+		length DECIMAL(19, 2) NOT NULL,
+		net_weight DECIMAL(19, 2) NOT NULL,
+		product_code TEXT NOT NULL,
+		recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+		width DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT  NOT NULL,
+		seller_id BIGINT  NOT NULL,
+		FOREIGN KEY (product_type) REFERENCES products_types(id),
+		FOREIGN KEY (seller_id) REFERENCES sellers(id)
+	);
+`
+
+const INSERT_PRODUCTS = `
+	INSERT INTO products(description, expiration_rate, freezing_rate, height, length, net_weight, product_code, recommended_freezing_temperature, width, product_type, seller_id)
+	VALUES ("Banana", 1, 1, 1, 1, 1, "BAN", 1, 1, 1, 1)
+`
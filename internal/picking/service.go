@@ -0,0 +1,124 @@
+package picking
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+)
+
+var (
+	ProductNotFoundError   = errors.New("product not found")
+	InsufficientStockError = errors.New("not enough stock in unexpired batches")
+)
+
+type PickingService interface {
+	Pick(productId uint64, warehouseId uint64, quantity uint64) (db.PickList, error)
+}
+
+// Repositories are the transaction-bound repositories used by Pick.
+type Repositories struct {
+	Picking        PickingRepository
+	ProductBatches batches.ProductBatchRepository
+	Sections       sections.SectionRepository
+	Products       products.ProductRepository
+	Ledger         ledger.LedgerRepository
+}
+
+type pickingService struct {
+	unitOfWork uow.UnitOfWork[Repositories]
+	now        func() time.Time
+}
+
+func NewPickingService(unitOfWork uow.UnitOfWork[Repositories]) PickingService {
+	return &pickingService{
+		unitOfWork: unitOfWork,
+		now:        time.Now,
+	}
+}
+
+// Pick takes quantity of the product out of stock, first expired first out.
+// Expired and recalled batches are skipped. The stock of each batch and the
+// capacity in use of its section are decreased together, and nothing is
+// taken when the remaining stock does not cover the whole quantity.
+func (s *pickingService) Pick(productId uint64, warehouseId uint64, quantity uint64) (db.PickList, error) {
+	pickList := db.PickList{ProductId: productId, Quantity: quantity, Picks: []db.Pick{}}
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		product, err := r.Products.Get(productId)
+		if err != nil {
+			return err
+		}
+
+		if (product == db.Product{}) {
+			return ProductNotFoundError
+		}
+
+		now := s.now().UTC()
+		stocked, err := r.Picking.FindStockedBatches(productId, warehouseId, now.Format(batches.DueDateLayout))
+		if err != nil {
+			return err
+		}
+
+		remaining := quantity
+		for _, batch := range stocked {
+			if remaining == 0 {
+				break
+			}
+
+			picked := batch.CurrentQuantity
+			if picked > remaining {
+				picked = remaining
+			}
+
+			if err := r.ProductBatches.DecreaseCurrentQuantity(batch.Id, picked); err != nil {
+				return err
+			}
+
+			if err := r.Sections.DecreaseCurrentCapacity(batch.SectionId, picked); err != nil {
+				return err
+			}
+
+			_, err := r.Ledger.Record(db.InventoryMovement{
+				ProductBatchId: batch.Id,
+				ProductId:      productId,
+				SectionId:      batch.SectionId,
+				WarehouseId:    batch.WarehouseId,
+				Type:           ledger.PickMovement,
+				Quantity:       -int64(picked),
+				CreatedAt:      now.Format(ledger.TimeLayout),
+			})
+			if err != nil {
+				return err
+			}
+
+			pickList.Picks = append(pickList.Picks, db.Pick{
+				ProductBatchId: batch.Id,
+				BatchNumber:    batch.Number,
+				DueDate:        batch.DueDate,
+				SectionId:      batch.SectionId,
+				WarehouseId:    batch.WarehouseId,
+				Quantity:       picked,
+			})
+
+			remaining -= picked
+		}
+
+		if remaining > 0 {
+			return InsufficientStockError
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return db.PickList{}, err
+	}
+
+	return pickList, nil
+}
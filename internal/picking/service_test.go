@@ -0,0 +1,182 @@
+package picking
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var stockedBatches = []db.StockedBatch{
+	{Id: 3, Number: 30, DueDate: "2022-08-10 10:00:00", CurrentQuantity: 5, SectionId: 2, WarehouseId: 2},
+	{Id: 2, Number: 20, DueDate: "2022-09-01 10:00:00", CurrentQuantity: 20, SectionId: 1, WarehouseId: 1},
+}
+
+func Test_Pick_Ok(t *testing.T) {
+
+	service := NewPickingService(mockUnitOfWork(MockPickingRepository{Result: stockedBatches}, batches.MockProductBatchesRepository{}))
+
+	pickList, err := service.Pick(1, 0, 12)
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.PickList{
+		ProductId: 1,
+		Quantity:  12,
+		Picks: []db.Pick{
+			{ProductBatchId: 3, BatchNumber: 30, DueDate: "2022-08-10 10:00:00", SectionId: 2, WarehouseId: 2, Quantity: 5},
+			{ProductBatchId: 2, BatchNumber: 20, DueDate: "2022-09-01 10:00:00", SectionId: 1, WarehouseId: 1, Quantity: 7},
+		},
+	}, pickList)
+}
+
+func Test_Pick_ShouldCompareDueDatesInUTC(t *testing.T) {
+
+	var dueAfter string
+	service := &pickingService{
+		unitOfWork: mockUnitOfWork(MockPickingRepository{Result: stockedBatches, DueAfter: &dueAfter}, batches.MockProductBatchesRepository{}),
+		now:        func() time.Time { return time.Date(2022, 8, 1, 9, 0, 0, 0, time.FixedZone("BRT", -3*60*60)) },
+	}
+
+	_, err := service.Pick(1, 0, 12)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-08-01 12:00:00", dueAfter)
+}
+
+func Test_Pick_ShouldReturnInsufficientStockError(t *testing.T) {
+
+	service := NewPickingService(mockUnitOfWork(MockPickingRepository{Result: stockedBatches}, batches.MockProductBatchesRepository{}))
+
+	pickList, err := service.Pick(1, 0, 26)
+
+	assert.Equal(t, InsufficientStockError, err)
+	assert.Equal(t, db.PickList{}, pickList)
+}
+
+func Test_Pick_ShouldReturnProductNotFoundError(t *testing.T) {
+
+	unitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{Products: products.MockProductRepository{}},
+	}
+	service := NewPickingService(unitOfWork)
+
+	_, err := service.Pick(1, 0, 1)
+
+	assert.Equal(t, ProductNotFoundError, err)
+}
+
+func Test_Pick_ShouldReturnBatchError(t *testing.T) {
+
+	unitOfWork := mockUnitOfWork(
+		MockPickingRepository{Result: stockedBatches},
+		batches.MockProductBatchesRepository{UpdateErr: batches.InsufficientQuantityError},
+	)
+	service := NewPickingService(unitOfWork)
+
+	_, err := service.Pick(1, 0, 1)
+
+	assert.Equal(t, batches.InsufficientQuantityError, err)
+}
+
+func Test_Pick_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := NewPickingService(mockUnitOfWork(MockPickingRepository{Err: expectedError}, batches.MockProductBatchesRepository{}))
+
+	_, err := service.Pick(1, 0, 1)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Pick_ShouldUpdateStockAndSkipExpiredBatches(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_TABLE)
+	util.QueryExec(database, CREATE_INVENTORY_MOVEMENTS_TABLE)
+	util.QueryExec(database, INSERT_PRODUCTS)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+
+	service := &pickingService{
+		unitOfWork: uow.New(database, func(q db.Querier) Repositories {
+			return Repositories{
+				Picking:        NewPickingRepository(q),
+				ProductBatches: batches.NewProductBatchRepository(q),
+				Sections:       sections.NewRepository(q),
+				Products:       products.NewProductRepository(q),
+				Ledger:         ledger.NewLedgerRepository(q),
+			}
+		}),
+		now: func() time.Time { return time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC) },
+	}
+
+	pickList, err := service.Pick(1, 0, 8)
+	assert.Nil(t, err)
+	assert.Len(t, pickList.Picks, 2)
+
+	batchRepository := batches.NewProductBatchRepository(database)
+	expired, _ := batchRepository.Get(1)
+	first, _ := batchRepository.Get(3)
+	second, _ := batchRepository.Get(2)
+	assert.Equal(t, uint64(10), expired.CurrentQuantity)
+	assert.Equal(t, uint64(0), first.CurrentQuantity)
+	assert.Equal(t, uint64(17), second.CurrentQuantity)
+
+	sectionRepository := sections.NewRepository(database)
+	firstSection, _ := sectionRepository.Get(1)
+	secondSection, _ := sectionRepository.Get(2)
+	assert.Equal(t, uint32(77), firstSection.CurrentCapacity)
+	assert.Equal(t, uint32(10), secondSection.CurrentCapacity)
+
+	movements, _ := ledger.NewLedgerRepository(database).GetMovements(query.Params{})
+	assert.Len(t, movements.Items, 2)
+	assert.Equal(t, int64(-5), movements.Items[0].Quantity)
+	assert.Equal(t, int64(-3), movements.Items[1].Quantity)
+	assert.Equal(t, "2022-08-01 00:00:00", movements.Items[1].CreatedAt)
+
+	_, err = service.Pick(1, 0, 100)
+	assert.Equal(t, InsufficientStockError, err)
+
+	second, _ = batchRepository.Get(2)
+	assert.Equal(t, uint64(17), second.CurrentQuantity)
+
+	util.DropDB(database)
+}
+
+func mockUnitOfWork(pickingRepository PickingRepository, batchRepository batches.ProductBatchRepository) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Picking:        pickingRepository,
+			ProductBatches: batchRepository,
+			Sections:       sections.MockSectionRepository{},
+			Products:       products.MockProductRepository{GetById: db.Product{Id: 1}},
+			Ledger:         ledger.MockLedgerRepository{},
+		},
+	}
+}
+
+const CREATE_INVENTORY_MOVEMENTS_TABLE = `
+	CREATE TABLE "inventory_movements"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		movement_type TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
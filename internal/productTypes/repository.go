@@ -0,0 +1,129 @@
+package productTypes
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+const (
+	FindOneQuery         = "SELECT id, description FROM products_types WHERE id = ?"
+	CreateQuery          = "INSERT INTO products_types(description) VALUES(?)"
+	UpdateQuery          = "UPDATE products_types SET description = ? WHERE id = ?"
+	DeleteQuery          = "DELETE FROM products_types WHERE id = ?"
+	CountReferencesQuery = `
+		SELECT
+			(SELECT COUNT(*) FROM products WHERE product_type = ?) +
+			(SELECT COUNT(*) FROM sections WHERE product_type = ?)
+	`
+)
+
+var productTypesQuery = query.NewBuilder(
+	"products_types",
+	"id, description",
+	map[string]string{
+		"id":          "id",
+		"description": "description",
+	},
+)
+
+type Repository interface {
+	FindAll(params query.Params) (query.Page[database.ProductType], error)
+	FindOne(id uint64) (database.ProductType, error)
+	Create(description string) (database.ProductType, error)
+	Update(productType database.ProductType) (database.ProductType, error)
+	Delete(id uint64) error
+	// CountReferences counts the products and sections of the type.
+	CountReferences(id uint64) (uint64, error)
+}
+
+type repository struct {
+	db database.Querier
+}
+
+func NewRepository(db database.Querier) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) FindAll(params query.Params) (query.Page[database.ProductType], error) {
+	var productTypes []database.ProductType
+
+	statement, args, err := productTypesQuery.Build(params)
+	if err != nil {
+		return query.Page[database.ProductType]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[database.ProductType]{}, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var productType database.ProductType
+		if err := rows.Scan(&productType.Id, &productType.Description); err != nil {
+			log.Println(err.Error())
+			return query.Page[database.ProductType]{}, err
+		}
+
+		productTypes = append(productTypes, productType)
+	}
+
+	return query.NewPage(productTypes, params)
+}
+
+func (r *repository) FindOne(id uint64) (database.ProductType, error) {
+	var productType database.ProductType
+
+	err := r.db.QueryRow(FindOneQuery, id).Scan(&productType.Id, &productType.Description)
+	if err != nil {
+		return database.ProductType{}, err
+	}
+
+	return productType, nil
+}
+
+func (r *repository) Create(description string) (database.ProductType, error) {
+	stmt, err := r.db.Prepare(CreateQuery)
+	if err != nil {
+		return database.ProductType{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+
+	result, err = stmt.Exec(description)
+	if err != nil {
+		return database.ProductType{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+
+	return database.ProductType{Id: uint64(insertedId), Description: description}, nil
+}
+
+func (r *repository) Update(productType database.ProductType) (database.ProductType, error) {
+	_, err := r.db.Exec(UpdateQuery, productType.Description, productType.Id)
+	if err != nil {
+		return database.ProductType{}, err
+	}
+
+	return productType, nil
+}
+
+func (r *repository) Delete(id uint64) error {
+	_, err := r.db.Exec(DeleteQuery, id)
+	return err
+}
+
+func (r *repository) CountReferences(id uint64) (uint64, error) {
+	var count uint64
+	err := r.db.QueryRow(CountReferencesQuery, id, id).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,56 @@
+package productTypes
+
+import (
+	"database/sql"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type mockProductTypeRepository struct {
+	result     []database.ProductType
+	err        error
+	getById    database.ProductType
+	references uint64
+	updated    *database.ProductType
+	deleted    *bool
+}
+
+func (m mockProductTypeRepository) FindAll(params query.Params) (query.Page[database.ProductType], error) {
+	if m.err != nil {
+		return query.Page[database.ProductType]{}, m.err
+	}
+	return query.NewPage(m.result, params)
+}
+
+func (m mockProductTypeRepository) FindOne(id uint64) (database.ProductType, error) {
+	if (m.getById == database.ProductType{}) {
+		return database.ProductType{}, sql.ErrNoRows
+	}
+	return m.getById, nil
+}
+
+func (m mockProductTypeRepository) Create(description string) (database.ProductType, error) {
+	if m.err != nil {
+		return database.ProductType{}, m.err
+	}
+	return database.ProductType{Id: 1, Description: description}, nil
+}
+
+func (m mockProductTypeRepository) Update(productType database.ProductType) (database.ProductType, error) {
+	if m.updated != nil {
+		*m.updated = productType
+	}
+	return productType, m.err
+}
+
+func (m mockProductTypeRepository) Delete(id uint64) error {
+	if m.deleted != nil {
+		*m.deleted = true
+	}
+	return m.err
+}
+
+func (m mockProductTypeRepository) CountReferences(id uint64) (uint64, error) {
+	return m.references, nil
+}
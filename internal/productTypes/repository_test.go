@@ -0,0 +1,155 @@
+package productTypes
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Create_Ok(t *testing.T) {
+
+	db := createProductTypesDB()
+	repository := NewRepository(db)
+
+	productType, err := repository.Create("Cozinha")
+	assert.Nil(t, err)
+
+	found, err := repository.FindOne(productType.Id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, database.ProductType{Id: 3, Description: "Cozinha"}, found)
+
+	util.DropDB(db)
+}
+
+func Test_Repo_FindOne_NotFound(t *testing.T) {
+
+	db := createProductTypesDB()
+
+	_, err := NewRepository(db).FindOne(99)
+
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	util.DropDB(db)
+}
+
+func Test_Repo_FindAll_Filtered(t *testing.T) {
+
+	db := createProductTypesDB()
+
+	page, err := NewRepository(db).FindAll(query.Params{Limit: 10, Filters: map[string]string{"description": "Eletrônico"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []database.ProductType{{Id: 2, Description: "Eletrônico"}}, page.Items)
+
+	util.DropDB(db)
+}
+
+func Test_Repo_Update_Ok(t *testing.T) {
+
+	db := createProductTypesDB()
+	repository := NewRepository(db)
+
+	_, err := repository.Update(database.ProductType{Id: 1, Description: "Vestuário"})
+	assert.Nil(t, err)
+
+	found, _ := repository.FindOne(1)
+	assert.Equal(t, "Vestuário", found.Description)
+
+	util.DropDB(db)
+}
+
+func Test_Repo_Delete_Ok(t *testing.T) {
+
+	db := createProductTypesDB()
+	repository := NewRepository(db)
+
+	err := repository.Delete(2)
+	assert.Nil(t, err)
+
+	_, err = repository.FindOne(2)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	util.DropDB(db)
+}
+
+func Test_Repo_CountReferences(t *testing.T) {
+
+	db := createProductTypesDB()
+	repository := NewRepository(db)
+
+	used, err := repository.CountReferences(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), used)
+
+	unused, _ := repository.CountReferences(2)
+	assert.Equal(t, uint64(0), unused)
+
+	util.DropDB(db)
+}
+
+func Test_Repo_ConnectionError(t *testing.T) {
+
+	db := createProductTypesDB()
+	repository := NewRepository(db)
+	db.Close()
+
+	_, err := repository.FindAll(query.Params{Limit: 10})
+	assert.NotNil(t, err)
+
+	_, err = repository.Create("Cozinha")
+	assert.NotNil(t, err)
+
+	util.DropDB(db)
+}
+
+func createProductTypesDB() *sql.DB {
+	db := util.CreateDB()
+	util.QueryExec(db, CREATE_PRODUCTS_TYPES_TABLE)
+	util.QueryExec(db, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(db, CREATE_SECTIONS_TABLE)
+	util.QueryExec(db, INSERT_PRODUCTS_TYPES)
+	util.QueryExec(db, INSERT_PRODUCTS)
+	util.QueryExec(db, INSERT_SECTIONS)
+	return db
+}
+
+const CREATE_PRODUCTS_TYPES_TABLE = `
+	CREATE TABLE "products_types" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_code TEXT NOT NULL,
+		product_type BIGINT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		product_type BIGINT NOT NULL
+	);
+`
+
+const INSERT_PRODUCTS_TYPES = `
+	INSERT INTO products_types(description) VALUES ("Roupa"), ("Eletrônico")
+`
+
+const INSERT_PRODUCTS = `
+	INSERT INTO products(product_code, product_type) VALUES ("P1", 1), ("P2", 1)
+`
+
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, product_type) VALUES (1, 1)
+`
@@ -0,0 +1,78 @@
+package productTypes
+
+import (
+	"errors"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/imdario/mergo"
+)
+
+var (
+	ProductTypeNotFoundError = errors.New("product type not found")
+	ProductTypeInUseError    = errors.New("product type has products or sections")
+)
+
+type Service interface {
+	FindAll(params query.Params) (query.Page[database.ProductType], error)
+	FindOne(id uint64) (database.ProductType, error)
+	Create(description string) (database.ProductType, error)
+	Update(id uint64, description string) (database.ProductType, error)
+	// Delete removes a product type without products or sections.
+	Delete(id uint64) error
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{
+		repo: r,
+	}
+}
+
+func (s service) FindAll(params query.Params) (query.Page[database.ProductType], error) {
+	return s.repo.FindAll(params)
+}
+
+func (s service) FindOne(id uint64) (database.ProductType, error) {
+	productType, err := s.repo.FindOne(id)
+	if err != nil {
+		return database.ProductType{}, ProductTypeNotFoundError
+	}
+
+	return productType, nil
+}
+
+func (s service) Create(description string) (database.ProductType, error) {
+	return s.repo.Create(description)
+}
+
+func (s service) Update(id uint64, description string) (database.ProductType, error) {
+	foundProductType, err := s.FindOne(id)
+	if err != nil {
+		return database.ProductType{}, err
+	}
+
+	mergo.Merge(&foundProductType, database.ProductType{Description: description}, mergo.WithOverride)
+
+	return s.repo.Update(foundProductType)
+}
+
+func (s service) Delete(id uint64) error {
+	if _, err := s.FindOne(id); err != nil {
+		return err
+	}
+
+	references, err := s.repo.CountReferences(id)
+	if err != nil {
+		return err
+	}
+
+	if references > 0 {
+		return ProductTypeInUseError
+	}
+
+	return s.repo.Delete(id)
+}
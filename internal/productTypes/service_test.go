@@ -0,0 +1,96 @@
+package productTypes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FindAll_OK(t *testing.T) {
+
+	expected := []database.ProductType{{Id: 1, Description: "Congelados"}, {Id: 2, Description: "Frescos"}}
+
+	page, err := NewService(mockProductTypeRepository{result: expected}).FindAll(query.Params{Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, page.Items)
+}
+
+func Test_FindOne_NotFound(t *testing.T) {
+
+	_, err := NewService(mockProductTypeRepository{}).FindOne(1)
+
+	assert.Equal(t, ProductTypeNotFoundError, err)
+}
+
+func Test_Create_OK(t *testing.T) {
+
+	productType, err := NewService(mockProductTypeRepository{}).Create("Congelados")
+
+	assert.Nil(t, err)
+	assert.Equal(t, database.ProductType{Id: 1, Description: "Congelados"}, productType)
+}
+
+func Test_Update_OK(t *testing.T) {
+
+	var updated database.ProductType
+	service := NewService(mockProductTypeRepository{getById: database.ProductType{Id: 2, Description: "Fresco"}, updated: &updated})
+
+	productType, err := service.Update(2, "Frescos")
+
+	assert.Nil(t, err)
+	assert.Equal(t, database.ProductType{Id: 2, Description: "Frescos"}, productType)
+	assert.Equal(t, productType, updated)
+}
+
+func Test_Update_ShouldKeepOmittedDescription(t *testing.T) {
+
+	productType, err := NewService(mockProductTypeRepository{getById: database.ProductType{Id: 2, Description: "Frescos"}}).Update(2, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Frescos", productType.Description)
+}
+
+func Test_Update_NotFound(t *testing.T) {
+
+	_, err := NewService(mockProductTypeRepository{}).Update(2, "Frescos")
+
+	assert.Equal(t, ProductTypeNotFoundError, err)
+}
+
+func Test_Delete_OK(t *testing.T) {
+
+	var deleted bool
+	err := NewService(mockProductTypeRepository{getById: database.ProductType{Id: 2}, deleted: &deleted}).Delete(2)
+
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func Test_Delete_ShouldRejectProductTypeInUse(t *testing.T) {
+
+	var deleted bool
+	err := NewService(mockProductTypeRepository{getById: database.ProductType{Id: 1}, references: 2, deleted: &deleted}).Delete(1)
+
+	assert.Equal(t, ProductTypeInUseError, err)
+	assert.False(t, deleted)
+}
+
+func Test_Delete_NotFound(t *testing.T) {
+
+	err := NewService(mockProductTypeRepository{}).Delete(1)
+
+	assert.Equal(t, ProductTypeNotFoundError, err)
+}
+
+func Test_Delete_Error(t *testing.T) {
+
+	expectedError := errors.New("connection refused")
+
+	err := NewService(mockProductTypeRepository{getById: database.ProductType{Id: 2}, err: expectedError}).Delete(2)
+
+	assert.Equal(t, expectedError, err)
+}
@@ -8,23 +8,30 @@ import (
 )
 
 type ProductRecordsRepository interface {
-	Create(lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64) (models.ProductRecord, error)
+	Create(lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64, promotion bool) (models.ProductRecord, error)
 	Get(id uint64) (models.ProductRecord, error)
 	GetAll() ([]models.ProductRecord, error)
+	// GetByProductId returns the records of a product, oldest first.
+	GetByProductId(productId uint64) ([]models.ProductRecord, error)
+	// GetLatestBefore returns the last record of a product updated before
+	// date, or ErrPriceNotFoundError.
+	GetLatestBefore(productId uint64, date string) (models.ProductRecord, error)
 }
 
+const productRecordColumns = "id, last_update_date, purchase_price, sale_price, product_id, promotion"
+
 type productRecordsRepository struct {
-	db *sql.DB
+	db models.Querier
 }
 
-func NewProductRecordsRepository(db *sql.DB) ProductRecordsRepository {
+func NewProductRecordsRepository(db models.Querier) ProductRecordsRepository {
 	return &productRecordsRepository{
 		db: db,
 	}
 }
 
 func (r *productRecordsRepository) Create(
-	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64,
+	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64, promotion bool,
 ) (models.ProductRecord, error) {
 
 	stmt, err := r.db.Prepare(`
@@ -32,8 +39,9 @@ func (r *productRecordsRepository) Create(
 			last_update_date, 
 			purchase_price, 
 			sale_price, 
-			product_id
-		) VALUES(?, ?, ?, ?)
+			product_id,
+			promotion
+		) VALUES(?, ?, ?, ?, ?)
 	`)
 
 	if err != nil {
@@ -47,6 +55,7 @@ func (r *productRecordsRepository) Create(
 		purchasePrice,
 		salePrice,
 		productId,
+		promotion,
 	)
 
 	if err != nil {
@@ -60,6 +69,7 @@ func (r *productRecordsRepository) Create(
 		PurchasePrice:  purchasePrice,
 		SalePrice:      salePrice,
 		ProductId:      productId,
+		Promotion:      promotion,
 	}
 
 	return product, nil
@@ -67,7 +77,7 @@ func (r *productRecordsRepository) Create(
 
 func (r *productRecordsRepository) Get(id uint64) (models.ProductRecord, error) {
 	var productRecords models.ProductRecord
-	rows, err := r.db.Query("SELECT * FROM product_records WHERE id = ?", id)
+	rows, err := r.db.Query("SELECT "+productRecordColumns+" FROM product_records WHERE id = ?", id)
 
 	if err != nil {
 		log.Println(err)
@@ -82,6 +92,7 @@ func (r *productRecordsRepository) Get(id uint64) (models.ProductRecord, error)
 			&productRecords.PurchasePrice,
 			&productRecords.SalePrice,
 			&productRecords.ProductId,
+			&productRecords.Promotion,
 		)
 		if err != nil {
 			log.Println(err.Error())
@@ -94,7 +105,7 @@ func (r *productRecordsRepository) Get(id uint64) (models.ProductRecord, error)
 
 func (r *productRecordsRepository) GetAll() ([]models.ProductRecord, error) {
 	var productRecords []models.ProductRecord
-	rows, err := r.db.Query("SELECT * FROM product_records")
+	rows, err := r.db.Query("SELECT " + productRecordColumns + " FROM product_records")
 
 	if err != nil {
 		log.Println(err)
@@ -110,6 +121,7 @@ func (r *productRecordsRepository) GetAll() ([]models.ProductRecord, error) {
 			&productRec.PurchasePrice,
 			&productRec.SalePrice,
 			&productRec.ProductId,
+			&productRec.Promotion,
 		)
 		if err != nil {
 			log.Println(err.Error())
@@ -121,3 +133,55 @@ func (r *productRecordsRepository) GetAll() ([]models.ProductRecord, error) {
 
 	return productRecords, nil
 }
+
+func (r *productRecordsRepository) GetByProductId(productId uint64) ([]models.ProductRecord, error) {
+	rows, err := r.db.Query(`
+		SELECT `+productRecordColumns+`
+		FROM product_records
+		WHERE product_id = ?
+		ORDER BY last_update_date, id`, productId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	productRecords := []models.ProductRecord{}
+	for rows.Next() {
+		var productRecord models.ProductRecord
+		if err := rows.Scan(
+			&productRecord.Id, &productRecord.LastUpdateDate, &productRecord.PurchasePrice,
+			&productRecord.SalePrice, &productRecord.ProductId, &productRecord.Promotion,
+		); err != nil {
+			return nil, err
+		}
+		productRecords = append(productRecords, productRecord)
+	}
+
+	return productRecords, rows.Err()
+}
+
+func (r *productRecordsRepository) GetLatestBefore(productId uint64, date string) (models.ProductRecord, error) {
+	var productRecord models.ProductRecord
+
+	row := r.db.QueryRow(`
+		SELECT `+productRecordColumns+`
+		FROM product_records
+		WHERE product_id = ? AND last_update_date < ?
+		ORDER BY last_update_date DESC, id DESC
+		LIMIT 1`, productId, date,
+	)
+
+	err := row.Scan(
+		&productRecord.Id, &productRecord.LastUpdateDate, &productRecord.PurchasePrice,
+		&productRecord.SalePrice, &productRecord.ProductId, &productRecord.Promotion,
+	)
+	if err == sql.ErrNoRows {
+		return models.ProductRecord{}, ErrPriceNotFoundError
+	}
+	if err != nil {
+		return models.ProductRecord{}, err
+	}
+
+	return productRecord, nil
+}
@@ -0,0 +1,48 @@
+package productrecords
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type MockProductRecordsRepository struct {
+	Result  []db.ProductRecord
+	Latest  db.ProductRecord
+	Err     error
+	Created *db.ProductRecord
+	Before  *string
+}
+
+func (m MockProductRecordsRepository) Create(
+	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64, promotion bool,
+) (db.ProductRecord, error) {
+	if m.Err != nil {
+		return db.ProductRecord{}, m.Err
+	}
+
+	record := db.ProductRecord{
+		Id: 1, LastUpdateDate: lastUpdateDate, PurchasePrice: purchasePrice, SalePrice: salePrice, ProductId: productId, Promotion: promotion,
+	}
+	if m.Created != nil {
+		*m.Created = record
+	}
+	return record, nil
+}
+
+func (m MockProductRecordsRepository) Get(id uint64) (db.ProductRecord, error) {
+	return m.Latest, m.Err
+}
+
+func (m MockProductRecordsRepository) GetAll() ([]db.ProductRecord, error) {
+	return m.Result, m.Err
+}
+
+func (m MockProductRecordsRepository) GetByProductId(productId uint64) ([]db.ProductRecord, error) {
+	return m.Result, m.Err
+}
+
+func (m MockProductRecordsRepository) GetLatestBefore(productId uint64, date string) (db.ProductRecord, error) {
+	if m.Before != nil {
+		*m.Before = date
+	}
+	return m.Latest, m.Err
+}
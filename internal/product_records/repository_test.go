@@ -23,7 +23,7 @@ func Test_Create_Ok(t *testing.T) {
 	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
 
 	repository := NewProductRecordsRepository(database)
-	_, err := repository.Create("2021-04-04", 10, 15, 1)
+	_, err := repository.Create("2021-04-04", 10, 15, 1, false)
 	assert.Nil(t, err)
 
 	productRecordFound, err := repository.Get(1)
@@ -40,7 +40,7 @@ func Test_Create_ConnectionError(t *testing.T) {
 	repository := NewProductRecordsRepository(database)
 
 	database.Close()
-	_, err := repository.Create("", 0, 0, 0)
+	_, err := repository.Create("", 0, 0, 0, false)
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -55,10 +55,10 @@ func Test_Repo_GetAll_OK(t *testing.T) {
 
 	expectedCountRows := 2
 
-	_, err := repository.Create("", 0, 0, 0)
+	_, err := repository.Create("", 0, 0, 0, false)
 	assert.Nil(t, err)
 
-	_, err = repository.Create("", 0, 0, 0)
+	_, err = repository.Create("", 0, 0, 0, false)
 	assert.Nil(t, err)
 
 	foundProducts, _ := repository.GetAll()
@@ -81,6 +81,73 @@ func Test_Repo_GetAll_ConnectionError(t *testing.T) {
 	util.DropDB(database)
 }
 
+func Test_Repo_GetByProductId_ShouldOrderByLastUpdateDate(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+
+	repository := NewProductRecordsRepository(database)
+	repository.Create("2022-07-04 09:00:00", 10, 15, 1, false)
+	repository.Create("2022-06-14 10:00:00", 10, 12, 1, false)
+	repository.Create("2022-06-20 10:00:00", 10, 8, 2, true)
+
+	productRecords, err := repository.GetByProductId(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []models.ProductRecord{
+		{Id: 2, LastUpdateDate: "2022-06-14 10:00:00", PurchasePrice: 10, SalePrice: 12, ProductId: 1},
+		{Id: 1, LastUpdateDate: "2022-07-04 09:00:00", PurchasePrice: 10, SalePrice: 15, ProductId: 1},
+	}, productRecords)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetByProductId_Empty(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+
+	productRecords, err := NewProductRecordsRepository(database).GetByProductId(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []models.ProductRecord{}, productRecords)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetLatestBefore(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+
+	repository := NewProductRecordsRepository(database)
+	repository.Create("2022-06-14 10:00:00", 10, 12, 1, false)
+	repository.Create("2022-07-04 09:00:00", 10, 8, 1, true)
+	repository.Create("2022-07-05", 10, 20, 1, false)
+
+	productRecord, err := repository.GetLatestBefore(1, "2022-07-05")
+
+	assert.Nil(t, err)
+	assert.Equal(t, models.ProductRecord{Id: 2, LastUpdateDate: "2022-07-04 09:00:00", PurchasePrice: 10, SalePrice: 8, ProductId: 1, Promotion: true}, productRecord)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetLatestBefore_NotFound(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+
+	repository := NewProductRecordsRepository(database)
+	repository.Create("2022-06-14 10:00:00", 10, 12, 1, false)
+
+	_, err := repository.GetLatestBefore(1, "2022-06-14")
+
+	assert.Equal(t, ErrPriceNotFoundError, err)
+
+	util.DropDB(database)
+}
+
 const CREATE_PRODUCT_RECORDS_TABLE = `
 	CREATE TABLE "product_records"(
 		id INTEGER PRIMARY KEY AUTOINCREMENT ,
@@ -88,6 +155,7 @@ const CREATE_PRODUCT_RECORDS_TABLE = `
 		purchase_price TEXT NOT NULL,
 		sale_price BIGINT  NOT NULL,
 		product_id BIGINT NOT NULL,
+		promotion BOOLEAN NOT NULL DEFAULT 0,
 		FOREIGN KEY (product_id) REFERENCES products(id)
 	);
 	`
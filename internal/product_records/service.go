@@ -2,50 +2,135 @@ package productrecords
 
 import (
 	"errors"
+	"math"
+	"time"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
 )
 
+// DateLayout is the layout of the date prices are looked up at.
+const DateLayout = "2006-01-02"
+
 var (
-	ErrProductNotFoundError = errors.New("product not found")
+	ErrProductNotFoundError        = errors.New("product not found")
+	ErrSalePriceBelowPurchaseError = errors.New("sale price is below the purchase price, flag the record as a promotion")
+	ErrPriceNotFoundError          = errors.New("no price recorded for the product at this date")
+	ErrInvalidDateError            = errors.New("date must be formatted as YYYY-MM-DD")
 )
 
 type ProductRecordsService interface {
-	Create(lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64) (db.ProductRecord, error)
+	Create(lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64, promotion bool) (db.ProductRecord, error)
+	// GetPrices returns the price timeline of a product, oldest first.
+	GetPrices(productId uint64) ([]db.ProductRecord, error)
+	// GetPriceAt returns the price a product had at the end of date, today
+	// when date is empty.
+	GetPriceAt(productId uint64, date string) (db.ProductRecord, error)
+	// GetProduct returns the product records are kept for.
+	GetProduct(productId uint64) (db.Product, error)
 }
 
 type productRecordsService struct {
 	productRecordsRepository ProductRecordsRepository
 	productRepository        products.ProductRepository
+	now                      func() time.Time
 }
 
 func NewProductRecordsService(r ProductRecordsRepository, pr products.ProductRepository) ProductRecordsService {
 	return &productRecordsService{
 		productRecordsRepository: r,
 		productRepository:        pr,
+		now:                      time.Now,
 	}
 }
 
 func (s *productRecordsService) Create(
-	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64,
+	lastUpdateDate string, purchasePrice float32, salePrice float32, productId uint64, promotion bool,
 ) (db.ProductRecord, error) {
-	productFound, err := s.productRepository.Get(productId)
-	if err != nil {
-		return db.ProductRecord{}, err
+	if salePrice < purchasePrice && !promotion {
+		return db.ProductRecord{}, ErrSalePriceBelowPurchaseError
 	}
 
-	if productFound.Id != productId {
-		return db.ProductRecord{}, ErrProductNotFoundError
+	if err := s.checkProduct(productId); err != nil {
+		return db.ProductRecord{}, err
 	}
 
 	productRecords, err := s.productRecordsRepository.Create(
-		lastUpdateDate, purchasePrice, salePrice, productId,
+		lastUpdateDate, purchasePrice, salePrice, productId, promotion,
 	)
 
 	if err != nil {
 		return db.ProductRecord{}, err
 	}
 
+	return withMargin(productRecords), nil
+}
+
+func (s *productRecordsService) GetPrices(productId uint64) ([]db.ProductRecord, error) {
+	if err := s.checkProduct(productId); err != nil {
+		return nil, err
+	}
+
+	productRecords, err := s.productRecordsRepository.GetByProductId(productId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range productRecords {
+		productRecords[i] = withMargin(productRecords[i])
+	}
+
 	return productRecords, nil
 }
+
+func (s *productRecordsService) GetPriceAt(productId uint64, date string) (db.ProductRecord, error) {
+	day := s.now()
+	if date != "" {
+		var err error
+		day, err = time.Parse(DateLayout, date)
+		if err != nil {
+			return db.ProductRecord{}, ErrInvalidDateError
+		}
+	}
+
+	if err := s.checkProduct(productId); err != nil {
+		return db.ProductRecord{}, err
+	}
+
+	// Records are kept with the time they were updated, so the price of a
+	// day is the last one set before the next day starts.
+	nextDay := day.AddDate(0, 0, 1).Format(DateLayout)
+
+	productRecord, err := s.productRecordsRepository.GetLatestBefore(productId, nextDay)
+	if err != nil {
+		return db.ProductRecord{}, err
+	}
+
+	return withMargin(productRecord), nil
+}
+
+func (s *productRecordsService) GetProduct(productId uint64) (db.Product, error) {
+	productFound, err := s.productRepository.Get(productId)
+	if err != nil {
+		return db.Product{}, err
+	}
+
+	if productFound.Id != productId {
+		return db.Product{}, ErrProductNotFoundError
+	}
+
+	return productFound, nil
+}
+
+func (s *productRecordsService) checkProduct(productId uint64) error {
+	_, err := s.GetProduct(productId)
+	return err
+}
+
+// withMargin sets the margin of a record, rounded to cents like the prices
+// are stored.
+func withMargin(productRecord db.ProductRecord) db.ProductRecord {
+	margin := float64(productRecord.SalePrice) - float64(productRecord.PurchasePrice)
+	productRecord.Margin = float32(math.Round(margin*100) / 100)
+	return productRecord
+}
@@ -0,0 +1,155 @@
+package productrecords
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/stretchr/testify/assert"
+)
+
+var existingProduct = products.MockProductRepository{GetById: db.Product{Id: 1}}
+
+func Test_Service_Create_Ok(t *testing.T) {
+
+	service := NewProductRecordsService(MockProductRecordsRepository{}, existingProduct)
+
+	productRecord, err := service.Create("2022-07-04 09:00:00", 10.1, 15.3, 1, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, float32(5.2), productRecord.Margin)
+}
+
+func Test_Service_Create_ShouldRejectSaleBelowPurchase(t *testing.T) {
+
+	var created db.ProductRecord
+	service := NewProductRecordsService(MockProductRecordsRepository{Created: &created}, existingProduct)
+
+	_, err := service.Create("2022-07-04 09:00:00", 10, 8, 1, false)
+
+	assert.Equal(t, ErrSalePriceBelowPurchaseError, err)
+	assert.Equal(t, db.ProductRecord{}, created)
+}
+
+func Test_Service_Create_ShouldAcceptPromotionBelowPurchase(t *testing.T) {
+
+	var created db.ProductRecord
+	service := NewProductRecordsService(MockProductRecordsRepository{Created: &created}, existingProduct)
+
+	productRecord, err := service.Create("2022-07-04 09:00:00", 10, 8, 1, true)
+
+	assert.Nil(t, err)
+	assert.True(t, created.Promotion)
+	assert.Equal(t, float32(-2), productRecord.Margin)
+}
+
+func Test_Service_Create_ProductNotFound(t *testing.T) {
+
+	service := NewProductRecordsService(MockProductRecordsRepository{}, products.MockProductRepository{})
+
+	_, err := service.Create("2022-07-04 09:00:00", 10, 15, 1, false)
+
+	assert.Equal(t, ErrProductNotFoundError, err)
+}
+
+func Test_Service_GetProduct_Ok(t *testing.T) {
+
+	product := db.Product{Id: 1, SellerId: 2}
+	service := NewProductRecordsService(MockProductRecordsRepository{}, products.MockProductRepository{GetById: product})
+
+	productFound, err := service.GetProduct(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, product, productFound)
+}
+
+func Test_Service_GetProduct_NotFound(t *testing.T) {
+
+	service := NewProductRecordsService(MockProductRecordsRepository{}, products.MockProductRepository{})
+
+	_, err := service.GetProduct(1)
+
+	assert.Equal(t, ErrProductNotFoundError, err)
+}
+
+func Test_Service_GetPrices_ShouldSetMargins(t *testing.T) {
+
+	repository := MockProductRecordsRepository{Result: []db.ProductRecord{
+		{Id: 1, PurchasePrice: 10, SalePrice: 15, ProductId: 1},
+		{Id: 2, PurchasePrice: 10, SalePrice: 9.5, ProductId: 1, Promotion: true},
+	}}
+	service := NewProductRecordsService(repository, existingProduct)
+
+	productRecords, err := service.GetPrices(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, float32(5), productRecords[0].Margin)
+	assert.Equal(t, float32(-0.5), productRecords[1].Margin)
+}
+
+func Test_Service_GetPrices_ProductNotFound(t *testing.T) {
+
+	service := NewProductRecordsService(MockProductRecordsRepository{}, products.MockProductRepository{})
+
+	_, err := service.GetPrices(1)
+
+	assert.Equal(t, ErrProductNotFoundError, err)
+}
+
+func Test_Service_GetPriceAt_ShouldLookUpUntilTheEndOfTheDay(t *testing.T) {
+
+	var before string
+	repository := MockProductRecordsRepository{Latest: db.ProductRecord{Id: 1, PurchasePrice: 10, SalePrice: 12, ProductId: 1}, Before: &before}
+	service := NewProductRecordsService(repository, existingProduct)
+
+	productRecord, err := service.GetPriceAt(1, "2022-07-04")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-07-05", before)
+	assert.Equal(t, float32(2), productRecord.Margin)
+}
+
+func Test_Service_GetPriceAt_DefaultsToToday(t *testing.T) {
+
+	var before string
+	repository := MockProductRecordsRepository{Before: &before}
+	service := &productRecordsService{
+		productRecordsRepository: repository,
+		productRepository:        existingProduct,
+		now:                      func() time.Time { return time.Date(2022, 12, 31, 15, 0, 0, 0, time.UTC) },
+	}
+
+	service.GetPriceAt(1, "")
+
+	assert.Equal(t, "2023-01-01", before)
+}
+
+func Test_Service_GetPriceAt_InvalidDate(t *testing.T) {
+
+	service := NewProductRecordsService(MockProductRecordsRepository{}, existingProduct)
+
+	_, err := service.GetPriceAt(1, "04/07/2022")
+
+	assert.Equal(t, ErrInvalidDateError, err)
+}
+
+func Test_Service_GetPriceAt_NotFound(t *testing.T) {
+
+	service := NewProductRecordsService(MockProductRecordsRepository{Err: ErrPriceNotFoundError}, existingProduct)
+
+	_, err := service.GetPriceAt(1, "2022-07-04")
+
+	assert.Equal(t, ErrPriceNotFoundError, err)
+}
+
+func Test_Service_GetPriceAt_RepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection refused")
+	service := NewProductRecordsService(MockProductRecordsRepository{Err: expectedError}, existingProduct)
+
+	_, err := service.GetPriceAt(1, "2022-07-04")
+
+	assert.Equal(t, expectedError, err)
+}
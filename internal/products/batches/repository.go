@@ -5,6 +5,7 @@ import (
 	"log"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type ProductBatchRepository interface {
@@ -12,19 +13,23 @@ type ProductBatchRepository interface {
 		dueDate string, initialQuantity uint64, manufacturingDate string, manufacturingHour string,
 		minimumTemperature float32, productId uint64, sectionId uint64) (models.ProductBatch, error)
 
-	CountProductsBySections() ([]models.CountProductsBySectionIdReport, error)
+	CountProductsBySections() report.Rows[models.CountProductsBySectionIdReport]
 	CountProductsBySectionId(sectionId uint64) (models.CountProductsBySectionIdReport, error)
 
 	ExistsBatchNumber(number uint64) (bool, error)
 
 	Get(id uint64) (models.ProductBatch, error)
+	IncreaseCurrentQuantity(id uint64, quantity uint64) error
+	DecreaseCurrentQuantity(id uint64, quantity uint64) error
+	UpdateCurrentTemperatureBySection(sectionId uint64, temperature float32) error
+	UpdateSection(id uint64, sectionId uint64) error
 }
 
 type productBatchRepository struct {
-	db *sql.DB
+	db models.Querier
 }
 
-func NewProductBatchRepository(db *sql.DB) ProductBatchRepository {
+func NewProductBatchRepository(db models.Querier) ProductBatchRepository {
 	return &productBatchRepository{
 		db: db,
 	}
@@ -92,37 +97,18 @@ func (r *productBatchRepository) Create(
 	return productBatch, nil
 }
 
-func (r *productBatchRepository) CountProductsBySections() ([]models.CountProductsBySectionIdReport, error) {
-
-	var report []models.CountProductsBySectionIdReport
-
-	rows, err := r.db.Query(`
+func (r *productBatchRepository) CountProductsBySections() report.Rows[models.CountProductsBySectionIdReport] {
+	return report.Query(func() (*sql.Rows, error) {
+		return r.db.Query(`
 		SELECT sc.id, sc.section_number, COUNT(pb.product_id) AS products_count
 		FROM product_batches pb JOIN sections sc ON sc.id = pb.section_id GROUP BY (sc.id)`)
-
-	if err != nil {
-		log.Println(err)
-		return report, err
-	}
-
-	var foundReport models.CountProductsBySectionIdReport
-	for rows.Next() {
-
-		err := rows.Scan(
+	}, func(rows *sql.Rows, foundReport *models.CountProductsBySectionIdReport) error {
+		return rows.Scan(
 			&foundReport.SectionId,
 			&foundReport.SectionNumber,
 			&foundReport.ProductsCount,
 		)
-
-		if err != nil {
-			log.Println(err.Error())
-			return report, nil
-		}
-
-		report = append(report, foundReport)
-	}
-
-	return report, nil
+	})
 }
 
 func (r *productBatchRepository) CountProductsBySectionId(sectionId uint64) (models.CountProductsBySectionIdReport, error) {
@@ -224,3 +210,42 @@ func (r *productBatchRepository) Get(id uint64) (models.ProductBatch, error) {
 
 	return productBatch, nil
 }
+
+func (r *productBatchRepository) IncreaseCurrentQuantity(id uint64, quantity uint64) error {
+	_, err := r.db.Exec("UPDATE product_batches SET current_quantity = current_quantity + ? WHERE id = ?", quantity, id)
+	return err
+}
+
+// DecreaseCurrentQuantity takes quantity out of the batch stock. Like
+// sections.IncreaseCurrentCapacity, the check is part of the update, so
+// concurrent picks can not take the same units twice.
+func (r *productBatchRepository) DecreaseCurrentQuantity(id uint64, quantity uint64) error {
+	result, err := r.db.Exec(
+		"UPDATE product_batches SET current_quantity = current_quantity - ? WHERE id = ? AND current_quantity >= ?",
+		quantity, id, quantity,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := result.RowsAffected(); affected != 1 {
+		return InsufficientQuantityError
+	}
+
+	return nil
+}
+
+// UpdateCurrentTemperatureBySection sets the temperature of the batches with
+// stock stored in the section.
+func (r *productBatchRepository) UpdateCurrentTemperatureBySection(sectionId uint64, temperature float32) error {
+	_, err := r.db.Exec(
+		"UPDATE product_batches SET current_temperature = ? WHERE section_id = ? AND current_quantity > 0",
+		temperature, sectionId,
+	)
+	return err
+}
+
+func (r *productBatchRepository) UpdateSection(id uint64, sectionId uint64) error {
+	_, err := r.db.Exec("UPDATE product_batches SET section_id = ? WHERE id = ?", sectionId, id)
+	return err
+}
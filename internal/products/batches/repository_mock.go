@@ -2,13 +2,17 @@ package batches
 
 import (
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type MockProductBatchesRepository struct {
 	result            any
 	err               error
 	existsBatchNumber bool
-	getById           models.ProductBatch
+	GetById           models.ProductBatch
+	UpdateErr         error
+	// DueDate, when set, receives the due date of the created batch.
+	DueDate *string
 }
 
 func (m MockProductBatchesRepository) Create(
@@ -16,11 +20,17 @@ func (m MockProductBatchesRepository) Create(
 	dueDate string, initialQuantity uint64, manufacturingDate string, manufacturingHour string,
 	minimumTemperature float32, productId uint64, sectionId uint64,
 ) (models.ProductBatch, error) {
+	if m.DueDate != nil {
+		*m.DueDate = dueDate
+	}
 	return m.result.(models.ProductBatch), m.err
 }
 
-func (m MockProductBatchesRepository) CountProductsBySections() ([]models.CountProductsBySectionIdReport, error) {
-	return m.result.([]models.CountProductsBySectionIdReport), m.err
+func (m MockProductBatchesRepository) CountProductsBySections() report.Rows[models.CountProductsBySectionIdReport] {
+	if m.err != nil {
+		return report.Err[models.CountProductsBySectionIdReport](m.err)
+	}
+	return report.Slice(m.result.([]models.CountProductsBySectionIdReport))
 }
 
 func (m MockProductBatchesRepository) CountProductsBySectionId(sectionId uint64) (models.CountProductsBySectionIdReport, error) {
@@ -32,5 +42,21 @@ func (m MockProductBatchesRepository) ExistsBatchNumber(number uint64) (bool, er
 }
 
 func (m MockProductBatchesRepository) Get(id uint64) (models.ProductBatch, error) {
-	return m.getById, m.err
+	return m.GetById, m.err
+}
+
+func (m MockProductBatchesRepository) IncreaseCurrentQuantity(id uint64, quantity uint64) error {
+	return m.UpdateErr
+}
+
+func (m MockProductBatchesRepository) DecreaseCurrentQuantity(id uint64, quantity uint64) error {
+	return m.UpdateErr
+}
+
+func (m MockProductBatchesRepository) UpdateCurrentTemperatureBySection(sectionId uint64, temperature float32) error {
+	return m.UpdateErr
+}
+
+func (m MockProductBatchesRepository) UpdateSection(id uint64, sectionId uint64) error {
+	return m.UpdateErr
 }
@@ -8,6 +8,7 @@ import (
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -129,9 +130,9 @@ func Test_Repo_CountProductsBySections_Ok(t *testing.T) {
 	_, err = batchRepository.Create(666, 666, 666, "2012", 666, "2012", "16:20", 666, 1, 1)
 	_, err = batchRepository.Create(777, 777, 777, "2013", 777, "2013", "17:20", 777, 1, 2)
 
-	report, err := batchRepository.CountProductsBySections()
+	counts, err := report.Collect(batchRepository.CountProductsBySections())
 	assert.Nil(t, err)
-	assert.Equal(t, 2, len(report))
+	assert.Equal(t, 2, len(counts))
 
 	util.DropDB(database)
 }
@@ -144,7 +145,7 @@ func Test_Repo_CountProductsBySections_ConnectionError(t *testing.T) {
 	repository := NewProductBatchRepository(database)
 
 	database.Close()
-	_, err := repository.CountProductsBySections()
+	_, err := report.Collect(repository.CountProductsBySections())
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -240,6 +241,97 @@ func Test_Repo_ExistsBatchNumber_ConnectionError(t *testing.T) {
 	util.DropDB(database)
 }
 
+func Test_Repo_IncreaseCurrentQuantity_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+
+	repository := NewProductBatchRepository(database)
+	_, err := repository.Create(666, 100, 666, "2012", 100, "2012", "16:20", 666, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.IncreaseCurrentQuantity(1, 50)
+	assert.Nil(t, err)
+
+	foundBatch, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(150), foundBatch.CurrentQuantity)
+	assert.Equal(t, uint64(100), foundBatch.InitialQuantity)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_IncreaseCurrentQuantity_ConnectionError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+
+	repository := NewProductBatchRepository(database)
+
+	database.Close()
+	err := repository.IncreaseCurrentQuantity(1, 50)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_DecreaseCurrentQuantity_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+
+	repository := NewProductBatchRepository(database)
+	_, err := repository.Create(666, 100, 666, "2012", 100, "2012", "16:20", 666, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.DecreaseCurrentQuantity(1, 100)
+	assert.Nil(t, err)
+
+	foundBatch, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), foundBatch.CurrentQuantity)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_DecreaseCurrentQuantity_ShouldReturnInsufficientQuantityError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+
+	repository := NewProductBatchRepository(database)
+	_, err := repository.Create(666, 100, 666, "2012", 100, "2012", "16:20", 666, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.DecreaseCurrentQuantity(1, 101)
+	assert.Equal(t, InsufficientQuantityError, err)
+
+	foundBatch, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), foundBatch.CurrentQuantity)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_UpdateSection_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+
+	repository := NewProductBatchRepository(database)
+	_, err := repository.Create(666, 100, 666, "2012", 100, "2012", "16:20", 666, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.UpdateSection(1, 2)
+	assert.Nil(t, err)
+
+	foundBatch, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), foundBatch.SectionId)
+
+	util.DropDB(database)
+}
+
 const CREATE_PRODUCT_BATCHES_TABLE = `
 	CREATE TABLE "product_batches"(
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
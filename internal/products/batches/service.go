@@ -2,50 +2,92 @@ package batches
 
 import (
 	"errors"
+	"time"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 var (
-	ProductNotFoundError   = errors.New("product not found")
-	SectionNotFoundError   = errors.New("section not found")
-	ExistsBatchNumberError = errors.New("number already exists")
+	ProductNotFoundError      = errors.New("product not found")
+	SectionNotFoundError      = errors.New("section not found")
+	ExistsBatchNumberError    = errors.New("number already exists")
+	InsufficientQuantityError = errors.New("not enough quantity in product batch")
+	ProductTypeMismatchError  = errors.New("section does not store the product type of the batch")
+	TemperatureMismatchError  = errors.New("section cannot keep the product at its recommended freezing temperature")
+	InvalidDueDateError       = errors.New("due_date must be a date time, e.g. 2022-08-01 10:00:00 or 2022-08-01T10:00:00-03:00")
 )
 
 const (
 	NOT_FOUND_ID = 0
 )
 
+// DueDateLayout is the layout of product_batches.due_date, kept in UTC.
+const DueDateLayout = "2006-01-02 15:04:05"
+
+// NormalizeDueDate reads a due date in DueDateLayout or RFC 3339 and writes
+// it back in DueDateLayout, in UTC, so due dates compare as strings. Due
+// dates without a zone are taken as UTC.
+func NormalizeDueDate(value string) (string, error) {
+	parsed, err := time.Parse(DueDateLayout, value)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339, value)
+	}
+
+	if err != nil {
+		return "", InvalidDueDateError
+	}
+
+	return parsed.UTC().Format(DueDateLayout), nil
+}
+
+// CompatibleTemperature tells whether a section, which holds nothing
+// colder than its minimum temperature nor warmer than its current one, can
+// keep the product at its recommended freezing temperature.
+func CompatibleTemperature(product models.Product, section models.Section) bool {
+	return product.RecommendedFreezingTemp >= section.MinimumTemperature &&
+		product.RecommendedFreezingTemp <= section.CurrentTemperature
+}
+
 type ProductBatchService interface {
 	Create(number uint64, currentQuantity uint64, currentTemperature float32,
 		dueDate string, initialQuantity uint64, manufacturingDate string, manufacturingHour string,
 		minimumTemperature float32, productId uint64, sectionId uint64) (models.ProductBatch, error)
 
-	CountProductsBySections() ([]models.CountProductsBySectionIdReport, error)
+	CountProductsBySections() report.Rows[models.CountProductsBySectionIdReport]
 	CountProductsBySectionId(sectionId uint64) (models.CountProductsBySectionIdReport, error)
 }
 
+// Repositories are the transaction-bound repositories used by Create.
+type Repositories struct {
+	ProductBatches ProductBatchRepository
+	Sections       sections.SectionRepository
+	Products       products.ProductRepository
+	Ledger         ledger.LedgerRepository
+}
+
 type productBatchService struct {
 	productBatchRepository ProductBatchRepository
-	sectionRepository      sections.SectionRepository
-	productRepository      products.ProductRepository
+	unitOfWork             uow.UnitOfWork[Repositories]
+	now                    func() time.Time
 }
 
 func NewProductBatchesService(
 	pbr ProductBatchRepository,
-	sr sections.SectionRepository,
-	pr products.ProductRepository,
+	unitOfWork uow.UnitOfWork[Repositories],
 ) ProductBatchService {
 	return &productBatchService{
 		productBatchRepository: pbr,
-		sectionRepository:      sr,
-		productRepository:      pr,
+		unitOfWork:             unitOfWork,
+		now:                    time.Now,
 	}
 }
 
-func (s *productBatchService) CountProductsBySections() ([]models.CountProductsBySectionIdReport, error) {
+func (s *productBatchService) CountProductsBySections() report.Rows[models.CountProductsBySectionIdReport] {
 	return s.productBatchRepository.CountProductsBySections()
 }
 
@@ -59,40 +101,81 @@ func (s *productBatchService) Create(
 	minimumTemperature float32, productId uint64, sectionId uint64,
 ) (models.ProductBatch, error) {
 
-	existsNumber, err := s.ExistsBatchNumber(number)
-
-	if err != nil {
-		return models.ProductBatch{}, err
-	}
-
-	if existsNumber {
-		return models.ProductBatch{}, ExistsBatchNumberError
-	}
+	var productBatch models.ProductBatch
 
-	foundProduct, err := s.productRepository.Get(productId)
+	dueDate, err := NormalizeDueDate(dueDate)
 	if err != nil {
 		return models.ProductBatch{}, err
 	}
 
-	if (foundProduct == models.Product{}) {
-		return models.ProductBatch{}, ProductNotFoundError
-	}
-
-	_, err = s.sectionRepository.Get(sectionId)
-	if err != nil {
-		return models.ProductBatch{}, SectionNotFoundError
-	}
-
-	product, err := s.productBatchRepository.Create(
-		number, currentQuantity, currentTemperature, dueDate,
-		initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productId, sectionId,
-	)
+	err = s.unitOfWork.Do(func(r Repositories) error {
+		existsNumber, err := r.ProductBatches.ExistsBatchNumber(number)
+
+		if err != nil {
+			return err
+		}
+
+		if existsNumber {
+			return ExistsBatchNumberError
+		}
+
+		foundProduct, err := r.Products.Get(productId)
+		if err != nil {
+			return err
+		}
+
+		if (foundProduct == models.Product{}) {
+			return ProductNotFoundError
+		}
+
+		section, err := r.Sections.Get(sectionId)
+		if err != nil {
+			return SectionNotFoundError
+		}
+
+		if foundProduct.ProductTypeId != section.ProductTypeId {
+			return ProductTypeMismatchError
+		}
+
+		if !CompatibleTemperature(foundProduct, section) {
+			return TemperatureMismatchError
+		}
+
+		productBatch, err = r.ProductBatches.Create(
+			number, currentQuantity, currentTemperature, dueDate,
+			initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productId, sectionId,
+		)
+		if err != nil {
+			return err
+		}
+
+		// An empty batch takes no room and moves no stock.
+		if currentQuantity == 0 {
+			return nil
+		}
+
+		if err := r.Sections.IncreaseCurrentCapacity(sectionId, currentQuantity); err != nil {
+			return err
+		}
+
+		_, err = r.Ledger.Record(models.InventoryMovement{
+			ProductBatchId: productBatch.Id,
+			ProductId:      productId,
+			SectionId:      sectionId,
+			WarehouseId:    section.WarehouseId,
+			Type:           ledger.ReceiptMovement,
+			Quantity:       int64(currentQuantity),
+			CreatedAt:      s.now().UTC().Format(ledger.TimeLayout),
+		})
+
+		return err
+	})
 
 	if err != nil {
 		return models.ProductBatch{}, err
 	}
 
-	return product, nil
+	return productBatch, nil
 }
 
 func (s *productBatchService) ExistsBatchNumber(number uint64) (bool, error) {
@@ -2,10 +2,14 @@ package batches
 
 import (
 	"testing"
+	"time"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
 	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,20 +59,90 @@ func Test_Create_Ok(t *testing.T) {
 			Length:                  300,
 			NetWeight:               120,
 			ExpirationRate:          302,
-			RecommendedFreezingTemp: 45,
+			RecommendedFreezingTemp: 4,
 			FreezingRate:            67,
 			ProductTypeId:           1,
 			SellerId:                1,
 		},
 	}
 
-	service := NewProductBatchesService(mockProductBatchesRepository, mockSectionRepository, mockProductRepository)
-	result, err := service.Create(666, 666, 666, "2012", 666, "2012", "16:20", 666, 1, 1)
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	result, err := service.Create(666, 666, 666, "2022-08-01 10:00:00", 666, "2012", "16:20", 666, 1, 1)
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
 }
 
+func Test_Create_ShouldRecordReceipt(t *testing.T) {
+
+	mockProductBatchesRepository := MockProductBatchesRepository{
+		result: models.ProductBatch{Id: 1, Number: 666, CurrentQuantity: 10, ProductId: 1, SectionId: 2},
+	}
+
+	var recorded []models.InventoryMovement
+	unitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			ProductBatches: mockProductBatchesRepository,
+			Sections:       sections.MockSectionRepository{GetById: models.Section{Id: 2, WarehouseId: 3}},
+			Products:       products.MockProductRepository{GetById: models.Product{Id: 1}},
+			Ledger:         ledger.MockLedgerRepository{Recorded: &recorded},
+		},
+	}
+
+	service := &productBatchService{
+		productBatchRepository: mockProductBatchesRepository,
+		unitOfWork:             unitOfWork,
+		now:                    func() time.Time { return time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC) },
+	}
+
+	_, err := service.Create(666, 10, 666, "2022-08-01 10:00:00", 10, "2012", "16:20", 666, 1, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []models.InventoryMovement{{
+		ProductBatchId: 1,
+		ProductId:      1,
+		SectionId:      2,
+		WarehouseId:    3,
+		Type:           ledger.ReceiptMovement,
+		Quantity:       10,
+		CreatedAt:      "2022-08-01 10:00:00",
+	}}, recorded)
+}
+
+func Test_Create_ShouldStoreTheDueDateInUTC(t *testing.T) {
+
+	var dueDate string
+	mockProductBatchesRepository := MockProductBatchesRepository{result: models.ProductBatch{Id: 1}, DueDate: &dueDate}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, sections.MockSectionRepository{GetById: models.Section{Id: 1}}, products.MockProductRepository{GetById: models.Product{Id: 1}}))
+
+	for value, expected := range map[string]string{
+		"2022-08-01 10:00:00":       "2022-08-01 10:00:00",
+		"2022-08-01T10:00:00Z":      "2022-08-01 10:00:00",
+		"2022-08-01T22:30:00-03:00": "2022-08-02 01:30:00",
+	} {
+		_, err := service.Create(666, 0, 666, value, 0, "2012", "16:20", 666, 1, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, dueDate)
+	}
+}
+
+func Test_Create_ShouldReturnErrorWhenDueDateIsInvalid(t *testing.T) {
+
+	var dueDate string
+	mockProductBatchesRepository := MockProductBatchesRepository{result: models.ProductBatch{Id: 1}, DueDate: &dueDate}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, sections.MockSectionRepository{GetById: models.Section{Id: 1}}, products.MockProductRepository{GetById: models.Product{Id: 1}}))
+
+	for _, value := range []string{"", "2012", "01/08/2022", "2022-08-01", "2022-08-01 10:00"} {
+		_, err := service.Create(666, 0, 666, value, 0, "2012", "16:20", 666, 1, 1)
+
+		assert.Equal(t, InvalidDueDateError, err)
+		assert.Empty(t, dueDate)
+	}
+}
+
 func Test_Create_ShouldReturnErrorWhenNumberAlreadyExists(t *testing.T) {
 
 	expectedError := ExistsBatchNumberError
@@ -80,8 +154,8 @@ func Test_Create_ShouldReturnErrorWhenNumberAlreadyExists(t *testing.T) {
 	mockSectionRepository := sections.MockSectionRepository{}
 	mockProductRepository := products.MockProductRepository{}
 
-	service := NewProductBatchesService(mockProductBatchesRepository, mockSectionRepository, mockProductRepository)
-	_, err := service.Create(666, 666, 666, "2012", 666, "2012", "16:20", 666, 1, 1)
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	_, err := service.Create(666, 666, 666, "2022-08-01 10:00:00", 666, "2012", "16:20", 666, 1, 1)
 
 	assert.Equal(t, expectedError, err)
 }
@@ -98,8 +172,8 @@ func Test_Create_ShouldReturnErrorWhenNotFoundProduct(t *testing.T) {
 	mockSectionRepository := sections.MockSectionRepository{}
 	mockProductRepository := products.MockProductRepository{}
 
-	service := NewProductBatchesService(mockProductBatchesRepository, mockSectionRepository, mockProductRepository)
-	_, err := service.Create(666, 666, 666, "2012", 666, "2012", "16:20", 666, 1, 1)
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	_, err := service.Create(666, 666, 666, "2022-08-01 10:00:00", 666, "2012", "16:20", 666, 1, 1)
 
 	assert.Equal(t, expectedError, err)
 }
@@ -116,12 +190,96 @@ func Test_Create_ShouldReturnErrorWhenNotFoundSection(t *testing.T) {
 	mockSectionRepository := sections.MockSectionRepository{}
 	mockProductRepository := products.MockProductRepository{}
 
-	service := NewProductBatchesService(mockProductBatchesRepository, mockSectionRepository, mockProductRepository)
-	_, err := service.Create(666, 666, 666, "2012", 666, "2012", "16:20", 666, 1, 1)
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	_, err := service.Create(666, 666, 666, "2022-08-01 10:00:00", 666, "2012", "16:20", 666, 1, 1)
 
 	assert.Equal(t, expectedError, err)
 }
 
+func Test_Create_ShouldReturnErrorWhenSectionStoresAnotherProductType(t *testing.T) {
+
+	var recorded []models.InventoryMovement
+	mockProductBatchesRepository := MockProductBatchesRepository{}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			ProductBatches: mockProductBatchesRepository,
+			Sections:       sections.MockSectionRepository{GetById: models.Section{Id: 1, ProductTypeId: 2}},
+			Products:       products.MockProductRepository{GetById: models.Product{Id: 1, ProductTypeId: 1}},
+			Ledger:         ledger.MockLedgerRepository{Recorded: &recorded},
+		},
+	})
+	_, err := service.Create(666, 10, -18, "2022-08-01 10:00:00", 10, "2012", "16:20", -20, 1, 1)
+
+	assert.Equal(t, ProductTypeMismatchError, err)
+	assert.Empty(t, recorded)
+}
+
+func Test_Create_ShouldReturnErrorWhenSectionIsNotColdEnough(t *testing.T) {
+
+	mockProductBatchesRepository := MockProductBatchesRepository{}
+
+	mockSectionRepository := sections.MockSectionRepository{GetById: models.Section{Id: 1, MinimumTemperature: 2, CurrentTemperature: 8, ProductTypeId: 1}}
+	mockProductRepository := products.MockProductRepository{GetById: models.Product{Id: 1, RecommendedFreezingTemp: -18, ProductTypeId: 1}}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	_, err := service.Create(666, 10, -18, "2022-08-01 10:00:00", 10, "2012", "16:20", -20, 1, 1)
+
+	assert.Equal(t, TemperatureMismatchError, err)
+}
+
+func Test_Create_ShouldReturnErrorWhenSectionIsTooWarm(t *testing.T) {
+
+	mockProductBatchesRepository := MockProductBatchesRepository{}
+
+	mockSectionRepository := sections.MockSectionRepository{GetById: models.Section{Id: 1, MinimumTemperature: -25, CurrentTemperature: -18, ProductTypeId: 1}}
+	mockProductRepository := products.MockProductRepository{GetById: models.Product{Id: 1, RecommendedFreezingTemp: 4, ProductTypeId: 1}}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	_, err := service.Create(666, 10, 4, "2022-08-01 10:00:00", 10, "2012", "16:20", 2, 1, 1)
+
+	assert.Equal(t, TemperatureMismatchError, err)
+}
+
+func Test_Create_ShouldNotTouchTheSectionForAnEmptyBatch(t *testing.T) {
+
+	var recorded []models.InventoryMovement
+	mockProductBatchesRepository := MockProductBatchesRepository{result: models.ProductBatch{Id: 1, SectionId: 1}}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			ProductBatches: mockProductBatchesRepository,
+			Sections:       sections.MockSectionRepository{GetById: models.Section{Id: 1}, UpdateErr: sections.ErrSectionCapacityExceededError},
+			Products:       products.MockProductRepository{GetById: models.Product{Id: 1}},
+			Ledger:         ledger.MockLedgerRepository{Recorded: &recorded},
+		},
+	})
+	result, err := service.Create(666, 0, 666, "2022-08-01 10:00:00", 0, "2012", "16:20", 666, 1, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), result.Id)
+	assert.Empty(t, recorded)
+}
+
+func Test_Create_ShouldReturnErrorWhenSectionIsFull(t *testing.T) {
+
+	var recorded []models.InventoryMovement
+	mockProductBatchesRepository := MockProductBatchesRepository{result: models.ProductBatch{Id: 1, CurrentQuantity: 10, SectionId: 1}}
+
+	service := NewProductBatchesService(mockProductBatchesRepository, uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			ProductBatches: mockProductBatchesRepository,
+			Sections:       sections.MockSectionRepository{GetById: models.Section{Id: 1}, UpdateErr: sections.ErrSectionCapacityExceededError},
+			Products:       products.MockProductRepository{GetById: models.Product{Id: 1}},
+			Ledger:         ledger.MockLedgerRepository{Recorded: &recorded},
+		},
+	})
+	_, err := service.Create(666, 10, 666, "2022-08-01 10:00:00", 10, "2012", "16:20", 666, 1, 1)
+
+	assert.Equal(t, sections.ErrSectionCapacityExceededError, err)
+	assert.Empty(t, recorded)
+}
+
 func Test_CountProductsBySectionId_Ok(t *testing.T) {
 
 	expectedResult := models.CountProductsBySectionIdReport{
@@ -139,7 +297,7 @@ func Test_CountProductsBySectionId_Ok(t *testing.T) {
 	mockSectionRepository := sections.MockSectionRepository{}
 	mockProductRepository := products.MockProductRepository{}
 
-	service := NewProductBatchesService(mockProductBatchesRepository, mockSectionRepository, mockProductRepository)
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
 	result, err := service.CountProductsBySectionId(1)
 
 	assert.Nil(t, err)
@@ -171,9 +329,24 @@ func Test_CountProductsBySections_Ok(t *testing.T) {
 	mockSectionRepository := sections.MockSectionRepository{}
 	mockProductRepository := products.MockProductRepository{}
 
-	service := NewProductBatchesService(mockProductBatchesRepository, mockSectionRepository, mockProductRepository)
-	result, err := service.CountProductsBySections()
+	service := NewProductBatchesService(mockProductBatchesRepository, mockUnitOfWork(mockProductBatchesRepository, mockSectionRepository, mockProductRepository))
+	result, err := report.Collect(service.CountProductsBySections())
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
 }
+
+func mockUnitOfWork(
+	pbr ProductBatchRepository,
+	sr sections.SectionRepository,
+	pr products.ProductRepository,
+) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			ProductBatches: pbr,
+			Sections:       sr,
+			Products:       pr,
+			Ledger:         ledger.MockLedgerRepository{},
+		},
+	}
+}
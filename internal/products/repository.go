@@ -5,64 +5,66 @@ import (
 	"log"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type ProductRepository interface {
-	GetAll() ([]models.Product, error)
+	GetAll(params query.Params) (query.Page[models.Product], error)
 	Get(id uint64) (models.Product, error)
 	Update(updatedproduct models.Product) (models.Product, error)
 	Delete(id uint64) error
 	ExistsProductCode(code string) (bool, error)
 
 	GetReportRecords(id uint64) (models.ProductReportRecords, error)
-	GetAllReportRecords() ([]models.ProductReportRecords, error)
+	GetAllReportRecords() report.Rows[models.ProductReportRecords]
 
 	Create(code string, description string, width float32, height float32, length float32, netWeight float32, expirationRate float32,
 		recommendedFreezingTemp float32, freezingRate float32, productTypeId uint64, sellerId uint64) (models.Product, error)
 }
 
+var productsQuery = query.NewBuilder(
+	"products",
+	"id, description, expiration_rate, freezing_rate, height, length, net_weight, product_code, recommended_freezing_temperature, width, product_type, seller_id",
+	map[string]string{
+		"id":                               "id",
+		"product_code":                     "product_code",
+		"description":                      "description",
+		"width":                            "width",
+		"height":                           "height",
+		"length":                           "length",
+		"net_weight":                       "net_weight",
+		"expiration_rate":                  "expiration_rate",
+		"recommended_freezing_temperature": "recommended_freezing_temperature",
+		"freezing_rate":                    "freezing_rate",
+		"product_type_id":                  "product_type",
+		"seller_id":                        "seller_id",
+	},
+)
+
 type productRepository struct {
-	db *sql.DB
+	db models.Querier
 }
 
-func NewProductRepository(db *sql.DB) ProductRepository {
+func NewProductRepository(db models.Querier) ProductRepository {
 	return &productRepository{
 		db: db,
 	}
 }
 
-func (r *productRepository) GetAllReportRecords() ([]models.ProductReportRecords, error) {
-	rows, err := r.db.Query(`SELECT p.id product_id, p.description, count(pr.product_id) records_count FROM products p
+func (r *productRepository) GetAllReportRecords() report.Rows[models.ProductReportRecords] {
+	return report.Query(func() (*sql.Rows, error) {
+		return r.db.Query(`SELECT p.id product_id, p.description, count(pr.product_id) records_count FROM products p
 		left join product_records pr on pr.product_id = p.id
 		group by p.id, p.description`)
-
-	if err != nil {
-		log.Println(err)
-		return nil, err
-	}
-
-	var products []models.ProductReportRecords
-	for rows.Next() {
-
-		var product models.ProductReportRecords
-
+	}, func(rows *sql.Rows, product *models.ProductReportRecords) error {
 		// Fields must be in the same order as in the database
-		err := rows.Scan(
+		return rows.Scan(
 			&product.Id,
 			&product.Description,
 			&product.RecordsCount,
 		)
-
-		if err != nil {
-			log.Fatal(err)
-			return nil, err
-		}
-
-		products = append(products, product)
-	}
-
-	return products, nil
-
+	})
 }
 
 func (r *productRepository) GetReportRecords(id uint64) (models.ProductReportRecords, error) {
@@ -98,21 +100,27 @@ func (r *productRepository) GetReportRecords(id uint64) (models.ProductReportRec
 
 }
 
-func (r *productRepository) GetAll() ([]models.Product, error) {
+func (r *productRepository) GetAll(params query.Params) (query.Page[models.Product], error) {
 
-	rows, err := r.db.Query("SELECT * FROM products")
+	statement, args, err := productsQuery.Build(params)
+	if err != nil {
+		return query.Page[models.Product]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
 
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return query.Page[models.Product]{}, err
 	}
 
+	defer rows.Close()
+
 	var products []models.Product
 	for rows.Next() {
 
 		var product models.Product
 
-		// Fields must be in the same order as in the database
 		err := rows.Scan(
 			&product.Id,
 			&product.Description,
@@ -129,14 +137,14 @@ func (r *productRepository) GetAll() ([]models.Product, error) {
 		)
 
 		if err != nil {
-			log.Fatal(err)
-			return nil, err
+			log.Println(err)
+			return query.Page[models.Product]{}, err
 		}
 
 		products = append(products, product)
 	}
 
-	return products, nil
+	return query.NewPage(products, params)
 }
 
 func (r *productRepository) Get(id uint64) (models.Product, error) {
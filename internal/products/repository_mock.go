@@ -2,6 +2,8 @@ package products
 
 import (
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 )
 
 type MockProductRepository struct {
@@ -11,11 +13,11 @@ type MockProductRepository struct {
 	GetById            db.Product
 }
 
-func (m MockProductRepository) GetAll() ([]db.Product, error) {
+func (m MockProductRepository) GetAll(params query.Params) (query.Page[db.Product], error) {
 	if m.Err != nil {
-		return []db.Product{}, m.Err
+		return query.Page[db.Product]{}, m.Err
 	}
-	return m.Result.([]db.Product), nil
+	return query.NewPage(m.Result.([]db.Product), params)
 }
 
 func (m MockProductRepository) Get(id uint64) (db.Product, error) {
@@ -51,11 +53,11 @@ func (m MockProductRepository) GetReportRecords(id uint64) (db.ProductReportReco
 	return m.Result.(db.ProductReportRecords), nil
 }
 
-func (m MockProductRepository) GetAllReportRecords() ([]db.ProductReportRecords, error) {
+func (m MockProductRepository) GetAllReportRecords() report.Rows[db.ProductReportRecords] {
 	if m.Err != nil {
-		return []db.ProductReportRecords{}, m.Err
+		return report.Err[db.ProductReportRecords](m.Err)
 	}
-	return m.Result.([]db.ProductReportRecords), nil
+	return report.Slice(m.Result.([]db.ProductReportRecords))
 }
 
 func (m MockProductRepository) Update(updatedproduct db.Product) (db.Product, error) {
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -130,8 +132,8 @@ func Test_Repo_GetAll_OK(t *testing.T) {
 	_, err = repository.Create("cd", "Original", 1, 1, 1, 1, 1, 1, 1, 1, 1)
 	assert.Nil(t, err)
 
-	foundProducts, err := repository.GetAll()
-	assert.Equal(t, expectedCountRows, len(foundProducts))
+	foundProducts, err := repository.GetAll(query.Params{})
+	assert.Equal(t, expectedCountRows, len(foundProducts.Items))
 
 	util.DropDB(database)
 }
@@ -163,7 +165,7 @@ func Test_Repo_GetAllReportRecords_OK(t *testing.T) {
 	_, err = repository.Create("cd", "Original", 1, 1, 1, 1, 1, 1, 1, 1, 1)
 	assert.Nil(t, err)
 
-	foundProducts, err := repository.GetAllReportRecords()
+	foundProducts, err := report.Collect(repository.GetAllReportRecords())
 	assert.Equal(t, expectedRecords, foundProducts)
 	assert.Nil(t, err)
 
@@ -227,7 +229,7 @@ func Test_Repo_GetAll_ConnectionError(t *testing.T) {
 	repository := NewProductRepository(database)
 
 	database.Close()
-	_, err := repository.GetAll()
+	_, err := repository.GetAll(query.Params{})
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
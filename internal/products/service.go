@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/imdario/mergo"
 )
 
@@ -14,13 +16,13 @@ var (
 )
 
 type ProductService interface {
-	GetAll() ([]db.Product, error)
+	GetAll(params query.Params) (query.Page[db.Product], error)
 	Get(id uint64) (db.Product, error)
 	Delete(id uint64) error
 	ExistsProductCode(code string) (bool, error)
 
 	GetReportRecords(id uint64) (db.ProductReportRecords, error)
-	GetAllReportRecords() ([]db.ProductReportRecords, error)
+	GetAllReportRecords() report.Rows[db.ProductReportRecords]
 
 	Create(code string, description string, width float32, height float32, length float32, netWeight float32, expirationRate float32,
 		recommendedFreezingTemp float32, freezingRate float32, productTypeId uint64, sellerId uint64) (db.Product, error)
@@ -39,8 +41,8 @@ func NewProductService(r ProductRepository) ProductService {
 	}
 }
 
-func (s *productService) GetAll() ([]db.Product, error) {
-	return s.productRepository.GetAll()
+func (s *productService) GetAll(params query.Params) (query.Page[db.Product], error) {
+	return s.productRepository.GetAll(params)
 }
 
 func (s *productService) Get(id uint64) (db.Product, error) {
@@ -60,7 +62,7 @@ func (s *productService) GetReportRecords(id uint64) (db.ProductReportRecords, e
 	return s.productRepository.GetReportRecords(id)
 }
 
-func (s *productService) GetAllReportRecords() ([]db.ProductReportRecords, error) {
+func (s *productService) GetAllReportRecords() report.Rows[db.ProductReportRecords] {
 	return s.productRepository.GetAllReportRecords()
 }
 
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/report"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -94,10 +96,10 @@ func Test_GetAll_OK(t *testing.T) {
 	}
 
 	service := NewProductService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
 	assert.Nil(t, err)
-	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, expectedResult, result.Items)
 }
 
 func Test_GetReportRecords_OK(t *testing.T) {
@@ -140,7 +142,7 @@ func Test_GetAllReportRecords_OK(t *testing.T) {
 	}
 
 	service := NewProductService(mockRepository)
-	result, err := service.GetAllReportRecords()
+	result, err := report.Collect(service.GetAllReportRecords())
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedResult, result)
@@ -157,9 +159,9 @@ func Test_GetAll_ShouldReturnErrorWhenDatabaseFails(t *testing.T) {
 	}
 
 	service := NewProductService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
-	assert.Equal(t, expectedResult, result)
+	assert.Empty(t, result.Items)
 	assert.Equal(t, expectedError, err)
 }
 
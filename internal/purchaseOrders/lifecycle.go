@@ -0,0 +1,56 @@
+package purchaseOrders
+
+import "errors"
+
+// Status ids as seeded in the order_status table.
+const (
+	ApprovedStatusId  uint64 = 1
+	InTransitStatusId uint64 = 2
+	RejectedStatusId  uint64 = 3
+	PendingStatusId   uint64 = 4
+	DeliveredStatusId uint64 = 5
+	CancelledStatusId uint64 = 6
+)
+
+const (
+	ApproveAction = "approve"
+	ShipAction    = "ship"
+	DeliverAction = "deliver"
+	RejectAction  = "reject"
+	CancelAction  = "cancel"
+)
+
+var (
+	UnknownTransitionActionError = errors.New("unknown transition action")
+	IllegalTransitionError       = errors.New("transition not allowed from the current order status")
+)
+
+type transition struct {
+	from []uint64
+	to   uint64
+}
+
+var transitions = map[string]transition{
+	ApproveAction: {from: []uint64{PendingStatusId}, to: ApprovedStatusId},
+	RejectAction:  {from: []uint64{PendingStatusId}, to: RejectedStatusId},
+	ShipAction:    {from: []uint64{ApprovedStatusId}, to: InTransitStatusId},
+	DeliverAction: {from: []uint64{InTransitStatusId}, to: DeliveredStatusId},
+	CancelAction:  {from: []uint64{PendingStatusId, ApprovedStatusId}, to: CancelledStatusId},
+}
+
+// NextStatus returns the status an order in currentStatusId moves to when
+// action is applied, or an error when the move is not allowed.
+func NextStatus(currentStatusId uint64, action string) (uint64, error) {
+	transition, ok := transitions[action]
+	if !ok {
+		return 0, UnknownTransitionActionError
+	}
+
+	for _, from := range transition.from {
+		if from == currentStatusId {
+			return transition.to, nil
+		}
+	}
+
+	return 0, IllegalTransitionError
+}
@@ -17,13 +17,16 @@ type PurchaseOrdersRepository interface {
 	) (models.PurchaseOrder, error)
 	Get(id uint64) (models.PurchaseOrder, error)
 	ExistsBuyerId(buyerId uint64) bool
+	UpdateStatus(id uint64, fromStatusId uint64, toStatusId uint64) error
+	CreateStatusHistory(id uint64, fromStatusId uint64, toStatusId uint64, action string, changedAt string) error
+	GetStatusHistory(id uint64) ([]models.PurchaseOrderStatusHistory, error)
 }
 
 type purchaseOrdersRepository struct {
-	db *sql.DB
+	db models.Querier
 }
 
-func NewPurchaseOrdersRepository(purchaseOrders *sql.DB) PurchaseOrdersRepository {
+func NewPurchaseOrdersRepository(purchaseOrders models.Querier) PurchaseOrdersRepository {
 	return &purchaseOrdersRepository{
 		db: purchaseOrders,
 	}
@@ -118,3 +121,75 @@ func (r *purchaseOrdersRepository) ExistsBuyerId(buyerId uint64) bool {
 	return err == nil
 
 }
+
+// UpdateStatus moves the order from fromStatusId to toStatusId. It fails with
+// IllegalTransitionError when the order is no longer in fromStatusId.
+func (r *purchaseOrdersRepository) UpdateStatus(id uint64, fromStatusId uint64, toStatusId uint64) error {
+	result, err := r.db.Exec(
+		"UPDATE purchase_orders SET order_status_id = ? WHERE id = ? AND order_status_id = ?",
+		toStatusId, id, fromStatusId,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := result.RowsAffected(); affected != 1 {
+		return IllegalTransitionError
+	}
+
+	return nil
+}
+
+func (r *purchaseOrdersRepository) CreateStatusHistory(
+	id uint64, fromStatusId uint64, toStatusId uint64, action string, changedAt string,
+) error {
+	_, err := r.db.Exec(`
+		INSERT INTO purchase_order_status_history(
+			purchase_order_id,
+			from_status_id,
+			to_status_id,
+			action,
+			changed_at
+		) VALUES (?, ?, ?, ?, ?)
+	`, id, fromStatusId, toStatusId, action, changedAt)
+
+	return err
+}
+
+func (r *purchaseOrdersRepository) GetStatusHistory(id uint64) ([]models.PurchaseOrderStatusHistory, error) {
+	rows, err := r.db.Query(`
+		SELECT id, purchase_order_id, from_status_id, to_status_id, action, changed_at
+		FROM purchase_order_status_history
+		WHERE purchase_order_id = ?
+		ORDER BY id
+	`, id)
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var history []models.PurchaseOrderStatusHistory
+	for rows.Next() {
+		var change models.PurchaseOrderStatusHistory
+
+		err := rows.Scan(
+			&change.Id,
+			&change.PurchaseOrderId,
+			&change.FromStatusId,
+			&change.ToStatusId,
+			&change.Action,
+			&change.ChangedAt,
+		)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+
+		history = append(history, change)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,58 @@
+package purchaseOrders
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type MockPurchaseOrdersRepository struct {
+	Result        any
+	Err           error
+	GetById       db.PurchaseOrder
+	ExistsBuyer   bool
+	UpdateErr     error
+	StatusHistory []db.PurchaseOrderStatusHistory
+	// Recorded receives the history passed to CreateStatusHistory.
+	Recorded *[]db.PurchaseOrderStatusHistory
+}
+
+func (m MockPurchaseOrdersRepository) Create(
+	orderNumber string, orderDate string, trackingCode string, buyerId uint64, orderStatusId uint64, productRecordId uint64,
+) (db.PurchaseOrder, error) {
+	if m.Err != nil {
+		return db.PurchaseOrder{}, m.Err
+	}
+	return m.Result.(db.PurchaseOrder), nil
+}
+
+func (m MockPurchaseOrdersRepository) Get(id uint64) (db.PurchaseOrder, error) {
+	if (m.GetById == db.PurchaseOrder{} && m.Err != nil) {
+		return db.PurchaseOrder{}, m.Err
+	}
+	return m.GetById, nil
+}
+
+func (m MockPurchaseOrdersRepository) ExistsBuyerId(buyerId uint64) bool {
+	return m.ExistsBuyer
+}
+
+func (m MockPurchaseOrdersRepository) UpdateStatus(id uint64, fromStatusId uint64, toStatusId uint64) error {
+	return m.UpdateErr
+}
+
+func (m MockPurchaseOrdersRepository) CreateStatusHistory(
+	id uint64, fromStatusId uint64, toStatusId uint64, action string, changedAt string,
+) error {
+	if m.Recorded != nil {
+		*m.Recorded = append(*m.Recorded, db.PurchaseOrderStatusHistory{
+			PurchaseOrderId: id, FromStatusId: fromStatusId, ToStatusId: toStatusId, Action: action, ChangedAt: changedAt,
+		})
+	}
+	return m.UpdateErr
+}
+
+func (m MockPurchaseOrdersRepository) GetStatusHistory(id uint64) ([]db.PurchaseOrderStatusHistory, error) {
+	if m.Err != nil {
+		return []db.PurchaseOrderStatusHistory{}, m.Err
+	}
+	return m.StatusHistory, nil
+}
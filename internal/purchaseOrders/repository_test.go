@@ -129,6 +129,103 @@ func Test_Repo_ExistsId_ConnectionError(t *testing.T) {
 	util.DropDB(database)
 }
 
+func Test_Repo_UpdateStatus_Ok(t *testing.T) {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PURCHASE_ORDERS_TABLE)
+	util.QueryExec(database, CREATE_STATUS_HISTORY_TABLE)
+
+	repository := NewPurchaseOrdersRepository(database)
+
+	_, err := repository.Create("1", "2022-07-12", "1", 1, PendingStatusId, 1)
+	assert.Nil(t, err)
+
+	err = repository.UpdateStatus(1, PendingStatusId, ApprovedStatusId)
+	assert.Nil(t, err)
+
+	err = repository.CreateStatusHistory(1, PendingStatusId, ApprovedStatusId, ApproveAction, "2022-07-13 10:00:00")
+	assert.Nil(t, err)
+
+	purchaseOrder, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ApprovedStatusId, purchaseOrder.OrderStatusId)
+
+	expectedHistory := []models.PurchaseOrderStatusHistory{
+		{
+			Id:              1,
+			PurchaseOrderId: 1,
+			FromStatusId:    PendingStatusId,
+			ToStatusId:      ApprovedStatusId,
+			Action:          ApproveAction,
+			ChangedAt:       "2022-07-13 10:00:00",
+		},
+	}
+
+	history, err := repository.GetStatusHistory(1)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHistory, history)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_UpdateStatus_ShouldRefuseStaleStatus(t *testing.T) {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PURCHASE_ORDERS_TABLE)
+	util.QueryExec(database, CREATE_STATUS_HISTORY_TABLE)
+
+	repository := NewPurchaseOrdersRepository(database)
+
+	_, err := repository.Create("1", "2022-07-12", "1", 1, ApprovedStatusId, 1)
+	assert.Nil(t, err)
+
+	err = repository.UpdateStatus(1, PendingStatusId, ApprovedStatusId)
+	assert.Equal(t, IllegalTransitionError, err)
+
+	purchaseOrder, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ApprovedStatusId, purchaseOrder.OrderStatusId)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_UpdateStatus_ConnectionError(t *testing.T) {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_PURCHASE_ORDERS_TABLE)
+
+	repository := NewPurchaseOrdersRepository(database)
+
+	database.Close()
+	err := repository.UpdateStatus(1, PendingStatusId, ApprovedStatusId)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_CreateStatusHistory_ConnectionError(t *testing.T) {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_STATUS_HISTORY_TABLE)
+
+	repository := NewPurchaseOrdersRepository(database)
+
+	database.Close()
+	err := repository.CreateStatusHistory(1, PendingStatusId, ApprovedStatusId, ApproveAction, "")
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetStatusHistory_ConnectionError(t *testing.T) {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_STATUS_HISTORY_TABLE)
+
+	repository := NewPurchaseOrdersRepository(database)
+
+	database.Close()
+	_, err := repository.GetStatusHistory(1)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
 const CREATE_PURCHASE_ORDERS_TABLE = `
 	CREATE TABLE "purchase_orders"(
 		id INTEGER PRIMARY KEY AUTOINCREMENT ,
@@ -143,3 +240,14 @@ const CREATE_PURCHASE_ORDERS_TABLE = `
 		FOREIGN KEY (product_record_id) REFERENCES product_records(id)
 	);
 `
+
+const CREATE_STATUS_HISTORY_TABLE = `
+	CREATE TABLE "purchase_order_status_history"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		purchase_order_id BIGINT NOT NULL,
+		from_status_id BIGINT NOT NULL,
+		to_status_id BIGINT NOT NULL,
+		action TEXT NOT NULL,
+		changed_at TEXT NOT NULL
+	);
+`
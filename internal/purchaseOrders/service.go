@@ -2,7 +2,10 @@ package purchaseOrders
 
 import (
 	"errors"
+	"time"
+
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
 )
 
 var (
@@ -19,15 +22,24 @@ type PurchaseOrdersService interface {
 		orderStatusId uint64,
 		productRecordId uint64,
 	) (db.PurchaseOrder, error)
+	Transition(id uint64, action string) (db.PurchaseOrder, error)
+	GetStatusHistory(id uint64) ([]db.PurchaseOrderStatusHistory, error)
+}
+
+// Repositories are the transaction-bound repositories used by Transition.
+type Repositories struct {
+	PurchaseOrders PurchaseOrdersRepository
 }
 
 type purchaseOrdersService struct {
 	purchaseOrdersRepository PurchaseOrdersRepository
+	unitOfWork               uow.UnitOfWork[Repositories]
 }
 
-func NewPurchaseOrdersService(r PurchaseOrdersRepository) PurchaseOrdersService {
+func NewPurchaseOrdersService(r PurchaseOrdersRepository, unitOfWork uow.UnitOfWork[Repositories]) PurchaseOrdersService {
 	return &purchaseOrdersService{
 		purchaseOrdersRepository: r,
+		unitOfWork:               unitOfWork,
 	}
 }
 
@@ -50,3 +62,50 @@ func (s *purchaseOrdersService) Create(
 func (s *purchaseOrdersService) ExistsBuyerId(buyerId uint64) bool {
 	return s.purchaseOrdersRepository.ExistsBuyerId(buyerId)
 }
+
+func (s *purchaseOrdersService) Transition(id uint64, action string) (db.PurchaseOrder, error) {
+	purchaseOrder, err := s.get(id)
+	if err != nil {
+		return db.PurchaseOrder{}, err
+	}
+
+	nextStatusId, err := NextStatus(purchaseOrder.OrderStatusId, action)
+	if err != nil {
+		return db.PurchaseOrder{}, err
+	}
+
+	changedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+	err = s.unitOfWork.Do(func(r Repositories) error {
+		if err := r.PurchaseOrders.UpdateStatus(id, purchaseOrder.OrderStatusId, nextStatusId); err != nil {
+			return err
+		}
+		return r.PurchaseOrders.CreateStatusHistory(id, purchaseOrder.OrderStatusId, nextStatusId, action, changedAt)
+	})
+	if err != nil {
+		return db.PurchaseOrder{}, err
+	}
+
+	purchaseOrder.OrderStatusId = nextStatusId
+	return purchaseOrder, nil
+}
+
+func (s *purchaseOrdersService) GetStatusHistory(id uint64) ([]db.PurchaseOrderStatusHistory, error) {
+	if _, err := s.get(id); err != nil {
+		return []db.PurchaseOrderStatusHistory{}, err
+	}
+
+	return s.purchaseOrdersRepository.GetStatusHistory(id)
+}
+
+func (s *purchaseOrdersService) get(id uint64) (db.PurchaseOrder, error) {
+	purchaseOrder, err := s.purchaseOrdersRepository.Get(id)
+	if err != nil {
+		return db.PurchaseOrder{}, err
+	}
+
+	if purchaseOrder.Id != id {
+		return db.PurchaseOrder{}, PurchaseOrderNotFoundError
+	}
+
+	return purchaseOrder, nil
+}
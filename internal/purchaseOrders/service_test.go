@@ -0,0 +1,151 @@
+package purchaseOrders
+
+import (
+	"errors"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Service_Transition_Ok(t *testing.T) {
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById: db.PurchaseOrder{Id: 1, OrderStatusId: PendingStatusId},
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	result, err := service.Transition(1, ApproveAction)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ApprovedStatusId, result.OrderStatusId)
+}
+
+func Test_Service_Transition_ShouldReturnNotFoundError(t *testing.T) {
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById: db.PurchaseOrder{},
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	_, err := service.Transition(1, ApproveAction)
+
+	assert.Equal(t, PurchaseOrderNotFoundError, err)
+}
+
+func Test_Service_Transition_ShouldReturnUnknownActionError(t *testing.T) {
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById: db.PurchaseOrder{Id: 1, OrderStatusId: PendingStatusId},
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	_, err := service.Transition(1, "teleport")
+
+	assert.Equal(t, UnknownTransitionActionError, err)
+}
+
+func Test_Service_Transition_ShouldReturnIllegalTransitionError(t *testing.T) {
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById: db.PurchaseOrder{Id: 1, OrderStatusId: DeliveredStatusId},
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	_, err := service.Transition(1, CancelAction)
+
+	assert.Equal(t, IllegalTransitionError, err)
+}
+
+func Test_Service_Transition_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("update failed")
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById:   db.PurchaseOrder{Id: 1, OrderStatusId: ApprovedStatusId},
+		UpdateErr: expectedError,
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	_, err := service.Transition(1, ShipAction)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Service_Transition_ShouldReturnUnitOfWorkError(t *testing.T) {
+
+	expectedError := errors.New("could not begin transaction")
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById: db.PurchaseOrder{Id: 1, OrderStatusId: ApprovedStatusId},
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, uow.MockUnitOfWork[Repositories]{Err: expectedError})
+	_, err := service.Transition(1, ShipAction)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Service_GetStatusHistory_Ok(t *testing.T) {
+
+	expectedHistory := []db.PurchaseOrderStatusHistory{
+		{Id: 1, PurchaseOrderId: 1, FromStatusId: PendingStatusId, ToStatusId: ApprovedStatusId, Action: ApproveAction},
+		{Id: 2, PurchaseOrderId: 1, FromStatusId: ApprovedStatusId, ToStatusId: InTransitStatusId, Action: ShipAction},
+	}
+
+	mockRepository := MockPurchaseOrdersRepository{
+		GetById:       db.PurchaseOrder{Id: 1, OrderStatusId: InTransitStatusId},
+		StatusHistory: expectedHistory,
+	}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	result, err := service.GetStatusHistory(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHistory, result)
+}
+
+func Test_Service_GetStatusHistory_ShouldReturnNotFoundError(t *testing.T) {
+
+	mockRepository := MockPurchaseOrdersRepository{}
+
+	service := NewPurchaseOrdersService(mockRepository, mockUnitOfWork(mockRepository))
+	_, err := service.GetStatusHistory(1)
+
+	assert.Equal(t, PurchaseOrderNotFoundError, err)
+}
+
+func Test_NextStatus(t *testing.T) {
+
+	cases := []struct {
+		from     uint64
+		action   string
+		expected uint64
+		err      error
+	}{
+		{PendingStatusId, ApproveAction, ApprovedStatusId, nil},
+		{PendingStatusId, RejectAction, RejectedStatusId, nil},
+		{PendingStatusId, CancelAction, CancelledStatusId, nil},
+		{ApprovedStatusId, ShipAction, InTransitStatusId, nil},
+		{ApprovedStatusId, CancelAction, CancelledStatusId, nil},
+		{InTransitStatusId, DeliverAction, DeliveredStatusId, nil},
+		{PendingStatusId, ShipAction, 0, IllegalTransitionError},
+		{InTransitStatusId, CancelAction, 0, IllegalTransitionError},
+		{DeliveredStatusId, DeliverAction, 0, IllegalTransitionError},
+		{RejectedStatusId, ApproveAction, 0, IllegalTransitionError},
+		{PendingStatusId, "teleport", 0, UnknownTransitionActionError},
+	}
+
+	for _, c := range cases {
+		next, err := NextStatus(c.from, c.action)
+		assert.Equal(t, c.err, err, c.action)
+		assert.Equal(t, c.expected, next, c.action)
+	}
+}
+
+func mockUnitOfWork(repository PurchaseOrdersRepository) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{PurchaseOrders: repository},
+	}
+}
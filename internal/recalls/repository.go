@@ -0,0 +1,257 @@
+package recalls
+
+import (
+	"database/sql"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+)
+
+// PickHistory is what the ledger knows of the picks of a batch number.
+type PickHistory struct {
+	// Tracked is false when the ledger does not hold the whole history of
+	// the batch number: it has no movements of it, or the batch number was
+	// only given an opening balance when the ledger was introduced.
+	Tracked bool
+	// LastPickedAt is when the batch number was last picked, empty when it
+	// never was.
+	LastPickedAt string
+}
+
+type RecallRepository interface {
+	Create(recall db.BatchRecall) (db.BatchRecall, error)
+	// GetByBatchNumber returns the recall of the batch number, or
+	// RecallNotFoundError when it was never recalled.
+	GetByBatchNumber(batchNumber uint64) (db.BatchRecall, error)
+
+	// FindReceipts lists the inbound orders that received the batch number.
+	FindReceipts(batchNumber uint64) ([]db.TraceReceipt, error)
+	// FindLocations lists the batches of the batch number that still have
+	// stock, with their section and warehouse.
+	FindLocations(batchNumber uint64) ([]db.TraceLocation, error)
+	// GetPickHistory reads the picks of the batch number from the ledger.
+	GetPickHistory(batchNumber uint64) (PickHistory, error)
+	// FindSales lists the purchase orders of the product placed since the
+	// given date, and up to until unless it is empty, with the quantity of
+	// their order details.
+	FindSales(productId uint64, since string, until string) ([]db.TraceSale, error)
+	// FindBuyers lists the buyers of the purchase orders of FindSales.
+	FindBuyers(productId uint64, since string, until string) ([]db.Buyer, error)
+}
+
+type recallRepository struct {
+	db db.Querier
+}
+
+func NewRecallRepository(database db.Querier) RecallRepository {
+	return &recallRepository{
+		db: database,
+	}
+}
+
+func (r *recallRepository) Create(recall db.BatchRecall) (db.BatchRecall, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO batch_recalls(product_batch_id, batch_number, reason, created_at)
+		VALUES(?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.BatchRecall{}, err
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.Exec(recall.ProductBatchId, recall.BatchNumber, recall.Reason, recall.CreatedAt)
+	if err != nil {
+		return db.BatchRecall{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	recall.Id = uint64(insertedId)
+
+	return recall, nil
+}
+
+func (r *recallRepository) GetByBatchNumber(batchNumber uint64) (db.BatchRecall, error) {
+	var recall db.BatchRecall
+
+	row := r.db.QueryRow(`
+		SELECT id, product_batch_id, batch_number, reason, created_at
+		FROM batch_recalls WHERE batch_number = ?`, batchNumber,
+	)
+
+	err := row.Scan(&recall.Id, &recall.ProductBatchId, &recall.BatchNumber, &recall.Reason, &recall.CreatedAt)
+	if err == sql.ErrNoRows {
+		return db.BatchRecall{}, RecallNotFoundError
+	}
+	if err != nil {
+		return db.BatchRecall{}, err
+	}
+
+	return recall, nil
+}
+
+func (r *recallRepository) FindReceipts(batchNumber uint64) ([]db.TraceReceipt, error) {
+	rows, err := r.db.Query(`
+		SELECT io.id, io.order_number, io.order_date, io.quantity, io.employee_id, w.id, w.warehouse_code
+		FROM inbound_orders io
+		JOIN product_batches pb ON pb.id = io.product_batch_id
+		JOIN warehouses w ON w.id = io.warehouse_id
+		WHERE pb.batch_number = ?
+		ORDER BY io.order_date, io.id`, batchNumber,
+	)
+	if err != nil {
+		return []db.TraceReceipt{}, err
+	}
+
+	defer rows.Close()
+
+	receipts := []db.TraceReceipt{}
+
+	for rows.Next() {
+		var receipt db.TraceReceipt
+
+		if err := rows.Scan(
+			&receipt.InboundOrderId,
+			&receipt.OrderNumber,
+			&receipt.OrderDate,
+			&receipt.Quantity,
+			&receipt.EmployeeId,
+			&receipt.WarehouseId,
+			&receipt.WarehouseCode,
+		); err != nil {
+			return []db.TraceReceipt{}, err
+		}
+
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, rows.Err()
+}
+
+func (r *recallRepository) FindLocations(batchNumber uint64) ([]db.TraceLocation, error) {
+	rows, err := r.db.Query(`
+		SELECT pb.id, pb.current_quantity, sc.id, sc.section_number, w.id, w.warehouse_code
+		FROM product_batches pb
+		JOIN sections sc ON sc.id = pb.section_id
+		JOIN warehouses w ON w.id = sc.warehouse_id
+		WHERE pb.batch_number = ? AND pb.current_quantity > 0
+		ORDER BY w.id, sc.id, pb.id`, batchNumber,
+	)
+	if err != nil {
+		return []db.TraceLocation{}, err
+	}
+
+	defer rows.Close()
+
+	locations := []db.TraceLocation{}
+
+	for rows.Next() {
+		var location db.TraceLocation
+
+		if err := rows.Scan(
+			&location.ProductBatchId,
+			&location.CurrentQuantity,
+			&location.SectionId,
+			&location.SectionNumber,
+			&location.WarehouseId,
+			&location.WarehouseCode,
+		); err != nil {
+			return []db.TraceLocation{}, err
+		}
+
+		locations = append(locations, location)
+	}
+
+	return locations, rows.Err()
+}
+
+func (r *recallRepository) GetPickHistory(batchNumber uint64) (PickHistory, error) {
+	var movements, openings int
+	var history PickHistory
+
+	row := r.db.QueryRow(`
+		SELECT COUNT(*),
+			COALESCE(SUM(CASE WHEN im.movement_type = ? THEN 1 ELSE 0 END), 0),
+			COALESCE(MAX(CASE WHEN im.movement_type = ? THEN im.created_at END), '')
+		FROM inventory_movements im
+		JOIN product_batches pb ON pb.id = im.product_batch_id
+		WHERE pb.batch_number = ?`, ledger.OpeningMovement, ledger.PickMovement, batchNumber,
+	)
+
+	if err := row.Scan(&movements, &openings, &history.LastPickedAt); err != nil {
+		return PickHistory{}, err
+	}
+
+	history.Tracked = movements > 0 && openings == 0
+
+	return history, nil
+}
+
+func (r *recallRepository) FindSales(productId uint64, since string, until string) ([]db.TraceSale, error) {
+	rows, err := r.db.Query(`
+		SELECT po.id, po.order_number, po.order_date, po.order_status_id, SUM(od.quantity), po.buyer_id
+		FROM purchase_orders po
+		JOIN order_details od ON od.purchase_order_id = po.id
+		JOIN product_records pr ON pr.id = od.product_record_id
+		WHERE pr.product_id = ? AND po.order_date >= ? AND (? = '' OR po.order_date <= ?)
+		GROUP BY po.id, po.order_number, po.order_date, po.order_status_id, po.buyer_id
+		ORDER BY po.order_date, po.id`, productId, since, until, until,
+	)
+	if err != nil {
+		return []db.TraceSale{}, err
+	}
+
+	defer rows.Close()
+
+	sales := []db.TraceSale{}
+
+	for rows.Next() {
+		var sale db.TraceSale
+
+		if err := rows.Scan(
+			&sale.PurchaseOrderId,
+			&sale.OrderNumber,
+			&sale.OrderDate,
+			&sale.OrderStatusId,
+			&sale.Quantity,
+			&sale.BuyerId,
+		); err != nil {
+			return []db.TraceSale{}, err
+		}
+
+		sales = append(sales, sale)
+	}
+
+	return sales, rows.Err()
+}
+
+func (r *recallRepository) FindBuyers(productId uint64, since string, until string) ([]db.Buyer, error) {
+	rows, err := r.db.Query(`
+		SELECT DISTINCT b.id, b.id_card_number, b.first_name, b.last_name
+		FROM buyers b
+		JOIN purchase_orders po ON po.buyer_id = b.id
+		JOIN order_details od ON od.purchase_order_id = po.id
+		JOIN product_records pr ON pr.id = od.product_record_id
+		WHERE pr.product_id = ? AND po.order_date >= ? AND (? = '' OR po.order_date <= ?)
+		ORDER BY b.id`, productId, since, until, until,
+	)
+	if err != nil {
+		return []db.Buyer{}, err
+	}
+
+	defer rows.Close()
+
+	buyers := []db.Buyer{}
+
+	for rows.Next() {
+		var buyer db.Buyer
+
+		if err := rows.Scan(&buyer.Id, &buyer.CardNumberId, &buyer.FirstName, &buyer.LastName); err != nil {
+			return []db.Buyer{}, err
+		}
+
+		buyers = append(buyers, buyer)
+	}
+
+	return buyers, rows.Err()
+}
@@ -0,0 +1,57 @@
+package recalls
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type MockRecallRepository struct {
+	Recall    db.BatchRecall
+	Receipts  []db.TraceReceipt
+	Locations []db.TraceLocation
+	Sales     []db.TraceSale
+	Buyers    []db.Buyer
+	History   PickHistory
+	Err       error
+	Created   *[]db.BatchRecall
+}
+
+func (m MockRecallRepository) Create(recall db.BatchRecall) (db.BatchRecall, error) {
+	if m.Err != nil {
+		return db.BatchRecall{}, m.Err
+	}
+	if m.Created != nil {
+		*m.Created = append(*m.Created, recall)
+	}
+	recall.Id = 1
+	return recall, nil
+}
+
+func (m MockRecallRepository) GetByBatchNumber(batchNumber uint64) (db.BatchRecall, error) {
+	if m.Err != nil {
+		return db.BatchRecall{}, m.Err
+	}
+	if (m.Recall == db.BatchRecall{}) {
+		return db.BatchRecall{}, RecallNotFoundError
+	}
+	return m.Recall, nil
+}
+
+func (m MockRecallRepository) FindReceipts(batchNumber uint64) ([]db.TraceReceipt, error) {
+	return m.Receipts, m.Err
+}
+
+func (m MockRecallRepository) FindLocations(batchNumber uint64) ([]db.TraceLocation, error) {
+	return m.Locations, m.Err
+}
+
+func (m MockRecallRepository) GetPickHistory(batchNumber uint64) (PickHistory, error) {
+	return m.History, m.Err
+}
+
+func (m MockRecallRepository) FindSales(productId uint64, since string, until string) ([]db.TraceSale, error) {
+	return m.Sales, m.Err
+}
+
+func (m MockRecallRepository) FindBuyers(productId uint64, since string, until string) ([]db.Buyer, error) {
+	return m.Buyers, m.Err
+}
@@ -0,0 +1,450 @@
+package recalls
+
+import (
+	"database/sql"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Create_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	recall := db.BatchRecall{ProductBatchId: 1, BatchNumber: 10, Reason: "Listeria", CreatedAt: "2022-08-01 10:00:00"}
+
+	created, err := repository.Create(recall)
+	assert.Nil(t, err)
+
+	recall.Id = 1
+	assert.Equal(t, recall, created)
+
+	found, err := repository.GetByBatchNumber(10)
+	assert.Nil(t, err)
+	assert.Equal(t, recall, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Create_ShouldFailOnRecalledBatchNumber(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	_, err := repository.Create(db.BatchRecall{ProductBatchId: 1, BatchNumber: 10, Reason: "Listeria", CreatedAt: "2022-08-01 10:00:00"})
+	assert.Nil(t, err)
+
+	_, err = repository.Create(db.BatchRecall{ProductBatchId: 2, BatchNumber: 10, Reason: "Listeria", CreatedAt: "2022-08-01 10:00:00"})
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetByBatchNumber_ShouldReturnRecallNotFoundError(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	_, err := repository.GetByBatchNumber(10)
+
+	assert.Equal(t, RecallNotFoundError, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindReceipts_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	found, err := repository.FindReceipts(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TraceReceipt{
+		{InboundOrderId: 1, OrderNumber: "IO-1", OrderDate: "2022-01-11 08:00:00", Quantity: 35, EmployeeId: 1, WarehouseId: 1, WarehouseCode: "WH1"},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindLocations_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	found, err := repository.FindLocations(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TraceLocation{
+		{ProductBatchId: 1, CurrentQuantity: 30, SectionId: 1, SectionNumber: 11, WarehouseId: 1, WarehouseCode: "WH1"},
+		{ProductBatchId: 2, CurrentQuantity: 5, SectionId: 2, SectionNumber: 22, WarehouseId: 2, WarehouseCode: "WH2"},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindSales_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	found, err := repository.FindSales(1, "2022-01-10", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TraceSale{
+		{PurchaseOrderId: 2, OrderNumber: "PO-2", OrderDate: "2022-02-01 10:00:00", OrderStatusId: 1, Quantity: 6, BuyerId: 2},
+		{PurchaseOrderId: 3, OrderNumber: "PO-3", OrderDate: "2022-02-10 10:00:00", OrderStatusId: 1, Quantity: 1, BuyerId: 2},
+		{PurchaseOrderId: 5, OrderNumber: "PO-5", OrderDate: "2022-03-01 10:00:00", OrderStatusId: 1, Quantity: 2, BuyerId: 1},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindBuyers_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	found, err := repository.FindBuyers(1, "2022-01-10", "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.Buyer{
+		{Id: 1, CardNumberId: "111", FirstName: "Ana", LastName: "Lima"},
+		{Id: 2, CardNumberId: "222", FirstName: "Bruno", LastName: "Souza"},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindBuyers_ShouldStopAtUntil(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	found, err := repository.FindBuyers(1, "2022-01-10", "2022-02-05 09:00:00")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.Buyer{
+		{Id: 2, CardNumberId: "222", FirstName: "Bruno", LastName: "Souza"},
+	}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetPickHistory_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	util.QueryExec(database, INSERT_INVENTORY_MOVEMENTS)
+	repository := NewRecallRepository(database)
+
+	picked, err := repository.GetPickHistory(10)
+	assert.Nil(t, err)
+	assert.Equal(t, PickHistory{Tracked: true, LastPickedAt: "2022-02-05 09:00:00"}, picked)
+
+	neverPicked, err := repository.GetPickHistory(20)
+	assert.Nil(t, err)
+	assert.Equal(t, PickHistory{Tracked: true}, neverPicked)
+
+	opened, err := repository.GetPickHistory(30)
+	assert.Nil(t, err)
+	assert.Equal(t, PickHistory{Tracked: false, LastPickedAt: "2022-02-20 09:00:00"}, opened)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetPickHistory_ShouldNotTrackBatchesWithoutMovements(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	found, err := repository.GetPickHistory(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, PickHistory{}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindLocations_ConnectionError(t *testing.T) {
+
+	database := createRecallsDB()
+	repository := NewRecallRepository(database)
+
+	database.Close()
+	found, err := repository.FindLocations(10)
+
+	assert.NotNil(t, err)
+	assert.Empty(t, found)
+
+	util.DropDB(database)
+}
+
+func createRecallsDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_WAREHOUSES_TABLE)
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_SELLERS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_INBOUND_ORDERS_TABLE)
+	util.QueryExec(database, CREATE_BUYERS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_RECORDS_TABLE)
+	util.QueryExec(database, CREATE_PURCHASE_ORDERS_TABLE)
+	util.QueryExec(database, CREATE_ORDER_DETAILS_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_TABLE)
+	util.QueryExec(database, CREATE_BATCH_RECALLS_INDEX)
+	util.QueryExec(database, CREATE_INVENTORY_MOVEMENTS_TABLE)
+	util.QueryExec(database, INSERT_WAREHOUSES)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_SELLERS)
+	util.QueryExec(database, INSERT_PRODUCTS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+	util.QueryExec(database, INSERT_INBOUND_ORDERS)
+	util.QueryExec(database, INSERT_BUYERS)
+	util.QueryExec(database, INSERT_PRODUCT_RECORDS)
+	util.QueryExec(database, INSERT_PURCHASE_ORDERS)
+	util.QueryExec(database, INSERT_ORDER_DETAILS)
+	return database
+}
+
+// Batch 2 was split from batch 1 by a transfer, batch 3 is empty and
+// batch 4 is of another product.
+const INSERT_PRODUCT_BATCHES = `
+	INSERT INTO product_batches(batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+	VALUES (10, 30, 1, "2022-09-01 10:00:00", 35, "2022-01-10", "10:00:00", 1, 1, 1),
+		(10, 5, 1, "2022-09-01 10:00:00", 5, "2022-01-10", "10:00:00", 1, 1, 2),
+		(20, 0, 1, "2022-09-01 10:00:00", 10, "2022-03-01", "10:00:00", 1, 1, 1),
+		(30, 10, 1, "2022-09-01 10:00:00", 10, "2022-01-10", "10:00:00", 1, 2, 1)
+`
+
+const INSERT_INBOUND_ORDERS = `
+	INSERT INTO inbound_orders(order_date, order_number, employee_id, product_batch_id, warehouse_id, quantity)
+	VALUES ("2022-01-11 08:00:00", "IO-1", 1, 1, 1, 35),
+		("2022-01-11 09:00:00", "IO-2", 1, 4, 1, 10)
+`
+
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id)
+	VALUES (11, 40, 1, 100, 10, 1, 1, 1),
+		(22, 5, 1, 100, 10, 1, 1, 2)
+`
+
+const INSERT_WAREHOUSES = `
+	INSERT INTO warehouses(address, telephone, warehouse_code, minimum_capacity, minimum_temperature, locality_id)
+	VALUES ("Rua 1", "1111", "WH1", 10, 1, "1"),
+		("Rua 2", "2222", "WH2", 10, 1, "1")
+`
+
+const INSERT_SELLERS = `
+	INSERT INTO sellers(cid, company_name, address, telephone, locality_id)
+	VALUES (1, "Frutas SA", "Rua 3", "3333", "1")
+`
+
+const INSERT_PRODUCTS = `
+	INSERT INTO products(description, expiration_rate, freezing_rate, height, length, net_weight, product_code, recommended_freezing_temperature, width, product_type, seller_id)
+	VALUES ("Banana", 0.5, 1, 1, 1, 1, "BAN", 1, 1, 1, 1),
+		("Maca", 0.5, 1, 1, 1, 1, "MAC", 1, 1, 1, 1)
+`
+
+const INSERT_BUYERS = `
+	INSERT INTO buyers(id_card_number, first_name, last_name)
+	VALUES ("111", "Ana", "Lima"),
+		("222", "Bruno", "Souza"),
+		("333", "Carla", "Dias")
+`
+
+const INSERT_PRODUCT_RECORDS = `
+	INSERT INTO product_records(last_update_date, purchase_price, sale_price, product_id)
+	VALUES ("2022-01-01 00:00:00", 1, 2, 1),
+		("2022-01-01 00:00:00", 1, 2, 2)
+`
+
+// PO-1 was placed before batch 1 was manufactured and PO-4 is of another
+// product.
+const INSERT_PURCHASE_ORDERS = `
+	INSERT INTO purchase_orders(order_number, order_date, tracking_code, buyer_id, order_status_id, product_record_id)
+	VALUES ("PO-1", "2022-01-05 10:00:00", "T1", 1, 1, 1),
+		("PO-2", "2022-02-01 10:00:00", "T2", 2, 1, 1),
+		("PO-3", "2022-02-10 10:00:00", "T3", 2, 1, 1),
+		("PO-4", "2022-02-15 10:00:00", "T4", 3, 1, 2),
+		("PO-5", "2022-03-01 10:00:00", "T5", 1, 1, 1)
+`
+
+const INSERT_ORDER_DETAILS = `
+	INSERT INTO order_details(clean_liness_status, quantity, temperature, product_record_id, purchase_order_id)
+	VALUES ("ok", 3, 1, 1, 1),
+		("ok", 2, 1, 1, 2),
+		("ok", 4, 1, 1, 2),
+		("ok", 1, 1, 1, 3),
+		("ok", 9, 1, 2, 4),
+		("ok", 2, 1, 1, 5)
+`
+
+// Batch number 10 was received and picked twice, batch number 20 was
+// received and never picked, and batch number 30 was given an opening
+// balance when the ledger was introduced.
+const INSERT_INVENTORY_MOVEMENTS = `
+	INSERT INTO inventory_movements(product_batch_id, product_id, section_id, warehouse_id, movement_type, quantity, reason, created_at)
+	VALUES (1, 1, 1, 1, "receipt", 35, "", "2022-01-11 08:00:00"),
+		(1, 1, 1, 1, "pick", -2, "", "2022-01-20 09:00:00"),
+		(2, 1, 2, 2, "pick", -1, "", "2022-02-05 09:00:00"),
+		(3, 1, 1, 1, "receipt", 10, "", "2022-03-01 08:00:00"),
+		(4, 2, 1, 1, "opening", 10, "", "2022-01-15 00:00:00"),
+		(4, 2, 1, 1, "pick", -1, "", "2022-02-20 09:00:00")
+`
+
+const CREATE_INVENTORY_MOVEMENTS_TABLE = `
+	CREATE TABLE "inventory_movements"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		movement_type TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_BATCH_RECALLS_TABLE = `
+	CREATE TABLE "batch_recalls"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		batch_number BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_BATCH_RECALLS_INDEX = `
+	CREATE UNIQUE INDEX "batch_recalls_batch_number" ON "batch_recalls" (batch_number);
+`
+
+const CREATE_INBOUND_ORDERS_TABLE = `
+	CREATE TABLE "inbound_orders"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		order_date TEXT NOT NULL,
+		order_number TEXT NOT NULL,
+		employee_id BIGINT NOT NULL,
+		product_batch_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		quantity BIGINT NOT NULL DEFAULT 0
+	);
+`
+
+const CREATE_BUYERS_TABLE = `
+	CREATE TABLE "buyers"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		id_card_number TEXT NOT NULL,
+		first_name TEXT NOT NULL,
+		last_name TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_RECORDS_TABLE = `
+	CREATE TABLE "product_records"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		last_update_date TEXT NOT NULL,
+		purchase_price DECIMAL(19, 2) NOT NULL,
+		sale_price DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PURCHASE_ORDERS_TABLE = `
+	CREATE TABLE "purchase_orders"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		order_number TEXT NOT NULL,
+		order_date TEXT NOT NULL,
+		tracking_code TEXT NOT NULL,
+		buyer_id BIGINT NOT NULL,
+		order_status_id BIGINT NOT NULL,
+		product_record_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_ORDER_DETAILS_TABLE = `
+	CREATE TABLE "order_details"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		clean_liness_status TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		temperature DECIMAL(19, 2) NOT NULL,
+		product_record_id BIGINT NOT NULL,
+		purchase_order_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SELLERS_TABLE = `
+	CREATE TABLE "sellers" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		cid BIGINT NOT NULL,
+		company_name TEXT NOT NULL,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const CREATE_WAREHOUSES_TABLE = `
+	CREATE TABLE "warehouses" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		address TEXT NOT NULL,
+		telephone TEXT NOT NULL,
+		warehouse_code TEXT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		locality_id TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_BATCHES_TABLE = `
+	CREATE TABLE "product_batches"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		batch_number BIGINT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		due_date TEXT NOT NULL,
+		initial_quantity BIGINT NOT NULL,
+		manufacturing_date TEXT NOT NULL,
+		manufacturing_hour TEXT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		expiration_rate DECIMAL(19, 2) NOT NULL,
+		freezing_rate DECIMAL(19, 2) NOT NULL,
+		height DECIMAL(19, 2) NOT NULL,
+		length DECIMAL(19, 2) NOT NULL,
+		net_weight DECIMAL(19, 2) NOT NULL,
+		product_code TEXT NOT NULL,
+		recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+		width DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT  NOT NULL,
+		seller_id BIGINT  NOT NULL
+	);
+`
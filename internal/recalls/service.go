@@ -0,0 +1,211 @@
+package recalls
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+)
+
+var (
+	ProductBatchNotFoundError = errors.New("product batch not found")
+	RecallNotFoundError       = errors.New("recall not found")
+	AlreadyRecalledError      = errors.New("product batch is already recalled")
+)
+
+// TimeLayout is the layout recalls are stored in, always in UTC.
+const TimeLayout = "2006-01-02 15:04:05"
+
+type RecallService interface {
+	Trace(productBatchId uint64) (db.BatchTrace, error)
+	Recall(productBatchId uint64, reason string) (db.RecallReport, error)
+}
+
+// Repositories are the transaction-bound repositories used by Recall.
+type Repositories struct {
+	Recalls        RecallRepository
+	ProductBatches batches.ProductBatchRepository
+}
+
+type recallService struct {
+	recallRepository  RecallRepository
+	batchRepository   batches.ProductBatchRepository
+	productRepository products.ProductRepository
+	sellerRepository  sellers.Repository
+	unitOfWork        uow.UnitOfWork[Repositories]
+	now               func() time.Time
+}
+
+func NewRecallService(
+	recallRepository RecallRepository,
+	batchRepository batches.ProductBatchRepository,
+	productRepository products.ProductRepository,
+	sellerRepository sellers.Repository,
+	unitOfWork uow.UnitOfWork[Repositories],
+) RecallService {
+	return &recallService{
+		recallRepository:  recallRepository,
+		batchRepository:   batchRepository,
+		productRepository: productRepository,
+		sellerRepository:  sellerRepository,
+		unitOfWork:        unitOfWork,
+		now:               time.Now,
+	}
+}
+
+// Trace follows the batch back to its product and seller, and forward to
+// the inbound orders that received it, the sections holding its stock and
+// the purchase orders of its product.
+//
+// Batches split by a transfer keep their batch number, so receipts and
+// locations cover the whole batch number. Purchase orders are not linked
+// to batches: the orders of the product placed since the batch was
+// manufactured are listed, up to its last pick when the ledger holds the
+// whole history of the batch number, so they may include buyers of other
+// batches.
+func (s *recallService) Trace(productBatchId uint64) (db.BatchTrace, error) {
+	batch, err := getBatch(s.batchRepository, productBatchId)
+	if err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	trace := db.BatchTrace{Batch: batch}
+
+	if trace.Product, err = s.productRepository.Get(batch.ProductId); err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	if trace.Seller, err = s.sellerRepository.FindOne(trace.Product.SellerId); err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	recall, err := s.recallRepository.GetByBatchNumber(batch.Number)
+	if err == nil {
+		trace.Recall = &recall
+	} else if err != RecallNotFoundError {
+		return db.BatchTrace{}, err
+	}
+
+	if trace.Receipts, err = s.recallRepository.FindReceipts(batch.Number); err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	if trace.Locations, err = s.recallRepository.FindLocations(batch.Number); err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	until, picked, err := lastPick(s.recallRepository, batch)
+	if err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	trace.Sales, trace.Buyers = []db.TraceSale{}, []db.Buyer{}
+	if !picked {
+		return trace, nil
+	}
+
+	if trace.Sales, err = s.recallRepository.FindSales(batch.ProductId, batch.ManufacturingDate, until); err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	if trace.Buyers, err = s.recallRepository.FindBuyers(batch.ProductId, batch.ManufacturingDate, until); err != nil {
+		return db.BatchTrace{}, err
+	}
+
+	return trace, nil
+}
+
+// Recall blocks the batch number from picking and reports where its stock
+// is and who may have bought it, as Trace does, all in one transaction.
+func (s *recallService) Recall(productBatchId uint64, reason string) (db.RecallReport, error) {
+	report := db.RecallReport{}
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		batch, err := getBatch(r.ProductBatches, productBatchId)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.Recalls.GetByBatchNumber(batch.Number)
+		if err == nil {
+			return AlreadyRecalledError
+		}
+		if err != RecallNotFoundError {
+			return err
+		}
+
+		report.Recall, err = r.Recalls.Create(db.BatchRecall{
+			ProductBatchId: batch.Id,
+			BatchNumber:    batch.Number,
+			Reason:         reason,
+			CreatedAt:      s.now().UTC().Format(TimeLayout),
+		})
+
+		// A concurrent request recalled the batch number after the check
+		// above.
+		if db.IsUniqueViolation(err) {
+			return AlreadyRecalledError
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if report.Locations, err = r.Recalls.FindLocations(batch.Number); err != nil {
+			return err
+		}
+
+		until, picked, err := lastPick(r.Recalls, batch)
+		if err != nil {
+			return err
+		}
+
+		report.Buyers = []db.Buyer{}
+		if !picked {
+			return nil
+		}
+
+		report.Buyers, err = r.Recalls.FindBuyers(batch.ProductId, batch.ManufacturingDate, until)
+		return err
+	})
+
+	if err != nil {
+		return db.RecallReport{}, err
+	}
+
+	return report, nil
+}
+
+// lastPick bounds the purchase orders that may have been served from the
+// batch number: none were when the ledger shows it was never picked, and
+// none placed after its last pick were. Without a whole history in the
+// ledger, until is empty and the orders are not bounded.
+func lastPick(repository RecallRepository, batch db.ProductBatch) (until string, picked bool, err error) {
+	history, err := repository.GetPickHistory(batch.Number)
+	if err != nil {
+		return "", false, err
+	}
+
+	if !history.Tracked {
+		return "", true, nil
+	}
+
+	return history.LastPickedAt, history.LastPickedAt != "", nil
+}
+
+func getBatch(batchRepository batches.ProductBatchRepository, productBatchId uint64) (db.ProductBatch, error) {
+	batch, err := batchRepository.Get(productBatchId)
+	if err != nil {
+		return db.ProductBatch{}, err
+	}
+
+	if (batch == db.ProductBatch{}) {
+		return db.ProductBatch{}, ProductBatchNotFoundError
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,180 @@
+package recalls
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sellers"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Trace_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	service := newRecallService(database, NewRecallRepository(database))
+
+	trace, err := service.Trace(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), trace.Batch.Number)
+	assert.Equal(t, "Banana", trace.Product.Description)
+	assert.Equal(t, "Frutas SA", trace.Seller.CompanyName)
+	assert.Nil(t, trace.Recall)
+	assert.Len(t, trace.Receipts, 1)
+	assert.Len(t, trace.Locations, 2)
+	assert.Len(t, trace.Sales, 3)
+	assert.Len(t, trace.Buyers, 2)
+
+	util.DropDB(database)
+}
+
+func Test_Trace_ShouldOnlyListOrdersPlacedUntilTheLastPick(t *testing.T) {
+
+	database := createRecallsDB()
+	util.QueryExec(database, INSERT_INVENTORY_MOVEMENTS)
+	service := newRecallService(database, NewRecallRepository(database))
+
+	trace, err := service.Trace(1)
+
+	assert.Nil(t, err)
+	assert.Len(t, trace.Sales, 1)
+	assert.Equal(t, uint64(2), trace.Sales[0].PurchaseOrderId)
+	assert.Equal(t, []db.Buyer{
+		{Id: 2, CardNumberId: "222", FirstName: "Bruno", LastName: "Souza"},
+	}, trace.Buyers)
+
+	util.DropDB(database)
+}
+
+func Test_Trace_ShouldListNoBuyersOfANeverPickedBatch(t *testing.T) {
+
+	database := createRecallsDB()
+	util.QueryExec(database, INSERT_INVENTORY_MOVEMENTS)
+	service := newRecallService(database, NewRecallRepository(database))
+
+	trace, err := service.Trace(3)
+
+	assert.Nil(t, err)
+	assert.Empty(t, trace.Sales)
+	assert.Empty(t, trace.Buyers)
+
+	util.DropDB(database)
+}
+
+func Test_Trace_ShouldReturnProductBatchNotFoundError(t *testing.T) {
+
+	service := NewRecallService(MockRecallRepository{}, batches.MockProductBatchesRepository{}, products.MockProductRepository{}, nil, nil)
+
+	_, err := service.Trace(1)
+
+	assert.Equal(t, ProductBatchNotFoundError, err)
+}
+
+func Test_Recall_Ok(t *testing.T) {
+
+	database := createRecallsDB()
+	service := newRecallService(database, NewRecallRepository(database))
+
+	report, err := service.Recall(1, "Listeria")
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.BatchRecall{Id: 1, ProductBatchId: 1, BatchNumber: 10, Reason: "Listeria", CreatedAt: "2022-08-01 10:00:00"}, report.Recall)
+	assert.Len(t, report.Locations, 2)
+	assert.Equal(t, []db.Buyer{
+		{Id: 1, CardNumberId: "111", FirstName: "Ana", LastName: "Lima"},
+		{Id: 2, CardNumberId: "222", FirstName: "Bruno", LastName: "Souza"},
+	}, report.Buyers)
+
+	trace, err := service.Trace(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &report.Recall, trace.Recall)
+
+	util.DropDB(database)
+}
+
+func Test_Recall_ShouldNotFlagTheBatchWhenTheReportFails(t *testing.T) {
+
+	database := createRecallsDB()
+	util.QueryExec(database, "DROP TABLE inventory_movements")
+	recallRepository := NewRecallRepository(database)
+	service := newRecallService(database, recallRepository)
+
+	_, err := service.Recall(1, "Listeria")
+	assert.NotNil(t, err)
+
+	_, err = recallRepository.GetByBatchNumber(10)
+	assert.Equal(t, RecallNotFoundError, err)
+
+	util.DropDB(database)
+}
+
+func Test_Recall_ShouldReturnAlreadyRecalledError(t *testing.T) {
+
+	created := []db.BatchRecall{}
+
+	service := NewRecallService(nil, nil, products.MockProductRepository{}, nil, mockUnitOfWork(
+		MockRecallRepository{Recall: db.BatchRecall{Id: 1, ProductBatchId: 1, BatchNumber: 10}, Created: &created},
+		batches.MockProductBatchesRepository{GetById: db.ProductBatch{Id: 2, Number: 10, ProductId: 1}},
+	))
+
+	_, err := service.Recall(2, "Listeria")
+
+	assert.Equal(t, AlreadyRecalledError, err)
+	assert.Empty(t, created)
+}
+
+func Test_Recall_ShouldReturnProductBatchNotFoundError(t *testing.T) {
+
+	service := NewRecallService(nil, nil, products.MockProductRepository{}, nil, mockUnitOfWork(MockRecallRepository{}, batches.MockProductBatchesRepository{}))
+
+	_, err := service.Recall(1, "Listeria")
+
+	assert.Equal(t, ProductBatchNotFoundError, err)
+}
+
+func Test_Recall_ShouldReturnRepositoryError(t *testing.T) {
+
+	service := NewRecallService(nil, nil, products.MockProductRepository{}, nil, mockUnitOfWork(
+		MockRecallRepository{Err: errors.New("connection lost")},
+		batches.MockProductBatchesRepository{GetById: db.ProductBatch{Id: 1, Number: 10, ProductId: 1}},
+	))
+
+	_, err := service.Recall(1, "Listeria")
+
+	assert.EqualError(t, err, "connection lost")
+}
+
+func newRecallService(database *sql.DB, recallRepository RecallRepository) RecallService {
+	return &recallService{
+		recallRepository:  recallRepository,
+		batchRepository:   batches.NewProductBatchRepository(database),
+		productRepository: products.NewProductRepository(database),
+		sellerRepository:  sellers.NewRepository(database),
+		unitOfWork: uow.New(database, func(q db.Querier) Repositories {
+			return Repositories{
+				Recalls:        NewRecallRepository(q),
+				ProductBatches: batches.NewProductBatchRepository(q),
+			}
+		}),
+		now: func() time.Time {
+			return time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+		},
+	}
+}
+
+func mockUnitOfWork(recallRepository RecallRepository, batchRepository batches.ProductBatchRepository) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Recalls:        recallRepository,
+			ProductBatches: batchRepository,
+		},
+	}
+}
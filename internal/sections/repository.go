@@ -5,36 +5,61 @@ import (
 	"log"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 )
 
 type SectionRepository interface {
-	GetAll() ([]database.Section, error)
+	GetAll(params query.Params) (query.Page[database.Section], error)
 	Get(id uint64) (database.Section, error)
 	Update(updatedSection database.Section) (database.Section, error)
 	Delete(id uint64) error
 	ExistsSectionNumber(number uint64) (bool, error)
+	IncreaseCurrentCapacity(id uint64, quantity uint64) error
+	DecreaseCurrentCapacity(id uint64, quantity uint64) error
+	UpdateCurrentTemperature(id uint64, temperature float32) error
 
 	Create(number uint64, currentTemperature float32, minimumTemperature float32, currentCapacity uint32,
 		minimumCapacity uint32, maximumCapacity uint32, warehouseId uint64, productTypeId uint64) (database.Section, error)
 }
 
+var sectionsQuery = query.NewBuilder(
+	"sections",
+	"id, section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id",
+	map[string]string{
+		"id":                  "id",
+		"section_number":      "section_number",
+		"current_temperature": "current_temperature",
+		"minimum_temperature": "minimum_temperature",
+		"current_capacity":    "current_capacity",
+		"minimum_capacity":    "minimum_capacity",
+		"maximum_capacity":    "maximum_capacity",
+		"warehouse_id":        "warehouse_id",
+		"product_type_id":     "product_type",
+	},
+)
+
 type sectionRepository struct {
-	db *sql.DB
+	db database.Querier
 }
 
-func NewRepository(db *sql.DB) SectionRepository {
+func NewRepository(db database.Querier) SectionRepository {
 	return &sectionRepository{
 		db: db,
 	}
 }
 
-func (r *sectionRepository) GetAll() ([]database.Section, error) {
+func (r *sectionRepository) GetAll(params query.Params) (query.Page[database.Section], error) {
+
+	statement, args, err := sectionsQuery.Build(params)
+	if err != nil {
+		return query.Page[database.Section]{}, err
+	}
 
-	rows, err := r.db.Query("SELECT * FROM sections")
+	rows, err := r.db.Query(statement, args...)
 
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return query.Page[database.Section]{}, err
 	}
 
 	defer rows.Close()
@@ -57,14 +82,14 @@ func (r *sectionRepository) GetAll() ([]database.Section, error) {
 		)
 
 		if err != nil {
-			log.Fatal(err)
-			return nil, err
+			log.Println(err)
+			return query.Page[database.Section]{}, err
 		}
 
 		sections = append(sections, section)
 	}
 
-	return sections, nil
+	return query.NewPage(sections, params)
 }
 
 func (r *sectionRepository) Get(id uint64) (database.Section, error) {
@@ -77,6 +102,8 @@ func (r *sectionRepository) Get(id uint64) (database.Section, error) {
 		return section, err
 	}
 
+	defer rows.Close()
+
 	for rows.Next() {
 
 		err := rows.Scan(
@@ -245,3 +272,45 @@ func (r *sectionRepository) ExistsSectionNumber(number uint64) (bool, error) {
 
 	return false, nil
 }
+
+// IncreaseCurrentCapacity adds quantity to the capacity in use of the section.
+// The maximum capacity is checked in the update itself, so two concurrent
+// calls can not both pass the check and overflow the section.
+func (r *sectionRepository) IncreaseCurrentCapacity(id uint64, quantity uint64) error {
+	result, err := r.db.Exec(
+		"UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ? AND current_capacity + ? <= maximum_capacity",
+		quantity, id, quantity,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := result.RowsAffected(); affected != 1 {
+		return ErrSectionCapacityExceededError
+	}
+
+	return nil
+}
+
+// DecreaseCurrentCapacity frees quantity of the capacity in use of the
+// section, failing when the section holds less than that.
+func (r *sectionRepository) DecreaseCurrentCapacity(id uint64, quantity uint64) error {
+	result, err := r.db.Exec(
+		"UPDATE sections SET current_capacity = current_capacity - ? WHERE id = ? AND current_capacity >= ?",
+		quantity, id, quantity,
+	)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := result.RowsAffected(); affected != 1 {
+		return ErrSectionCapacityUnderflowError
+	}
+
+	return nil
+}
+
+func (r *sectionRepository) UpdateCurrentTemperature(id uint64, temperature float32) error {
+	_, err := r.db.Exec("UPDATE sections SET current_temperature = ? WHERE id = ?", temperature, id)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 )
 
 type MockSectionRepository struct {
@@ -11,13 +12,14 @@ type MockSectionRepository struct {
 	err                 error
 	existsSectionNumber bool
 	GetById             db.Section
+	UpdateErr           error
 }
 
-func (m MockSectionRepository) GetAll() ([]db.Section, error) {
+func (m MockSectionRepository) GetAll(params query.Params) (query.Page[db.Section], error) {
 	if m.err != nil {
-		return []db.Section{}, m.err
+		return query.Page[db.Section]{}, m.err
 	}
-	return m.Result.([]db.Section), nil
+	return query.NewPage(m.Result.([]db.Section), params)
 }
 
 func (m MockSectionRepository) Get(id uint64) (db.Section, error) {
@@ -55,3 +57,15 @@ func (m MockSectionRepository) Update(updatedSection db.Section) (db.Section, er
 	}
 	return db.Section{}, m.err
 }
+
+func (m MockSectionRepository) IncreaseCurrentCapacity(id uint64, quantity uint64) error {
+	return m.UpdateErr
+}
+
+func (m MockSectionRepository) DecreaseCurrentCapacity(id uint64, quantity uint64) error {
+	return m.UpdateErr
+}
+
+func (m MockSectionRepository) UpdateCurrentTemperature(id uint64, temperature float32) error {
+	return m.UpdateErr
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -124,8 +125,8 @@ func Test_Repo_GetAll_OK(t *testing.T) {
 	_, err = repository.Create(2, 22.2, 1.0, 100, 10, 100, 1, 1)
 	assert.Nil(t, err)
 
-	foundSections, _ := repository.GetAll()
-	assert.Equal(t, expectedCountRows, len(foundSections))
+	foundSections, _ := repository.GetAll(query.Params{})
+	assert.Equal(t, expectedCountRows, len(foundSections.Items))
 
 	util.DropDB(database)
 }
@@ -138,7 +139,7 @@ func Test_Repo_GetAll_ConnectionError(t *testing.T) {
 	repository := NewRepository(database)
 
 	database.Close()
-	_, err := repository.GetAll()
+	_, err := repository.GetAll(query.Params{})
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -288,6 +289,96 @@ func Test_Repo_ExistsSectionNumber_ConnectionError(t *testing.T) {
 	util.DropDB(database)
 }
 
+func Test_Repo_IncreaseCurrentCapacity_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTION_TABLE)
+
+	repository := NewRepository(database)
+	_, err := repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.IncreaseCurrentCapacity(1, 40)
+	assert.Nil(t, err)
+
+	foundSection, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(100), foundSection.CurrentCapacity)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_IncreaseCurrentCapacity_ShouldReturnCapacityExceededError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTION_TABLE)
+
+	repository := NewRepository(database)
+	_, err := repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.IncreaseCurrentCapacity(1, 41)
+	assert.Equal(t, ErrSectionCapacityExceededError, err)
+
+	foundSection, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(60), foundSection.CurrentCapacity)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_IncreaseCurrentCapacity_ConnectionError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTION_TABLE)
+
+	repository := NewRepository(database)
+
+	database.Close()
+	err := repository.IncreaseCurrentCapacity(1, 1)
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_DecreaseCurrentCapacity_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTION_TABLE)
+
+	repository := NewRepository(database)
+	_, err := repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.DecreaseCurrentCapacity(1, 40)
+	assert.Nil(t, err)
+
+	foundSection, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(20), foundSection.CurrentCapacity)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_DecreaseCurrentCapacity_ShouldReturnCapacityUnderflowError(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTION_TABLE)
+
+	repository := NewRepository(database)
+	_, err := repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1)
+	assert.Nil(t, err)
+
+	err = repository.DecreaseCurrentCapacity(1, 61)
+	assert.Equal(t, ErrSectionCapacityUnderflowError, err)
+
+	foundSection, err := repository.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(60), foundSection.CurrentCapacity)
+
+	util.DropDB(database)
+}
+
 const CREATE_SECTION_TABLE = `
 	CREATE TABLE "sections" (
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -4,16 +4,20 @@ import (
 	"errors"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/imdario/mergo"
 )
 
 var (
-	ErrExistsSectionNumberError = errors.New("section number already exists")
-	ErrSectionNotFoundError     = errors.New("section not found")
+	ErrExistsSectionNumberError      = errors.New("section number already exists")
+	ErrSectionNotFoundError          = errors.New("section not found")
+	ErrSectionCapacityExceededError  = errors.New("section maximum capacity exceeded")
+	ErrSectionCapacityUnderflowError = errors.New("section holds less than the quantity taken out of it")
+	ErrInvalidSectionCapacityError   = errors.New("section current and minimum capacity must not exceed its maximum capacity")
 )
 
 type SectionService interface {
-	GetAll() ([]db.Section, error)
+	GetAll(params query.Params) (query.Page[db.Section], error)
 	Get(id uint64) (db.Section, error)
 	Delete(id uint64) error
 	ExistsSectionNumber(number uint64) (bool, error)
@@ -35,8 +39,8 @@ func NewService(r SectionRepository) SectionService {
 	}
 }
 
-func (s *sectionService) GetAll() ([]db.Section, error) {
-	return s.sectionRepository.GetAll()
+func (s *sectionService) GetAll(params query.Params) (query.Page[db.Section], error) {
+	return s.sectionRepository.GetAll(params)
 }
 
 func (s *sectionService) Get(id uint64) (db.Section, error) {
@@ -59,6 +63,10 @@ func (s *sectionService) Create(
 		return db.Section{}, ErrExistsSectionNumberError
 	}
 
+	if !validCapacity(currentCapacity, minimumCapacity, maximumCapacity) {
+		return db.Section{}, ErrInvalidSectionCapacityError
+	}
+
 	section, err := s.sectionRepository.Create(
 		number, currentTemperature, minimumTemperature, currentCapacity,
 		minimumCapacity, maximumCapacity, warehouseId, productTypeId,
@@ -106,6 +114,10 @@ func (s *sectionService) Update(
 		return db.Section{}, err
 	}
 
+	if !validCapacity(foundSection.CurrentCapacity, foundSection.MinimumCapacity, foundSection.MaximumCapacity) {
+		return db.Section{}, ErrInvalidSectionCapacityError
+	}
+
 	return s.sectionRepository.Update(foundSection)
 }
 
@@ -119,6 +131,12 @@ func (s *sectionService) Delete(id uint64) error {
 	return s.sectionRepository.Delete(id)
 }
 
+// validCapacity tells whether the capacity in use and the minimum capacity
+// of a section fit in its maximum capacity.
+func validCapacity(current uint32, minimum uint32, maximum uint32) bool {
+	return current <= maximum && minimum <= maximum
+}
+
 func (s *sectionService) ExistsSectionNumber(number uint64) (bool, error) {
 	return s.sectionRepository.ExistsSectionNumber(number)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,6 +50,22 @@ func Test_Create_Conflict(t *testing.T) {
 	assert.Equal(t, expectedResult, err)
 }
 
+func Test_Create_ShouldRejectCurrentCapacityAboveMaximum(t *testing.T) {
+
+	service := NewService(MockSectionRepository{Result: db.Section{Id: 4}})
+	_, err := service.Create(4, 99.5, 9.0, 901, 90, 900, 2, 2)
+
+	assert.Equal(t, ErrInvalidSectionCapacityError, err)
+}
+
+func Test_Create_ShouldRejectMinimumCapacityAboveMaximum(t *testing.T) {
+
+	service := NewService(MockSectionRepository{Result: db.Section{Id: 4}})
+	_, err := service.Create(4, 99.5, 9.0, 100, 1000, 900, 2, 2)
+
+	assert.Equal(t, ErrInvalidSectionCapacityError, err)
+}
+
 func Test_GetAll_FindAll(t *testing.T) {
 
 	expectedResult := []db.Section{{}, {}, {}}
@@ -59,9 +76,9 @@ func Test_GetAll_FindAll(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 	assert.Nil(t, err)
-	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, expectedResult, result.Items)
 }
 
 func Test_Get_FindByIdExistent(t *testing.T) {
@@ -175,6 +192,27 @@ func Test_Update_ShouldReturnErrWhenCodeAlreadyExists(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 }
 
+func Test_Update_ShouldCheckCapacityAgainstTheStoredValues(t *testing.T) {
+
+	getById := db.Section{
+		Id:              4,
+		Number:          4,
+		CurrentCapacity: 600,
+		MinimumCapacity: 90,
+		MaximumCapacity: 900,
+	}
+
+	mockRepository := MockSectionRepository{
+		Result:  getById,
+		GetById: getById,
+	}
+
+	service := NewService(mockRepository)
+	_, err := service.Update(4, 0, 0, 0, 0, 0, 500)
+
+	assert.Equal(t, ErrInvalidSectionCapacityError, err)
+}
+
 func Test_Delete_Ok(t *testing.T) {
 
 	mockRepository := MockSectionRepository{
@@ -5,11 +5,11 @@ import (
 	"log"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	_ "github.com/go-sql-driver/mysql"
 )
 
 const (
-	FindAllQuery = "SELECT id, cid, company_name, address, telephone, locality_id FROM sellers"
 	FindOneQuery = "SELECT id, cid, company_name, address, telephone, locality_id FROM sellers WHERE id = ?"
 	CreateQuery  = "INSERT INTO sellers(cid, company_name, address, telephone, locality_id) VALUES(?, ?, ?, ?, ?)"
 	DeleteQuery  = "DELETE FROM sellers WHERE id = ?"
@@ -28,8 +28,21 @@ const (
 	`
 )
 
+var sellersQuery = query.NewBuilder(
+	"sellers",
+	"id, cid, company_name, address, telephone, locality_id",
+	map[string]string{
+		"id":           "id",
+		"cid":          "cid",
+		"company_name": "company_name",
+		"address":      "address",
+		"telephone":    "telephone",
+		"locality_id":  "locality_id",
+	},
+)
+
 type Repository interface {
-	FindAll() ([]database.Seller, error)
+	FindAll(params query.Params) (query.Page[database.Seller], error)
 	Create(cid uint64, companyName string, address string, telephone string, localityId string) (database.Seller, error)
 	FindOne(id uint64) (database.Seller, error)
 	Update(seller database.Seller) (database.Seller, error)
@@ -38,29 +51,36 @@ type Repository interface {
 }
 
 type repository struct {
-	db *sql.DB
+	db database.Querier
 }
 
-func (r *repository) FindAll() ([]database.Seller, error) {
+func (r *repository) FindAll(params query.Params) (query.Page[database.Seller], error) {
 	var sellers []database.Seller
 
-	rows, err := r.db.Query(FindAllQuery)
+	statement, args, err := sellersQuery.Build(params)
+	if err != nil {
+		return query.Page[database.Seller]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
 
 	if err != nil {
-		return []database.Seller{}, err
+		return query.Page[database.Seller]{}, err
 	}
 
+	defer rows.Close()
+
 	for rows.Next() {
 		var seller database.Seller
 		if err := rows.Scan(&seller.Id, &seller.Cid, &seller.CompanyName, &seller.Address, &seller.Telephone, &seller.LocalityId); err != nil {
 			log.Println(err.Error())
-			return []database.Seller{}, err
+			return query.Page[database.Seller]{}, err
 		}
 
 		sellers = append(sellers, seller)
 	}
 
-	return sellers, nil
+	return query.NewPage(sellers, params)
 }
 
 func (r *repository) FindOne(id uint64) (database.Seller, error) {
@@ -157,7 +177,7 @@ func createSeller(id uint64, cid uint64, companyName string, address string, tel
 	}
 }
 
-func NewRepository(db *sql.DB) Repository {
+func NewRepository(db database.Querier) Repository {
 	return &repository{
 		db: db,
 	}
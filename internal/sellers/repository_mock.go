@@ -2,6 +2,7 @@ package sellers
 
 import (
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 )
 
 type mockSellerRepository struct {
@@ -11,11 +12,11 @@ type mockSellerRepository struct {
 	getByID         database.Seller
 }
 
-func (m mockSellerRepository) FindAll() ([]database.Seller, error) {
+func (m mockSellerRepository) FindAll(params query.Params) (query.Page[database.Seller], error) {
 	if m.err != nil {
-		return []database.Seller{}, m.err
+		return query.Page[database.Seller]{}, m.err
 	}
-	return m.result.([]database.Seller), nil
+	return query.NewPage(m.result.([]database.Seller), params)
 }
 
 func (m mockSellerRepository) FindOne(id uint64) (database.Seller, error) {
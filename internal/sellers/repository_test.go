@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 )
 
@@ -93,8 +94,51 @@ func Test_Repo_FindAll_OK(t *testing.T) {
 	_, err = repository.Create(2, "Apple", "Rua Goiás, 1233", "1233265412", "11092001")
 	assert.Nil(t, err)
 
-	foundSellers, _ := repository.FindAll()
-	assert.Equal(t, expectedCountRows, len(foundSellers))
+	foundSellers, _ := repository.FindAll(query.Params{})
+	assert.Equal(t, expectedCountRows, len(foundSellers.Items))
+
+	util.DropDB(database)
+}
+
+func Test_Repo_FindAll_Paginated(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SELLERS_TABLE)
+
+	repository := NewRepository(database)
+
+	_, err := repository.Create(1, "Microsoft", "Rua Pedro Américo, 123", "1233265466", "11065001")
+	assert.Nil(t, err)
+
+	_, err = repository.Create(2, "Apple", "Rua Goiás, 1233", "1233265412", "11092001")
+	assert.Nil(t, err)
+
+	_, err = repository.Create(3, "Google", "Rua Bahia, 10", "1233265499", "11065001")
+	assert.Nil(t, err)
+
+	params := query.Params{Limit: 2, Sort: "company_name"}
+
+	firstPage, err := repository.FindAll(params)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(firstPage.Items))
+	assert.Equal(t, "Apple", firstPage.Items[0].CompanyName)
+	assert.Equal(t, "Google", firstPage.Items[1].CompanyName)
+	assert.NotEmpty(t, firstPage.Meta.NextCursor)
+
+	params.Cursor = firstPage.Meta.NextCursor
+
+	secondPage, err := repository.FindAll(params)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(secondPage.Items))
+	assert.Equal(t, "Microsoft", secondPage.Items[0].CompanyName)
+	assert.Empty(t, secondPage.Meta.NextCursor)
+
+	filtered, err := repository.FindAll(query.Params{Filters: map[string]string{"locality_id": "11065001"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(filtered.Items))
+
+	_, err = repository.FindAll(query.Params{Sort: "unknown"})
+	assert.Equal(t, query.ErrInvalidSort, err)
 
 	util.DropDB(database)
 }
@@ -107,7 +151,7 @@ func Test_Repo_FindAll_ConnectionError(t *testing.T) {
 	repository := NewRepository(database)
 
 	database.Close()
-	_, err := repository.FindAll()
+	_, err := repository.FindAll(query.Params{})
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/imdario/mergo"
 )
 
@@ -13,7 +14,7 @@ var (
 )
 
 type Service interface {
-	FindAll() ([]database.Seller, error)
+	FindAll(params query.Params) (query.Page[database.Seller], error)
 	Create(cid uint64, companyName string, address string, telephone string, localityId string) (database.Seller, error)
 	FindOne(id uint64) (database.Seller, error)
 	Update(id uint64, cid uint64, companyName string, address string, telephone string, localityId string) (database.Seller, error)
@@ -24,11 +25,11 @@ type service struct {
 	repo Repository
 }
 
-func (s service) FindAll() ([]database.Seller, error) {
-	db, err := s.repo.FindAll()
+func (s service) FindAll(params query.Params) (query.Page[database.Seller], error) {
+	db, err := s.repo.FindAll(params)
 
 	if err != nil {
-		return []database.Seller{}, err
+		return query.Page[database.Seller]{}, err
 	}
 
 	return db, err
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,11 +35,11 @@ func Test_FindAll_OK(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.FindAll()
+	result, err := service.FindAll(query.Params{})
 
 	assert.Nil(t, err)
-	assert.Equal(t, expectedResult, result)
-	assert.Equal(t, len(expectedResult), len(result))
+	assert.Equal(t, expectedResult, result.Items)
+	assert.Equal(t, len(expectedResult), len(result.Items))
 }
 
 func Test_FindAll_Error(t *testing.T) {
@@ -52,9 +53,9 @@ func Test_FindAll_Error(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.FindAll()
+	result, err := service.FindAll(query.Params{})
 
-	assert.Equal(t, expectedResult, result)
+	assert.Empty(t, result.Items)
 	assert.Equal(t, expectedError, err)
 }
 
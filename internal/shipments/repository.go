@@ -0,0 +1,225 @@
+package shipments
+
+import (
+	"database/sql"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type ShipmentRepository interface {
+	Create(shipment db.Shipment) (db.Shipment, error)
+	Get(id uint64) (db.Shipment, error)
+	GetAll(params query.Params) (query.Page[db.Shipment], error)
+	ExistsPurchaseOrder(purchaseOrderId uint64) (bool, error)
+	ExistsTrackingCode(trackingCode string) (bool, error)
+	GetByTrackingCode(trackingCode string) (db.Shipment, error)
+	GetByPurchaseOrder(purchaseOrderId uint64) (db.Shipment, error)
+	CreateEvent(event db.TrackingEvent) (db.TrackingEvent, error)
+	// GetEvents returns the events of the shipment by the time they occurred.
+	GetEvents(shipmentId uint64) ([]db.TrackingEvent, error)
+}
+
+type shipmentRepository struct {
+	db db.Querier
+}
+
+func NewShipmentRepository(database db.Querier) ShipmentRepository {
+	return &shipmentRepository{
+		db: database,
+	}
+}
+
+const shipmentColumns = "id, purchase_order_id, carrier_id, warehouse_id, locality_id, tracking_code, created_at"
+
+var shipmentsQuery = query.NewBuilder(
+	"shipments",
+	shipmentColumns,
+	map[string]string{
+		"id":                "id",
+		"purchase_order_id": "purchase_order_id",
+		"carrier_id":        "carrier_id",
+		"warehouse_id":      "warehouse_id",
+		"locality_id":       "locality_id",
+		"tracking_code":     "tracking_code",
+		"created_at":        "created_at",
+	},
+)
+
+func (r *shipmentRepository) Create(shipment db.Shipment) (db.Shipment, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO shipments(purchase_order_id, carrier_id, warehouse_id, locality_id, tracking_code, created_at)
+		VALUES(?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.Shipment{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+	result, err = stmt.Exec(
+		shipment.PurchaseOrderId,
+		shipment.CarrierId,
+		shipment.WarehouseId,
+		shipment.LocalityId,
+		shipment.TrackingCode,
+		shipment.CreatedAt,
+	)
+	if err != nil {
+		return db.Shipment{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	shipment.Id = uint64(insertedId)
+
+	return shipment, nil
+}
+
+func (r *shipmentRepository) Get(id uint64) (db.Shipment, error) {
+	var shipment db.Shipment
+
+	row := r.db.QueryRow("SELECT "+shipmentColumns+" FROM shipments WHERE id = ?", id)
+
+	err := scanShipment(row, &shipment)
+	if err == sql.ErrNoRows {
+		return db.Shipment{}, ShipmentNotFoundError
+	}
+
+	return shipment, err
+}
+
+func (r *shipmentRepository) GetByTrackingCode(trackingCode string) (db.Shipment, error) {
+	return r.getBy("tracking_code = ?", trackingCode)
+}
+
+func (r *shipmentRepository) GetByPurchaseOrder(purchaseOrderId uint64) (db.Shipment, error) {
+	return r.getBy("purchase_order_id = ?", purchaseOrderId)
+}
+
+func (r *shipmentRepository) getBy(condition string, arg any) (db.Shipment, error) {
+	var shipment db.Shipment
+
+	row := r.db.QueryRow("SELECT "+shipmentColumns+" FROM shipments WHERE "+condition, arg)
+
+	err := scanShipment(row, &shipment)
+	if err == sql.ErrNoRows {
+		return db.Shipment{}, ShipmentNotFoundError
+	}
+
+	return shipment, err
+}
+
+func (r *shipmentRepository) GetAll(params query.Params) (query.Page[db.Shipment], error) {
+	statement, args, err := shipmentsQuery.Build(params)
+	if err != nil {
+		return query.Page[db.Shipment]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[db.Shipment]{}, err
+	}
+
+	defer rows.Close()
+
+	var shipments []db.Shipment
+
+	for rows.Next() {
+		var shipment db.Shipment
+
+		if err := scanShipment(rows, &shipment); err != nil {
+			return query.Page[db.Shipment]{}, err
+		}
+
+		shipments = append(shipments, shipment)
+	}
+
+	return query.NewPage(shipments, params)
+}
+
+func (r *shipmentRepository) ExistsPurchaseOrder(purchaseOrderId uint64) (bool, error) {
+	var count uint64
+	err := r.db.QueryRow("SELECT COUNT(*) FROM shipments WHERE purchase_order_id = ?", purchaseOrderId).Scan(&count)
+	return count > 0, err
+}
+
+func (r *shipmentRepository) ExistsTrackingCode(trackingCode string) (bool, error) {
+	var count uint64
+	err := r.db.QueryRow("SELECT COUNT(*) FROM shipments WHERE tracking_code = ?", trackingCode).Scan(&count)
+	return count > 0, err
+}
+
+func (r *shipmentRepository) CreateEvent(event db.TrackingEvent) (db.TrackingEvent, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO tracking_events(shipment_id, event_type, location, occurred_at, created_at)
+		VALUES(?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.TrackingEvent{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+	result, err = stmt.Exec(event.ShipmentId, event.Type, event.Location, event.OccurredAt, event.CreatedAt)
+	if err != nil {
+		return db.TrackingEvent{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	event.Id = uint64(insertedId)
+
+	return event, nil
+}
+
+func (r *shipmentRepository) GetEvents(shipmentId uint64) ([]db.TrackingEvent, error) {
+	rows, err := r.db.Query(`
+		SELECT id, shipment_id, event_type, location, occurred_at, created_at
+		FROM tracking_events
+		WHERE shipment_id = ?
+		ORDER BY occurred_at, id`, shipmentId,
+	)
+	if err != nil {
+		return []db.TrackingEvent{}, err
+	}
+
+	defer rows.Close()
+
+	events := []db.TrackingEvent{}
+
+	for rows.Next() {
+		var event db.TrackingEvent
+
+		if err := rows.Scan(
+			&event.Id,
+			&event.ShipmentId,
+			&event.Type,
+			&event.Location,
+			&event.OccurredAt,
+			&event.CreatedAt,
+		); err != nil {
+			return []db.TrackingEvent{}, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanShipment(row scanner, shipment *db.Shipment) error {
+	return row.Scan(
+		&shipment.Id,
+		&shipment.PurchaseOrderId,
+		&shipment.CarrierId,
+		&shipment.WarehouseId,
+		&shipment.LocalityId,
+		&shipment.TrackingCode,
+		&shipment.CreatedAt,
+	)
+}
@@ -0,0 +1,83 @@
+package shipments
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type MockShipmentRepository struct {
+	Result  any
+	Err     error
+	Shipped bool
+	// UsedCode makes every tracking code already used by a shipment.
+	UsedCode bool
+	// Found is the shipment of every tracking code and purchase order,
+	// none when empty.
+	Found  db.Shipment
+	Events []db.TrackingEvent
+	// Created receives the events passed to CreateEvent.
+	Created *[]db.TrackingEvent
+}
+
+func (m MockShipmentRepository) Create(shipment db.Shipment) (db.Shipment, error) {
+	if m.Err != nil {
+		return db.Shipment{}, m.Err
+	}
+	shipment.Id = 1
+	return shipment, nil
+}
+
+func (m MockShipmentRepository) Get(id uint64) (db.Shipment, error) {
+	if m.Err != nil {
+		return db.Shipment{}, m.Err
+	}
+	return m.Result.(db.Shipment), nil
+}
+
+func (m MockShipmentRepository) GetAll(params query.Params) (query.Page[db.Shipment], error) {
+	if m.Err != nil {
+		return query.Page[db.Shipment]{}, m.Err
+	}
+	return query.NewPage(m.Result.([]db.Shipment), params)
+}
+
+func (m MockShipmentRepository) ExistsPurchaseOrder(purchaseOrderId uint64) (bool, error) {
+	return m.Shipped, nil
+}
+
+func (m MockShipmentRepository) ExistsTrackingCode(trackingCode string) (bool, error) {
+	return m.UsedCode, nil
+}
+
+func (m MockShipmentRepository) GetByTrackingCode(trackingCode string) (db.Shipment, error) {
+	return m.found()
+}
+
+func (m MockShipmentRepository) GetByPurchaseOrder(purchaseOrderId uint64) (db.Shipment, error) {
+	return m.found()
+}
+
+func (m MockShipmentRepository) found() (db.Shipment, error) {
+	if (m.Found == db.Shipment{}) {
+		return db.Shipment{}, ShipmentNotFoundError
+	}
+	return m.Found, nil
+}
+
+func (m MockShipmentRepository) CreateEvent(event db.TrackingEvent) (db.TrackingEvent, error) {
+	if m.Err != nil {
+		return db.TrackingEvent{}, m.Err
+	}
+	event.Id = 1
+	if m.Created != nil {
+		*m.Created = append(*m.Created, event)
+	}
+	return event, nil
+}
+
+func (m MockShipmentRepository) GetEvents(shipmentId uint64) ([]db.TrackingEvent, error) {
+	if m.Err != nil {
+		return []db.TrackingEvent{}, m.Err
+	}
+	return m.Events, nil
+}
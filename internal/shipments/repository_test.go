@@ -0,0 +1,185 @@
+package shipments
+
+import (
+	"database/sql"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Create_Ok(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	shipment := db.Shipment{PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00"}
+
+	created, err := repository.Create(shipment)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), created.Id)
+
+	found, err := repository.Get(created.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, created, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Get_ShouldReturnShipmentNotFoundError(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	found, err := repository.Get(1)
+
+	assert.Equal(t, ShipmentNotFoundError, err)
+	assert.Equal(t, db.Shipment{}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_ShouldFilterByTrackingCode(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	repository.Create(db.Shipment{PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00"})
+	second, _ := repository.Create(db.Shipment{PurchaseOrderId: 2, CarrierId: 2, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK2", CreatedAt: "2022-08-01 11:00:00"})
+
+	found, err := repository.GetAll(query.Params{Filters: map[string]string{"tracking_code": "TRK2"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.Shipment{second}, found.Items)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_ExistsPurchaseOrder(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	repository.Create(db.Shipment{PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00"})
+
+	shipped, err := repository.ExistsPurchaseOrder(1)
+	assert.Nil(t, err)
+	assert.True(t, shipped)
+
+	shipped, err = repository.ExistsPurchaseOrder(2)
+	assert.Nil(t, err)
+	assert.False(t, shipped)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Create_ShouldReturnUniqueViolation(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	repository.Create(db.Shipment{PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00"})
+
+	_, err := repository.Create(db.Shipment{PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK2", CreatedAt: "2022-08-01 10:00:00"})
+	assert.True(t, db.IsUniqueViolation(err))
+
+	_, err = repository.Create(db.Shipment{PurchaseOrderId: 2, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00"})
+	assert.True(t, db.IsUniqueViolation(err))
+	assert.Contains(t, err.Error(), "tracking_code")
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetByTrackingCode(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	created, _ := repository.Create(db.Shipment{PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00"})
+
+	found, err := repository.GetByTrackingCode("TRK1")
+	assert.Nil(t, err)
+	assert.Equal(t, created, found)
+
+	found, err = repository.GetByPurchaseOrder(1)
+	assert.Nil(t, err)
+	assert.Equal(t, created, found)
+
+	_, err = repository.GetByTrackingCode("TRK2")
+	assert.Equal(t, ShipmentNotFoundError, err)
+
+	used, err := repository.ExistsTrackingCode("TRK1")
+	assert.Nil(t, err)
+	assert.True(t, used)
+
+	_, err = repository.Create(db.Shipment{PurchaseOrderId: 2, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 11:00:00"})
+	assert.NotNil(t, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetEvents_ShouldSortByOccurredAt(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	delivered, err := repository.CreateEvent(db.TrackingEvent{ShipmentId: 1, Type: "delivered", Location: "Campinas", OccurredAt: "2022-08-02 15:00:00", CreatedAt: "2022-08-02 15:01:00"})
+	assert.Nil(t, err)
+
+	pickedUp, _ := repository.CreateEvent(db.TrackingEvent{ShipmentId: 1, Type: "picked_up", Location: "Santos", OccurredAt: "2022-08-02 08:00:00", CreatedAt: "2022-08-02 15:02:00"})
+	repository.CreateEvent(db.TrackingEvent{ShipmentId: 2, Type: "picked_up", Location: "Santos", OccurredAt: "2022-08-02 09:00:00", CreatedAt: "2022-08-02 15:03:00"})
+
+	events, err := repository.GetEvents(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TrackingEvent{pickedUp, delivered}, events)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_ConnectionError(t *testing.T) {
+
+	database := createShipmentsDB()
+	repository := NewShipmentRepository(database)
+
+	database.Close()
+	found, err := repository.GetAll(query.Params{})
+
+	assert.NotNil(t, err)
+	assert.Empty(t, found.Items)
+
+	util.DropDB(database)
+}
+
+func createShipmentsDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SHIPMENTS_TABLE)
+	util.QueryExec(database, CREATE_TRACKING_EVENTS_TABLE)
+	return database
+}
+
+const CREATE_SHIPMENTS_TABLE = `
+	CREATE TABLE "shipments"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		purchase_order_id BIGINT NOT NULL UNIQUE,
+		carrier_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		locality_id TEXT NOT NULL,
+		tracking_code TEXT NOT NULL UNIQUE,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_TRACKING_EVENTS_TABLE = `
+	CREATE TABLE "tracking_events"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		shipment_id BIGINT NOT NULL,
+		event_type TEXT NOT NULL,
+		location TEXT NOT NULL,
+		occurred_at TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
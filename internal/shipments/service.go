@@ -0,0 +1,225 @@
+package shipments
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+var (
+	ShipmentNotFoundError      = errors.New("shipment not found")
+	PurchaseOrderNotFoundError = errors.New("purchase order not found")
+	WarehouseNotFoundError     = errors.New("warehouse not found")
+	CarrierNotFoundError       = errors.New("carrier not found")
+	LocalityNotFoundError      = errors.New("locality not found")
+	AlreadyShippedError        = errors.New("purchase order already has a shipment")
+	NoCarrierAvailableError    = errors.New("no carrier available")
+	MissingTrackingCodeError   = errors.New("purchase order has no tracking code")
+	TrackingCodeInUseError     = errors.New("tracking code already used by another shipment")
+	NotApprovedError           = errors.New("purchase order is not approved")
+)
+
+const TimeLayout = "2006-01-02 15:04:05"
+
+type ShipmentService interface {
+	// Create ships the approved purchase order from the warehouse to the
+	// locality, the locality of the warehouse when empty, moving the order
+	// to in transit. Without a carrier id one is assigned: the least busy
+	// carrier of the locality, then of the locality of the warehouse, then
+	// of anywhere.
+	Create(purchaseOrderId uint64, warehouseId uint64, localityId string, carrierId uint64) (db.Shipment, error)
+	Get(id uint64) (db.Shipment, error)
+	GetAll(params query.Params) (query.Page[db.Shipment], error)
+	// RecordEvent stores a scan of the shipment with the tracking code,
+	// moving its purchase order along when the scan says so. A carrier id
+	// other than zero only accepts scans of shipments of that carrier.
+	RecordEvent(trackingCode string, eventType string, location string, occurredAt string, carrierId uint64) (db.TrackingEvent, error)
+	// GetTracking returns the shipment of the purchase order and its scans.
+	GetTracking(purchaseOrderId uint64) (db.TrackingTimeline, error)
+}
+
+// Repositories are the transaction-bound repositories used by Create and
+// RecordEvent.
+type Repositories struct {
+	Shipments      ShipmentRepository
+	PurchaseOrders purchaseOrders.PurchaseOrdersRepository
+}
+
+type shipmentService struct {
+	shipmentRepository      ShipmentRepository
+	carrierRepository       carries.CarrierRepository
+	purchaseOrderRepository purchaseOrders.PurchaseOrdersRepository
+	warehouseRepository     warehouses.WarehouseRepository
+	unitOfWork              uow.UnitOfWork[Repositories]
+	now                     func() time.Time
+}
+
+func NewShipmentService(
+	shipmentRepository ShipmentRepository,
+	carrierRepository carries.CarrierRepository,
+	purchaseOrderRepository purchaseOrders.PurchaseOrdersRepository,
+	warehouseRepository warehouses.WarehouseRepository,
+	unitOfWork uow.UnitOfWork[Repositories],
+) ShipmentService {
+	return &shipmentService{
+		shipmentRepository:      shipmentRepository,
+		carrierRepository:       carrierRepository,
+		purchaseOrderRepository: purchaseOrderRepository,
+		warehouseRepository:     warehouseRepository,
+		unitOfWork:              unitOfWork,
+		now:                     time.Now,
+	}
+}
+
+func (s *shipmentService) Create(purchaseOrderId uint64, warehouseId uint64, localityId string, carrierId uint64) (db.Shipment, error) {
+	var shipment db.Shipment
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		purchaseOrder, err := r.PurchaseOrders.Get(purchaseOrderId)
+		if err != nil {
+			return err
+		}
+
+		if (purchaseOrder == db.PurchaseOrder{}) {
+			return PurchaseOrderNotFoundError
+		}
+
+		shipped, err := r.Shipments.ExistsPurchaseOrder(purchaseOrderId)
+		if err != nil {
+			return err
+		}
+
+		if shipped {
+			return AlreadyShippedError
+		}
+
+		if purchaseOrder.OrderStatusId != purchaseOrders.ApprovedStatusId {
+			return NotApprovedError
+		}
+
+		// Scans find the shipment by its tracking code, so it must be unique.
+		if purchaseOrder.TrackingCode == "" {
+			return MissingTrackingCodeError
+		}
+
+		usedCode, err := r.Shipments.ExistsTrackingCode(purchaseOrder.TrackingCode)
+		if err != nil {
+			return err
+		}
+
+		if usedCode {
+			return TrackingCodeInUseError
+		}
+
+		warehouse, err := s.warehouseRepository.Get(warehouseId)
+		if err == sql.ErrNoRows || (err == nil && warehouse == db.Warehouse{}) {
+			return WarehouseNotFoundError
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if localityId == "" {
+			localityId = warehouse.LocalityID
+		} else if !s.carrierRepository.FindLocalityId(localityId) {
+			return LocalityNotFoundError
+		}
+
+		carrier, err := s.carrier(carrierId, localityId, warehouse.LocalityID)
+		if err != nil {
+			return err
+		}
+
+		changedAt := s.now().UTC().Format(TimeLayout)
+
+		shipment, err = r.Shipments.Create(db.Shipment{
+			PurchaseOrderId: purchaseOrder.Id,
+			CarrierId:       carrier.Id,
+			WarehouseId:     warehouse.Id,
+			LocalityId:      localityId,
+			TrackingCode:    purchaseOrder.TrackingCode,
+			CreatedAt:       changedAt,
+		})
+
+		// A concurrent request shipped the order, or took the tracking
+		// code, after the checks above.
+		if db.IsUniqueViolation(err) {
+			if strings.Contains(err.Error(), "tracking_code") {
+				return TrackingCodeInUseError
+			}
+			return AlreadyShippedError
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return ship(r.PurchaseOrders, purchaseOrder.Id, changedAt)
+	})
+
+	if err != nil {
+		return db.Shipment{}, err
+	}
+
+	return shipment, nil
+}
+
+// ship moves an approved purchase order to in transit, keeping the change
+// in its status history.
+func ship(repository purchaseOrders.PurchaseOrdersRepository, purchaseOrderId uint64, changedAt string) error {
+	inTransit, err := purchaseOrders.NextStatus(purchaseOrders.ApprovedStatusId, purchaseOrders.ShipAction)
+	if err != nil {
+		return err
+	}
+
+	// The order left approved after it was read, cancelled for instance.
+	err = repository.UpdateStatus(purchaseOrderId, purchaseOrders.ApprovedStatusId, inTransit)
+	if err == purchaseOrders.IllegalTransitionError {
+		return NotApprovedError
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return repository.CreateStatusHistory(purchaseOrderId, purchaseOrders.ApprovedStatusId, inTransit, purchaseOrders.ShipAction, changedAt)
+}
+
+func (s *shipmentService) Get(id uint64) (db.Shipment, error) {
+	return s.shipmentRepository.Get(id)
+}
+
+func (s *shipmentService) GetAll(params query.Params) (query.Page[db.Shipment], error) {
+	return s.shipmentRepository.GetAll(params)
+}
+
+// carrier returns the chosen carrier, or the least busy one of the first
+// locality that has any, ending with every locality.
+func (s *shipmentService) carrier(carrierId uint64, localityIds ...string) (db.Carrier, error) {
+	if carrierId != 0 {
+		carrier, err := s.carrierRepository.Get(carrierId)
+		if err == sql.ErrNoRows {
+			return db.Carrier{}, CarrierNotFoundError
+		}
+		return carrier, err
+	}
+
+	for _, localityId := range append(localityIds, "") {
+		carrier, err := s.carrierRepository.FindAvailable(localityId)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		return carrier, err
+	}
+
+	return db.Carrier{}, NoCarrierAvailableError
+}
@@ -0,0 +1,235 @@
+package shipments
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	order     = db.PurchaseOrder{Id: 1, OrderNumber: "PO1", TrackingCode: "TRK1", BuyerId: 1, OrderStatusId: purchaseOrders.ApprovedStatusId}
+	warehouse = db.Warehouse{Id: 1, Code: "W1", LocalityID: "11065001"}
+	rapido    = db.Carrier{Id: 1, Cid: "CID1", CompanyName: "Rapido", LocalityID: "11065001"}
+	lento     = db.Carrier{Id: 3, Cid: "CID3", CompanyName: "Lento", LocalityID: "10235001"}
+)
+
+func newService(shipments MockShipmentRepository, carriers carries.MockCarrierRepository) ShipmentService {
+	return newCreateService(shipments, carriers, order, warehouse, nil)
+}
+
+func newCreateService(
+	shipments MockShipmentRepository,
+	carriers carries.MockCarrierRepository,
+	purchaseOrder db.PurchaseOrder,
+	warehouse db.Warehouse,
+	history *[]db.PurchaseOrderStatusHistory,
+) ShipmentService {
+	purchaseOrderRepository := purchaseOrders.MockPurchaseOrdersRepository{GetById: purchaseOrder, Recorded: history}
+
+	service := NewShipmentService(
+		shipments,
+		carriers,
+		purchaseOrderRepository,
+		warehouses.MockWarehouseRepository{GetById: warehouse},
+		uow.MockUnitOfWork[Repositories]{
+			Repositories: Repositories{Shipments: shipments, PurchaseOrders: purchaseOrderRepository},
+		},
+	).(*shipmentService)
+
+	service.now = func() time.Time { return time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC) }
+
+	return service
+}
+
+func Test_Create_ShouldPreferACarrierOfTheDeliveryLocality(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		HasLocality: true,
+		Available:   map[string]db.Carrier{"10235001": lento, "11065001": rapido, "": rapido},
+	})
+
+	shipment, err := service.Create(1, 1, "10235001", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.Shipment{
+		Id: 1, PurchaseOrderId: 1, CarrierId: 3, WarehouseId: 1, LocalityId: "10235001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 10:00:00",
+	}, shipment)
+}
+
+func Test_Create_ShouldFallBackToACarrierOfTheWarehouseLocality(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		HasLocality: true,
+		Available:   map[string]db.Carrier{"11065001": rapido, "": lento},
+	})
+
+	shipment, err := service.Create(1, 1, "10235001", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), shipment.CarrierId)
+	assert.Equal(t, "10235001", shipment.LocalityId)
+}
+
+func Test_Create_ShouldFallBackToAnyCarrier(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		Available: map[string]db.Carrier{"": lento},
+	})
+
+	shipment, err := service.Create(1, 1, "", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), shipment.CarrierId)
+	assert.Equal(t, "11065001", shipment.LocalityId)
+}
+
+func Test_Create_ShouldUseTheChosenCarrier(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		GetById:   lento,
+		Available: map[string]db.Carrier{"11065001": rapido},
+	})
+
+	shipment, err := service.Create(1, 1, "", 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), shipment.CarrierId)
+}
+
+func Test_Create_ShouldReturnCarrierNotFoundError(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{})
+
+	_, err := service.Create(1, 1, "", 9)
+
+	assert.Equal(t, CarrierNotFoundError, err)
+}
+
+func Test_Create_ShouldReturnNoCarrierAvailableError(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{})
+
+	_, err := service.Create(1, 1, "", 0)
+
+	assert.Equal(t, NoCarrierAvailableError, err)
+}
+
+func Test_Create_ShouldReturnAlreadyShippedError(t *testing.T) {
+
+	service := newService(MockShipmentRepository{Shipped: true}, carries.MockCarrierRepository{
+		Available: map[string]db.Carrier{"": rapido},
+	})
+
+	_, err := service.Create(1, 1, "", 0)
+
+	assert.Equal(t, AlreadyShippedError, err)
+}
+
+func Test_Create_ShouldReturnTrackingCodeInUseError(t *testing.T) {
+
+	service := newService(MockShipmentRepository{UsedCode: true}, carries.MockCarrierRepository{
+		Available: map[string]db.Carrier{"": rapido},
+	})
+
+	_, err := service.Create(1, 1, "", 0)
+
+	assert.Equal(t, TrackingCodeInUseError, err)
+}
+
+func Test_Create_ShouldReturnMissingTrackingCodeError(t *testing.T) {
+
+	untracked := order
+	untracked.TrackingCode = ""
+
+	service := newCreateService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		Available: map[string]db.Carrier{"": rapido},
+	}, untracked, warehouse, nil)
+
+	_, err := service.Create(1, 1, "", 0)
+
+	assert.Equal(t, MissingTrackingCodeError, err)
+}
+
+func Test_Create_ShouldReturnLocalityNotFoundError(t *testing.T) {
+
+	service := newService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		HasLocality: false,
+		Available:   map[string]db.Carrier{"": rapido},
+	})
+
+	_, err := service.Create(1, 1, "99999999", 0)
+
+	assert.Equal(t, LocalityNotFoundError, err)
+}
+
+func Test_Create_ShouldReturnPurchaseOrderNotFoundError(t *testing.T) {
+
+	service := newCreateService(MockShipmentRepository{}, carries.MockCarrierRepository{}, db.PurchaseOrder{}, warehouse, nil)
+
+	_, err := service.Create(9, 1, "", 0)
+
+	assert.Equal(t, PurchaseOrderNotFoundError, err)
+}
+
+func Test_Create_ShouldReturnWarehouseNotFoundError(t *testing.T) {
+
+	service := newCreateService(MockShipmentRepository{}, carries.MockCarrierRepository{}, order, db.Warehouse{}, nil)
+
+	_, err := service.Create(1, 9, "", 0)
+
+	assert.Equal(t, WarehouseNotFoundError, err)
+}
+
+func Test_Create_ShouldShipThePurchaseOrder(t *testing.T) {
+
+	var history []db.PurchaseOrderStatusHistory
+	service := newCreateService(MockShipmentRepository{}, carries.MockCarrierRepository{
+		Available: map[string]db.Carrier{"": rapido},
+	}, order, warehouse, &history)
+
+	_, err := service.Create(1, 1, "", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.PurchaseOrderStatusHistory{
+		{PurchaseOrderId: 1, FromStatusId: purchaseOrders.ApprovedStatusId, ToStatusId: purchaseOrders.InTransitStatusId, Action: purchaseOrders.ShipAction, ChangedAt: "2022-08-01 10:00:00"},
+	}, history)
+}
+
+func Test_Create_ShouldReturnNotApprovedError(t *testing.T) {
+
+	for _, statusId := range []uint64{
+		purchaseOrders.PendingStatusId, purchaseOrders.RejectedStatusId, purchaseOrders.CancelledStatusId,
+		purchaseOrders.InTransitStatusId, purchaseOrders.DeliveredStatusId,
+	} {
+		pending := order
+		pending.OrderStatusId = statusId
+
+		var history []db.PurchaseOrderStatusHistory
+		service := newCreateService(MockShipmentRepository{}, carries.MockCarrierRepository{
+			Available: map[string]db.Carrier{"": rapido},
+		}, pending, warehouse, &history)
+
+		_, err := service.Create(1, 1, "", 0)
+
+		assert.Equal(t, NotApprovedError, err)
+		assert.Empty(t, history)
+	}
+}
+
+func Test_Create_ShouldReturnAlreadyShippedErrorOnConcurrentShipment(t *testing.T) {
+
+	service := newService(MockShipmentRepository{Err: sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}}, carries.MockCarrierRepository{
+		Available: map[string]db.Carrier{"": rapido},
+	})
+
+	_, err := service.Create(1, 1, "", 0)
+
+	assert.Equal(t, AlreadyShippedError, err)
+}
@@ -0,0 +1,152 @@
+package shipments
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+)
+
+const (
+	PickedUpEvent       = "picked_up"
+	InTransitEvent      = "in_transit"
+	OutForDeliveryEvent = "out_for_delivery"
+	DeliveredEvent      = "delivered"
+	FailedEvent         = "failed"
+)
+
+var (
+	UnknownEventTypeError  = errors.New("event_type must be picked_up, in_transit, out_for_delivery, delivered or failed")
+	InvalidOccurredAtError = errors.New("occurred_at must be a date time, e.g. 2022-08-01 10:00:00 or 2022-08-01T10:00:00Z")
+	ShipmentClosedError    = errors.New("purchase order of the shipment is not approved nor in transit")
+	ForeignShipmentError   = errors.New("shipment belongs to another carrier")
+)
+
+// eventActions are the purchase order transitions each event applies, in
+// order. A transition the order already went through is skipped, so a
+// delivered scan also ships an order whose pick up was never scanned.
+var eventActions = map[string][]string{
+	PickedUpEvent:       {purchaseOrders.ShipAction},
+	InTransitEvent:      {purchaseOrders.ShipAction},
+	OutForDeliveryEvent: {purchaseOrders.ShipAction},
+	DeliveredEvent:      {purchaseOrders.ShipAction, purchaseOrders.DeliverAction},
+	FailedEvent:         {},
+}
+
+func (s *shipmentService) RecordEvent(trackingCode string, eventType string, location string, occurredAt string, carrierId uint64) (db.TrackingEvent, error) {
+	actions, ok := eventActions[eventType]
+	if !ok {
+		return db.TrackingEvent{}, UnknownEventTypeError
+	}
+
+	now := s.now().UTC()
+	occurred := now
+
+	if occurredAt != "" {
+		parsed, err := parseTime(occurredAt)
+		if err != nil {
+			return db.TrackingEvent{}, InvalidOccurredAtError
+		}
+		occurred = parsed.UTC()
+	}
+
+	var event db.TrackingEvent
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		shipment, err := r.Shipments.GetByTrackingCode(trackingCode)
+		if err != nil {
+			return err
+		}
+
+		if carrierId != 0 && shipment.CarrierId != carrierId {
+			return ForeignShipmentError
+		}
+
+		purchaseOrder, err := r.PurchaseOrders.Get(shipment.PurchaseOrderId)
+		if err != nil {
+			return err
+		}
+
+		statusId := purchaseOrder.OrderStatusId
+		if statusId != purchaseOrders.ApprovedStatusId && statusId != purchaseOrders.InTransitStatusId {
+			return ShipmentClosedError
+		}
+
+		changedAt := now.Format(TimeLayout)
+
+		for _, action := range actions {
+			nextStatusId, err := purchaseOrders.NextStatus(statusId, action)
+			if err == purchaseOrders.IllegalTransitionError {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if err := r.PurchaseOrders.UpdateStatus(purchaseOrder.Id, statusId, nextStatusId); err != nil {
+				return err
+			}
+
+			if err := r.PurchaseOrders.CreateStatusHistory(purchaseOrder.Id, statusId, nextStatusId, action, changedAt); err != nil {
+				return err
+			}
+
+			statusId = nextStatusId
+		}
+
+		event, err = r.Shipments.CreateEvent(db.TrackingEvent{
+			ShipmentId: shipment.Id,
+			Type:       eventType,
+			Location:   location,
+			OccurredAt: occurred.Format(TimeLayout),
+			CreatedAt:  changedAt,
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return db.TrackingEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (s *shipmentService) GetTracking(purchaseOrderId uint64) (db.TrackingTimeline, error) {
+	purchaseOrder, err := s.purchaseOrderRepository.Get(purchaseOrderId)
+	if err != nil {
+		return db.TrackingTimeline{}, err
+	}
+
+	if (purchaseOrder == db.PurchaseOrder{}) {
+		return db.TrackingTimeline{}, purchaseOrders.PurchaseOrderNotFoundError
+	}
+
+	shipment, err := s.shipmentRepository.GetByPurchaseOrder(purchaseOrderId)
+	if err != nil {
+		return db.TrackingTimeline{}, err
+	}
+
+	events, err := s.shipmentRepository.GetEvents(shipment.Id)
+	if err != nil {
+		return db.TrackingTimeline{}, err
+	}
+
+	return db.TrackingTimeline{
+		PurchaseOrderId: purchaseOrder.Id,
+		OrderStatusId:   purchaseOrder.OrderStatusId,
+		Shipment:        shipment,
+		Events:          events,
+	}, nil
+}
+
+// parseTime reads a timestamp in TimeLayout or RFC 3339. Timestamps without
+// a zone are taken as UTC.
+func parseTime(value string) (time.Time, error) {
+	if parsed, err := time.Parse(TimeLayout, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,201 @@
+package shipments
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/carries"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/purchaseOrders"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/warehouses"
+	"github.com/stretchr/testify/assert"
+)
+
+var shipped = db.Shipment{Id: 7, PurchaseOrderId: 1, CarrierId: 1, WarehouseId: 1, LocalityId: "11065001", TrackingCode: "TRK1", CreatedAt: "2022-08-01 09:00:00"}
+
+func newTrackingService(shipments MockShipmentRepository, statusId uint64, history *[]db.PurchaseOrderStatusHistory) ShipmentService {
+	purchaseOrder := order
+	purchaseOrder.OrderStatusId = statusId
+
+	purchaseOrderRepository := purchaseOrders.MockPurchaseOrdersRepository{GetById: purchaseOrder, Recorded: history}
+
+	service := NewShipmentService(
+		shipments,
+		carries.MockCarrierRepository{},
+		purchaseOrderRepository,
+		warehouses.MockWarehouseRepository{GetById: warehouse},
+		uow.MockUnitOfWork[Repositories]{
+			Repositories: Repositories{Shipments: shipments, PurchaseOrders: purchaseOrderRepository},
+		},
+	).(*shipmentService)
+
+	service.now = func() time.Time { return time.Date(2022, 8, 2, 10, 0, 0, 0, time.UTC) }
+
+	return service
+}
+
+func Test_RecordEvent_PickedUp_ShouldShipTheOrder(t *testing.T) {
+
+	var history []db.PurchaseOrderStatusHistory
+	service := newTrackingService(MockShipmentRepository{Found: shipped}, purchaseOrders.ApprovedStatusId, &history)
+
+	event, err := service.RecordEvent("TRK1", PickedUpEvent, "Santos", "2022-08-02T08:30:00-03:00", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.TrackingEvent{
+		Id: 1, ShipmentId: 7, Type: PickedUpEvent, Location: "Santos", OccurredAt: "2022-08-02 11:30:00", CreatedAt: "2022-08-02 10:00:00",
+	}, event)
+	assert.Equal(t, []db.PurchaseOrderStatusHistory{
+		{PurchaseOrderId: 1, FromStatusId: purchaseOrders.ApprovedStatusId, ToStatusId: purchaseOrders.InTransitStatusId, Action: purchaseOrders.ShipAction, ChangedAt: "2022-08-02 10:00:00"},
+	}, history)
+}
+
+func Test_RecordEvent_InTransit_ShouldKeepAShippedOrder(t *testing.T) {
+
+	var history []db.PurchaseOrderStatusHistory
+	service := newTrackingService(MockShipmentRepository{Found: shipped}, purchaseOrders.InTransitStatusId, &history)
+
+	event, err := service.RecordEvent("TRK1", InTransitEvent, "Campinas", "", 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-08-02 10:00:00", event.OccurredAt)
+	assert.Empty(t, history)
+}
+
+func Test_RecordEvent_Delivered_ShouldDeliverTheOrder(t *testing.T) {
+
+	var history []db.PurchaseOrderStatusHistory
+	service := newTrackingService(MockShipmentRepository{Found: shipped}, purchaseOrders.InTransitStatusId, &history)
+
+	_, err := service.RecordEvent("TRK1", DeliveredEvent, "Santos", "", 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, purchaseOrders.DeliveredStatusId, history[0].ToStatusId)
+}
+
+func Test_RecordEvent_Delivered_ShouldShipAnApprovedOrderFirst(t *testing.T) {
+
+	var history []db.PurchaseOrderStatusHistory
+	service := newTrackingService(MockShipmentRepository{Found: shipped}, purchaseOrders.ApprovedStatusId, &history)
+
+	_, err := service.RecordEvent("TRK1", DeliveredEvent, "Santos", "", 0)
+
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, purchaseOrders.ShipAction, history[0].Action)
+	assert.Equal(t, purchaseOrders.DeliverAction, history[1].Action)
+	assert.Equal(t, purchaseOrders.DeliveredStatusId, history[1].ToStatusId)
+}
+
+func Test_RecordEvent_Failed_ShouldNotMoveTheOrder(t *testing.T) {
+
+	var history []db.PurchaseOrderStatusHistory
+	service := newTrackingService(MockShipmentRepository{Found: shipped}, purchaseOrders.InTransitStatusId, &history)
+
+	_, err := service.RecordEvent("TRK1", FailedEvent, "Santos", "", 0)
+
+	assert.Nil(t, err)
+	assert.Empty(t, history)
+}
+
+func Test_RecordEvent_ShouldReturnShipmentClosedError(t *testing.T) {
+
+	var created []db.TrackingEvent
+	service := newTrackingService(MockShipmentRepository{Found: shipped, Created: &created}, purchaseOrders.DeliveredStatusId, nil)
+
+	_, err := service.RecordEvent("TRK1", InTransitEvent, "Santos", "", 0)
+
+	assert.Equal(t, ShipmentClosedError, err)
+	assert.Empty(t, created)
+}
+
+func Test_RecordEvent_ShouldReturnShipmentNotFoundError(t *testing.T) {
+
+	service := newTrackingService(MockShipmentRepository{}, purchaseOrders.ApprovedStatusId, nil)
+
+	_, err := service.RecordEvent("NOPE", PickedUpEvent, "Santos", "", 0)
+
+	assert.Equal(t, ShipmentNotFoundError, err)
+}
+
+func Test_RecordEvent_ShouldOnlyAcceptScansOfTheCarrier(t *testing.T) {
+
+	var created []db.TrackingEvent
+	var history []db.PurchaseOrderStatusHistory
+	service := newTrackingService(MockShipmentRepository{Found: shipped, Created: &created}, purchaseOrders.ApprovedStatusId, &history)
+
+	_, err := service.RecordEvent("TRK1", DeliveredEvent, "Santos", "", 3)
+
+	assert.Equal(t, ForeignShipmentError, err)
+	assert.Empty(t, created)
+	assert.Empty(t, history)
+
+	_, err = service.RecordEvent("TRK1", PickedUpEvent, "Santos", "", 1)
+
+	assert.Nil(t, err)
+	assert.Len(t, created, 1)
+}
+
+func Test_RecordEvent_ShouldValidateTheEvent(t *testing.T) {
+
+	service := newTrackingService(MockShipmentRepository{Found: shipped}, purchaseOrders.ApprovedStatusId, nil)
+
+	_, err := service.RecordEvent("TRK1", "lost", "Santos", "", 0)
+	assert.Equal(t, UnknownEventTypeError, err)
+
+	_, err = service.RecordEvent("TRK1", PickedUpEvent, "Santos", "yesterday", 0)
+	assert.Equal(t, InvalidOccurredAtError, err)
+}
+
+func Test_GetTracking_Ok(t *testing.T) {
+
+	events := []db.TrackingEvent{
+		{Id: 1, ShipmentId: 7, Type: PickedUpEvent, Location: "Santos", OccurredAt: "2022-08-02 08:00:00"},
+		{Id: 2, ShipmentId: 7, Type: DeliveredEvent, Location: "Campinas", OccurredAt: "2022-08-02 15:00:00"},
+	}
+	service := newTrackingService(MockShipmentRepository{Found: shipped, Events: events}, purchaseOrders.DeliveredStatusId, nil)
+
+	timeline, err := service.GetTracking(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, db.TrackingTimeline{
+		PurchaseOrderId: 1, OrderStatusId: purchaseOrders.DeliveredStatusId, Shipment: shipped, Events: events,
+	}, timeline)
+}
+
+func Test_GetTracking_ShouldReturnShipmentNotFoundError(t *testing.T) {
+
+	service := newTrackingService(MockShipmentRepository{}, purchaseOrders.ApprovedStatusId, nil)
+
+	_, err := service.GetTracking(1)
+
+	assert.Equal(t, ShipmentNotFoundError, err)
+}
+
+func Test_GetTracking_ShouldReturnPurchaseOrderNotFoundError(t *testing.T) {
+
+	service := NewShipmentService(
+		MockShipmentRepository{Found: shipped},
+		carries.MockCarrierRepository{},
+		purchaseOrders.MockPurchaseOrdersRepository{},
+		warehouses.MockWarehouseRepository{},
+		uow.MockUnitOfWork[Repositories]{},
+	)
+
+	_, err := service.GetTracking(9)
+
+	assert.Equal(t, purchaseOrders.PurchaseOrderNotFoundError, err)
+}
+
+func Test_GetTracking_RepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := newTrackingService(MockShipmentRepository{Found: shipped, Err: expectedError}, purchaseOrders.ApprovedStatusId, nil)
+
+	_, err := service.GetTracking(1)
+
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,140 @@
+package stock
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+var (
+	InvalidAtError            = errors.New("at must be an RFC 3339 date time, e.g. 2022-06-01T00:00:00Z")
+	ProductBatchNotFoundError = errors.New("product batch not found")
+	InvalidMovementTypeError  = errors.New("movement_type must be adjustment or write_off")
+	InvalidQuantityError      = errors.New("quantity must not be zero")
+	InvalidWriteOffError      = errors.New("a write-off takes stock out, its quantity must be negative")
+)
+
+type StockService interface {
+	StockAt(productId uint64, warehouseId uint64, at time.Time) ([]db.StockLevel, error)
+	Adjust(productBatchId uint64, movementType string, quantity int64, reason string) (db.InventoryMovement, error)
+	GetMovements(params query.Params) (query.Page[db.InventoryMovement], error)
+}
+
+// Repositories are the transaction-bound repositories used by Adjust.
+type Repositories struct {
+	Ledger         ledger.LedgerRepository
+	ProductBatches batches.ProductBatchRepository
+	Sections       sections.SectionRepository
+}
+
+type stockService struct {
+	ledgerRepository ledger.LedgerRepository
+	unitOfWork       uow.UnitOfWork[Repositories]
+	now              func() time.Time
+}
+
+func NewStockService(ledgerRepository ledger.LedgerRepository, unitOfWork uow.UnitOfWork[Repositories]) StockService {
+	return &stockService{
+		ledgerRepository: ledgerRepository,
+		unitOfWork:       unitOfWork,
+		now:              time.Now,
+	}
+}
+
+// StockAt rebuilds the stock by product and warehouse at the given moment
+// from the ledger. A zero at is now.
+func (s *stockService) StockAt(productId uint64, warehouseId uint64, at time.Time) ([]db.StockLevel, error) {
+	if at.IsZero() {
+		at = s.now()
+	}
+
+	return s.ledgerRepository.StockAt(productId, warehouseId, at.UTC().Format(ledger.TimeLayout))
+}
+
+// Adjust corrects the stock of a batch after a count (adjustment) or takes
+// out what was lost or damaged (write_off). The quantity is the signed
+// change; the capacity in use of the batch section follows it.
+func (s *stockService) Adjust(productBatchId uint64, movementType string, quantity int64, reason string) (db.InventoryMovement, error) {
+	if movementType != ledger.AdjustmentMovement && movementType != ledger.WriteOffMovement {
+		return db.InventoryMovement{}, InvalidMovementTypeError
+	}
+
+	if quantity == 0 {
+		return db.InventoryMovement{}, InvalidQuantityError
+	}
+
+	if movementType == ledger.WriteOffMovement && quantity > 0 {
+		return db.InventoryMovement{}, InvalidWriteOffError
+	}
+
+	var movement db.InventoryMovement
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		batch, err := r.ProductBatches.Get(productBatchId)
+		if err != nil {
+			return err
+		}
+
+		if (batch == db.ProductBatch{}) {
+			return ProductBatchNotFoundError
+		}
+
+		if quantity > 0 {
+			err = increase(r, batch, uint64(quantity))
+		} else {
+			err = decrease(r, batch, uint64(-quantity))
+		}
+
+		if err != nil {
+			return err
+		}
+
+		section, err := r.Sections.Get(batch.SectionId)
+		if err != nil {
+			return err
+		}
+
+		movement, err = r.Ledger.Record(db.InventoryMovement{
+			ProductBatchId: batch.Id,
+			ProductId:      batch.ProductId,
+			SectionId:      batch.SectionId,
+			WarehouseId:    section.WarehouseId,
+			Type:           movementType,
+			Quantity:       quantity,
+			Reason:         reason,
+			CreatedAt:      s.now().UTC().Format(ledger.TimeLayout),
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return db.InventoryMovement{}, err
+	}
+
+	return movement, nil
+}
+
+func (s *stockService) GetMovements(params query.Params) (query.Page[db.InventoryMovement], error) {
+	return s.ledgerRepository.GetMovements(params)
+}
+
+func increase(r Repositories, batch db.ProductBatch, quantity uint64) error {
+	if err := r.ProductBatches.IncreaseCurrentQuantity(batch.Id, quantity); err != nil {
+		return err
+	}
+	return r.Sections.IncreaseCurrentCapacity(batch.SectionId, quantity)
+}
+
+func decrease(r Repositories, batch db.ProductBatch, quantity uint64) error {
+	if err := r.ProductBatches.DecreaseCurrentQuantity(batch.Id, quantity); err != nil {
+		return err
+	}
+	return r.Sections.DecreaseCurrentCapacity(batch.SectionId, quantity)
+}
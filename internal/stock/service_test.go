@@ -0,0 +1,207 @@
+package stock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+var stockedBatch = db.ProductBatch{Id: 1, Number: 10, CurrentQuantity: 10, ProductId: 1, SectionId: 1}
+
+func Test_StockAt_Ok(t *testing.T) {
+
+	expectedLevels := []db.StockLevel{{ProductId: 1, WarehouseId: 1, Quantity: 10}}
+	service := NewStockService(ledger.MockLedgerRepository{Levels: expectedLevels}, mockUnitOfWork(batches.MockProductBatchesRepository{}))
+
+	levels, err := service.StockAt(1, 0, time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedLevels, levels)
+}
+
+func Test_Adjust_Ok(t *testing.T) {
+
+	service := NewStockService(ledger.MockLedgerRepository{}, mockUnitOfWork(batches.MockProductBatchesRepository{GetById: stockedBatch}))
+
+	movement, err := service.Adjust(1, ledger.WriteOffMovement, -2, "broken")
+
+	assert.Nil(t, err)
+	assert.Equal(t, ledger.WriteOffMovement, movement.Type)
+	assert.Equal(t, int64(-2), movement.Quantity)
+	assert.Equal(t, "broken", movement.Reason)
+	assert.Equal(t, uint64(3), movement.WarehouseId)
+}
+
+func Test_Adjust_ShouldValidateTheMovement(t *testing.T) {
+
+	service := NewStockService(ledger.MockLedgerRepository{}, mockUnitOfWork(batches.MockProductBatchesRepository{GetById: stockedBatch}))
+
+	_, err := service.Adjust(1, ledger.PickMovement, -2, "")
+	assert.Equal(t, InvalidMovementTypeError, err)
+
+	_, err = service.Adjust(1, ledger.AdjustmentMovement, 0, "")
+	assert.Equal(t, InvalidQuantityError, err)
+
+	_, err = service.Adjust(1, ledger.WriteOffMovement, 2, "")
+	assert.Equal(t, InvalidWriteOffError, err)
+}
+
+func Test_Adjust_ShouldReturnProductBatchNotFoundError(t *testing.T) {
+
+	service := NewStockService(ledger.MockLedgerRepository{}, mockUnitOfWork(batches.MockProductBatchesRepository{}))
+
+	_, err := service.Adjust(1, ledger.AdjustmentMovement, 2, "")
+
+	assert.Equal(t, ProductBatchNotFoundError, err)
+}
+
+func Test_Adjust_ShouldReturnInsufficientQuantityError(t *testing.T) {
+
+	service := NewStockService(ledger.MockLedgerRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch, UpdateErr: batches.InsufficientQuantityError},
+	))
+
+	_, err := service.Adjust(1, ledger.AdjustmentMovement, -20, "")
+
+	assert.Equal(t, batches.InsufficientQuantityError, err)
+}
+
+func Test_Adjust_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	unitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Ledger:         ledger.MockLedgerRepository{Err: expectedError},
+			ProductBatches: batches.MockProductBatchesRepository{GetById: stockedBatch},
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 1, WarehouseId: 3}},
+		},
+	}
+	service := NewStockService(ledger.MockLedgerRepository{}, unitOfWork)
+
+	movement, err := service.Adjust(1, ledger.AdjustmentMovement, 2, "")
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, db.InventoryMovement{}, movement)
+}
+
+func Test_Adjust_ShouldUpdateStockAndLedger(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_INVENTORY_MOVEMENTS_TABLE)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+
+	now := time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+	service := &stockService{
+		ledgerRepository: ledger.NewLedgerRepository(database),
+		unitOfWork: uow.New(database, func(q db.Querier) Repositories {
+			return Repositories{
+				Ledger:         ledger.NewLedgerRepository(q),
+				ProductBatches: batches.NewProductBatchRepository(q),
+				Sections:       sections.NewRepository(q),
+			}
+		}),
+		now: func() time.Time { return now },
+	}
+
+	_, err := service.Adjust(1, ledger.AdjustmentMovement, 5, "count")
+	assert.Nil(t, err)
+
+	now = now.Add(time.Hour)
+	_, err = service.Adjust(1, ledger.WriteOffMovement, -3, "broken")
+	assert.Nil(t, err)
+
+	_, err = service.Adjust(1, ledger.WriteOffMovement, -30, "broken")
+	assert.Equal(t, batches.InsufficientQuantityError, err)
+
+	batch, _ := batches.NewProductBatchRepository(database).Get(1)
+	assert.Equal(t, uint64(12), batch.CurrentQuantity)
+
+	section, _ := sections.NewRepository(database).Get(1)
+	assert.Equal(t, uint32(42), section.CurrentCapacity)
+
+	levels, err := service.StockAt(1, 0, time.Date(2022, 8, 1, 10, 30, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockLevel{{ProductId: 1, WarehouseId: 2, Quantity: 5}}, levels)
+
+	levels, err = service.StockAt(1, 0, time.Time{})
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockLevel{{ProductId: 1, WarehouseId: 2, Quantity: 2}}, levels)
+
+	util.DropDB(database)
+}
+
+func mockUnitOfWork(batchRepository batches.ProductBatchRepository) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Ledger:         ledger.MockLedgerRepository{},
+			ProductBatches: batchRepository,
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 1, WarehouseId: 3}},
+		},
+	}
+}
+
+const INSERT_PRODUCT_BATCHES = `
+	INSERT INTO product_batches(batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+	VALUES (10, 10, 1, "2022-09-01 10:00:00", 10, "2022-01-01", "10:00:00", 1, 1, 1)
+`
+
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id)
+	VALUES (11, 40, 1, 100, 10, 1, 1, 2)
+`
+
+const CREATE_INVENTORY_MOVEMENTS_TABLE = `
+	CREATE TABLE "inventory_movements"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		movement_type TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_BATCHES_TABLE = `
+	CREATE TABLE "product_batches"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		batch_number BIGINT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		due_date TEXT NOT NULL,
+		initial_quantity BIGINT NOT NULL,
+		manufacturing_date TEXT NOT NULL,
+		manufacturing_hour TEXT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
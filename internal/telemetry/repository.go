@@ -0,0 +1,127 @@
+package telemetry
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type TelemetryRepository interface {
+	CreateReadings(readings []db.TemperatureReading) ([]db.TemperatureReading, error)
+	// GetReadings lists the readings of the section recorded in [from, to),
+	// oldest first.
+	GetReadings(sectionId uint64, from string, to string) ([]db.TemperatureReading, error)
+	GetLatestReading(sectionId uint64) (db.TemperatureReading, error)
+	// GetStockedBatches lists the batches with stock stored in the section.
+	GetStockedBatches(sectionId uint64) ([]db.ProductBatch, error)
+}
+
+type telemetryRepository struct {
+	db db.Querier
+}
+
+func NewTelemetryRepository(database db.Querier) TelemetryRepository {
+	return &telemetryRepository{
+		db: database,
+	}
+}
+
+func (r *telemetryRepository) CreateReadings(readings []db.TemperatureReading) ([]db.TemperatureReading, error) {
+	stmt, err := r.db.Prepare("INSERT INTO temperature_readings(section_id, recorded_at, temperature) VALUES(?, ?, ?)")
+	if err != nil {
+		return []db.TemperatureReading{}, err
+	}
+
+	defer stmt.Close()
+
+	created := make([]db.TemperatureReading, 0, len(readings))
+
+	for _, reading := range readings {
+		result, err := stmt.Exec(reading.SectionId, reading.RecordedAt, reading.Temperature)
+		if err != nil {
+			return []db.TemperatureReading{}, err
+		}
+
+		insertedId, _ := result.LastInsertId()
+		reading.Id = uint64(insertedId)
+		created = append(created, reading)
+	}
+
+	return created, nil
+}
+
+func (r *telemetryRepository) GetReadings(sectionId uint64, from string, to string) ([]db.TemperatureReading, error) {
+	rows, err := r.db.Query(`
+		SELECT id, section_id, recorded_at, temperature FROM temperature_readings
+		WHERE section_id = ? AND recorded_at >= ? AND recorded_at < ?
+		ORDER BY recorded_at, id`, sectionId, from, to,
+	)
+	if err != nil {
+		return []db.TemperatureReading{}, err
+	}
+
+	defer rows.Close()
+
+	var readings []db.TemperatureReading
+
+	for rows.Next() {
+		var reading db.TemperatureReading
+
+		if err := rows.Scan(&reading.Id, &reading.SectionId, &reading.RecordedAt, &reading.Temperature); err != nil {
+			return []db.TemperatureReading{}, err
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings, rows.Err()
+}
+
+func (r *telemetryRepository) GetLatestReading(sectionId uint64) (db.TemperatureReading, error) {
+	var reading db.TemperatureReading
+
+	row := r.db.QueryRow(`
+		SELECT id, section_id, recorded_at, temperature FROM temperature_readings
+		WHERE section_id = ? ORDER BY recorded_at DESC, id DESC LIMIT 1`, sectionId,
+	)
+
+	err := row.Scan(&reading.Id, &reading.SectionId, &reading.RecordedAt, &reading.Temperature)
+	return reading, err
+}
+
+func (r *telemetryRepository) GetStockedBatches(sectionId uint64) ([]db.ProductBatch, error) {
+	rows, err := r.db.Query(`
+		SELECT id, batch_number, current_quantity, current_temperature, due_date, initial_quantity,
+			manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id
+		FROM product_batches WHERE section_id = ? AND current_quantity > 0 ORDER BY id`, sectionId,
+	)
+	if err != nil {
+		return []db.ProductBatch{}, err
+	}
+
+	defer rows.Close()
+
+	var batches []db.ProductBatch
+
+	for rows.Next() {
+		var batch db.ProductBatch
+
+		if err := rows.Scan(
+			&batch.Id,
+			&batch.Number,
+			&batch.CurrentQuantity,
+			&batch.CurrentTemperature,
+			&batch.DueDate,
+			&batch.InitialQuantity,
+			&batch.ManufacturingDate,
+			&batch.ManufacturingHour,
+			&batch.MinimumTemperature,
+			&batch.ProductId,
+			&batch.SectionId,
+		); err != nil {
+			return []db.ProductBatch{}, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, rows.Err()
+}
@@ -0,0 +1,39 @@
+package telemetry
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+type MockTelemetryRepository struct {
+	Readings []db.TemperatureReading
+	Batches  []db.ProductBatch
+	Err      error
+}
+
+func (m MockTelemetryRepository) CreateReadings(readings []db.TemperatureReading) ([]db.TemperatureReading, error) {
+	if m.Err != nil {
+		return []db.TemperatureReading{}, m.Err
+	}
+
+	created := []db.TemperatureReading{}
+	for i, reading := range readings {
+		reading.Id = uint64(i + 1)
+		created = append(created, reading)
+	}
+	return created, nil
+}
+
+func (m MockTelemetryRepository) GetReadings(sectionId uint64, from string, to string) ([]db.TemperatureReading, error) {
+	return m.Readings, m.Err
+}
+
+func (m MockTelemetryRepository) GetLatestReading(sectionId uint64) (db.TemperatureReading, error) {
+	if m.Err != nil || len(m.Readings) == 0 {
+		return db.TemperatureReading{}, m.Err
+	}
+	return m.Readings[len(m.Readings)-1], nil
+}
+
+func (m MockTelemetryRepository) GetStockedBatches(sectionId uint64) ([]db.ProductBatch, error) {
+	return m.Batches, m.Err
+}
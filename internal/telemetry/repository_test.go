@@ -0,0 +1,141 @@
+package telemetry
+
+import (
+	"database/sql"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_CreateReadings_Ok(t *testing.T) {
+
+	database := createTelemetryDB()
+	repository := NewTelemetryRepository(database)
+
+	created, err := repository.CreateReadings([]db.TemperatureReading{
+		{SectionId: 1, RecordedAt: "2022-08-01 10:10:00", Temperature: 2},
+		{SectionId: 1, RecordedAt: "2022-08-01 10:00:00", Temperature: 1},
+		{SectionId: 2, RecordedAt: "2022-08-01 10:05:00", Temperature: 5},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), created[2].Id)
+
+	found, err := repository.GetReadings(1, "2022-08-01 00:00:00", "2022-08-02 00:00:00")
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TemperatureReading{created[1], created[0]}, found)
+
+	found, err = repository.GetReadings(1, "2022-08-01 10:00:00", "2022-08-01 10:10:00")
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TemperatureReading{created[1]}, found)
+
+	latest, err := repository.GetLatestReading(1)
+	assert.Nil(t, err)
+	assert.Equal(t, created[0], latest)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetLatestReading_NoRows(t *testing.T) {
+
+	database := createTelemetryDB()
+	repository := NewTelemetryRepository(database)
+
+	_, err := repository.GetLatestReading(1)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetStockedBatches_Ok(t *testing.T) {
+
+	database := createTelemetryDB()
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+	repository := NewTelemetryRepository(database)
+
+	found, err := repository.GetStockedBatches(1)
+	assert.Nil(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, uint64(1), found[0].Id)
+	assert.Equal(t, float32(-2), found[0].MinimumTemperature)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetReadings_ConnectionError(t *testing.T) {
+
+	database := createTelemetryDB()
+	repository := NewTelemetryRepository(database)
+
+	database.Close()
+	found, err := repository.GetReadings(1, "2022-08-01 00:00:00", "2022-08-02 00:00:00")
+
+	assert.NotNil(t, err)
+	assert.Empty(t, found)
+
+	util.DropDB(database)
+}
+
+func createTelemetryDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_TEMPERATURE_READINGS_TABLE)
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, INSERT_SECTIONS)
+	return database
+}
+
+// Batch 2 is empty and batch 3 is in another section.
+const INSERT_PRODUCT_BATCHES = `
+	INSERT INTO product_batches(batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+	VALUES (10, 10, 1, "2022-09-01 10:00:00", 10, "2022-01-01", "10:00:00", -2, 1, 1),
+		(20, 0, 1, "2022-09-01 10:00:00", 20, "2022-01-01", "10:00:00", 4, 1, 1),
+		(30, 5, 1, "2022-09-01 10:00:00", 5, "2022-01-01", "10:00:00", 4, 1, 2)
+`
+
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id)
+	VALUES (1, 80, 1, 100, 10, 0, 1, 1),
+		(2, 15, 1, 100, 10, 0, 1, 2)
+`
+
+const CREATE_TEMPERATURE_READINGS_TABLE = `
+	CREATE TABLE "temperature_readings"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_id BIGINT NOT NULL,
+		recorded_at TEXT NOT NULL,
+		temperature DECIMAL(19, 2) NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_BATCHES_TABLE = `
+	CREATE TABLE "product_batches"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		batch_number BIGINT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		due_date TEXT NOT NULL,
+		initial_quantity BIGINT NOT NULL,
+		manufacturing_date TEXT NOT NULL,
+		manufacturing_hour TEXT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
@@ -0,0 +1,263 @@
+package telemetry
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+)
+
+var (
+	InvalidRecordedAtError = errors.New("recorded_at must be a date time, e.g. 2022-08-01 10:00:00 or 2022-08-01T10:00:00Z")
+	InvalidRangeError      = errors.New("from must be before to")
+	InvalidBucketError     = errors.New("bucket must be a positive duration, e.g. 1h")
+	TooManyBucketsError    = errors.New("too many buckets, use a larger bucket or a shorter range")
+)
+
+// TimeLayout is the layout readings are stored in, always in UTC.
+const TimeLayout = "2006-01-02 15:04:05"
+
+// MaxBuckets is the most buckets History returns in one response.
+const MaxBuckets = 1000
+
+type TelemetryService interface {
+	Ingest(sectionId uint64, readings []db.TemperatureReading) ([]db.TemperatureReading, error)
+	History(sectionId uint64, from time.Time, to time.Time, bucket time.Duration) ([]db.TemperatureRollup, error)
+	Excursions(sectionId uint64, from time.Time, to time.Time) ([]db.TemperatureExcursion, error)
+}
+
+// Repositories are the transaction-bound repositories used by Ingest.
+type Repositories struct {
+	Telemetry      TelemetryRepository
+	Sections       sections.SectionRepository
+	ProductBatches batches.ProductBatchRepository
+}
+
+type telemetryService struct {
+	telemetryRepository TelemetryRepository
+	sectionRepository   sections.SectionRepository
+	unitOfWork          uow.UnitOfWork[Repositories]
+}
+
+func NewTelemetryService(
+	telemetryRepository TelemetryRepository,
+	sectionRepository sections.SectionRepository,
+	unitOfWork uow.UnitOfWork[Repositories],
+) TelemetryService {
+	return &telemetryService{
+		telemetryRepository: telemetryRepository,
+		sectionRepository:   sectionRepository,
+		unitOfWork:          unitOfWork,
+	}
+}
+
+// ParseTime reads a timestamp in TimeLayout or RFC 3339. Timestamps
+// without a zone are taken as UTC.
+func ParseTime(value string) (time.Time, error) {
+	if parsed, err := time.Parse(TimeLayout, value); err == nil {
+		return parsed, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// Ingest stores the readings of the section, in any order. The latest
+// reading of the section becomes the current temperature of the section
+// and of the batches stored in it.
+func (s *telemetryService) Ingest(sectionId uint64, readings []db.TemperatureReading) ([]db.TemperatureReading, error) {
+	if len(readings) == 0 {
+		return []db.TemperatureReading{}, nil
+	}
+
+	normalized := make([]db.TemperatureReading, 0, len(readings))
+
+	for _, reading := range readings {
+		recordedAt, err := ParseTime(reading.RecordedAt)
+		if err != nil {
+			return []db.TemperatureReading{}, InvalidRecordedAtError
+		}
+
+		reading.SectionId = sectionId
+		reading.RecordedAt = recordedAt.UTC().Format(TimeLayout)
+		normalized = append(normalized, reading)
+	}
+
+	var created []db.TemperatureReading
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		if _, err := r.Sections.Get(sectionId); err != nil {
+			return err
+		}
+
+		var err error
+		created, err = r.Telemetry.CreateReadings(normalized)
+		if err != nil {
+			return err
+		}
+
+		latest, err := r.Telemetry.GetLatestReading(sectionId)
+		if err != nil {
+			return err
+		}
+
+		if err := r.Sections.UpdateCurrentTemperature(sectionId, latest.Temperature); err != nil {
+			return err
+		}
+
+		return r.ProductBatches.UpdateCurrentTemperatureBySection(sectionId, latest.Temperature)
+	})
+
+	if err != nil {
+		return []db.TemperatureReading{}, err
+	}
+
+	return created, nil
+}
+
+// History rolls the readings in [from, to) up into buckets of the given
+// size, aligned on from. Buckets without readings are left out.
+func (s *telemetryService) History(sectionId uint64, from time.Time, to time.Time, bucket time.Duration) ([]db.TemperatureRollup, error) {
+	if !from.Before(to) {
+		return []db.TemperatureRollup{}, InvalidRangeError
+	}
+
+	if bucket <= 0 {
+		return []db.TemperatureRollup{}, InvalidBucketError
+	}
+
+	if to.Sub(from)/bucket >= MaxBuckets {
+		return []db.TemperatureRollup{}, TooManyBucketsError
+	}
+
+	if _, err := s.sectionRepository.Get(sectionId); err != nil {
+		return []db.TemperatureRollup{}, err
+	}
+
+	readings, err := s.readings(sectionId, from, to)
+	if err != nil {
+		return []db.TemperatureRollup{}, err
+	}
+
+	rollups := []db.TemperatureRollup{}
+	var sum float64
+
+	for _, reading := range readings {
+		recordedAt, err := time.Parse(TimeLayout, reading.RecordedAt)
+		if err != nil {
+			return []db.TemperatureRollup{}, err
+		}
+
+		start := from.UTC().Add(recordedAt.Sub(from) / bucket * bucket).Format(TimeLayout)
+
+		if len(rollups) == 0 || rollups[len(rollups)-1].BucketStart != start {
+			rollups = append(rollups, db.TemperatureRollup{
+				BucketStart: start,
+				Minimum:     reading.Temperature,
+				Maximum:     reading.Temperature,
+			})
+			sum = 0
+		}
+
+		rollup := &rollups[len(rollups)-1]
+		rollup.Count++
+		sum += float64(reading.Temperature)
+
+		if reading.Temperature < rollup.Minimum {
+			rollup.Minimum = reading.Temperature
+		}
+		if reading.Temperature > rollup.Maximum {
+			rollup.Maximum = reading.Temperature
+		}
+		rollup.Average = float32(sum / float64(rollup.Count))
+	}
+
+	return rollups, nil
+}
+
+// Excursions finds the periods in [from, to) when the section was below
+// its minimum temperature or below the minimum temperature of a batch
+// stored in it. An excursion starts at the first reading below the minimum
+// and ends at the next reading back at or above it; one still open at the
+// last reading is ongoing and lasts until that reading.
+func (s *telemetryService) Excursions(sectionId uint64, from time.Time, to time.Time) ([]db.TemperatureExcursion, error) {
+	if !from.Before(to) {
+		return []db.TemperatureExcursion{}, InvalidRangeError
+	}
+
+	section, err := s.sectionRepository.Get(sectionId)
+	if err != nil {
+		return []db.TemperatureExcursion{}, err
+	}
+
+	stocked, err := s.telemetryRepository.GetStockedBatches(sectionId)
+	if err != nil {
+		return []db.TemperatureExcursion{}, err
+	}
+
+	readings, err := s.readings(sectionId, from, to)
+	if err != nil {
+		return []db.TemperatureExcursion{}, err
+	}
+
+	excursions := excursionsBelow(readings, db.TemperatureExcursion{SectionId: sectionId, MinimumTemperature: section.MinimumTemperature})
+
+	for _, batch := range stocked {
+		excursions = append(excursions, excursionsBelow(readings, db.TemperatureExcursion{
+			SectionId:          sectionId,
+			ProductBatchId:     batch.Id,
+			MinimumTemperature: batch.MinimumTemperature,
+		})...)
+	}
+
+	return excursions, nil
+}
+
+func (s *telemetryService) readings(sectionId uint64, from time.Time, to time.Time) ([]db.TemperatureReading, error) {
+	return s.telemetryRepository.GetReadings(sectionId, from.UTC().Format(TimeLayout), to.UTC().Format(TimeLayout))
+}
+
+// excursionsBelow scans readings, oldest first, for the periods below the
+// minimum temperature of template.
+func excursionsBelow(readings []db.TemperatureReading, template db.TemperatureExcursion) []db.TemperatureExcursion {
+	excursions := []db.TemperatureExcursion{}
+	var current *db.TemperatureExcursion
+
+	for _, reading := range readings {
+		below := reading.Temperature < template.MinimumTemperature
+
+		switch {
+		case below && current == nil:
+			excursion := template
+			excursion.StartedAt = reading.RecordedAt
+			excursion.LowestTemperature = reading.Temperature
+			current = &excursion
+
+		case below:
+			if reading.Temperature < current.LowestTemperature {
+				current.LowestTemperature = reading.Temperature
+			}
+
+		case current != nil:
+			current.EndedAt = reading.RecordedAt
+			current.DurationSeconds = secondsBetween(current.StartedAt, reading.RecordedAt)
+			excursions = append(excursions, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		current.Ongoing = true
+		current.DurationSeconds = secondsBetween(current.StartedAt, readings[len(readings)-1].RecordedAt)
+		excursions = append(excursions, *current)
+	}
+
+	return excursions
+}
+
+func secondsBetween(start string, end string) uint64 {
+	startedAt, _ := time.Parse(TimeLayout, start)
+	endedAt, _ := time.Parse(TimeLayout, end)
+	return uint64(endedAt.Sub(startedAt) / time.Second)
+}
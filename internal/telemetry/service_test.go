@@ -0,0 +1,163 @@
+package telemetry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	from = time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+	to   = time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+)
+
+var sectionReadings = []db.TemperatureReading{
+	{Id: 1, SectionId: 1, RecordedAt: "2022-08-01 10:00:00", Temperature: 1},
+	{Id: 2, SectionId: 1, RecordedAt: "2022-08-01 10:20:00", Temperature: -1},
+	{Id: 3, SectionId: 1, RecordedAt: "2022-08-01 10:40:00", Temperature: -3},
+	{Id: 4, SectionId: 1, RecordedAt: "2022-08-01 11:00:00", Temperature: 2},
+	{Id: 5, SectionId: 1, RecordedAt: "2022-08-01 11:30:00", Temperature: -0.5},
+}
+
+func Test_Ingest_ShouldNormalizeRecordedAt(t *testing.T) {
+
+	service := newMockTelemetryService(MockTelemetryRepository{Readings: sectionReadings})
+
+	created, err := service.Ingest(1, []db.TemperatureReading{
+		{RecordedAt: "2022-08-01T12:00:00+02:00", Temperature: 3},
+		{RecordedAt: "2022-08-01 10:05:00", Temperature: 4},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TemperatureReading{
+		{Id: 1, SectionId: 1, RecordedAt: "2022-08-01 10:00:00", Temperature: 3},
+		{Id: 2, SectionId: 1, RecordedAt: "2022-08-01 10:05:00", Temperature: 4},
+	}, created)
+}
+
+func Test_Ingest_ShouldReturnInvalidRecordedAtError(t *testing.T) {
+
+	service := newMockTelemetryService(MockTelemetryRepository{})
+
+	_, err := service.Ingest(1, []db.TemperatureReading{{RecordedAt: "yesterday", Temperature: 3}})
+
+	assert.Equal(t, InvalidRecordedAtError, err)
+}
+
+func Test_Ingest_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := newMockTelemetryService(MockTelemetryRepository{Err: expectedError})
+
+	_, err := service.Ingest(1, []db.TemperatureReading{{RecordedAt: "2022-08-01 10:05:00", Temperature: 4}})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_Ingest_ShouldUpdateCurrentTemperatures(t *testing.T) {
+
+	database := createTelemetryDB()
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+
+	service := NewTelemetryService(NewTelemetryRepository(database), sections.NewRepository(database),
+		uow.New(database, func(q db.Querier) Repositories {
+			return Repositories{
+				Telemetry:      NewTelemetryRepository(q),
+				Sections:       sections.NewRepository(q),
+				ProductBatches: batches.NewProductBatchRepository(q),
+			}
+		}),
+	)
+
+	_, err := service.Ingest(1, []db.TemperatureReading{
+		{RecordedAt: "2022-08-01 11:00:00", Temperature: -4},
+		{RecordedAt: "2022-08-01 10:00:00", Temperature: 3},
+	})
+	assert.Nil(t, err)
+
+	section, err := sections.NewRepository(database).Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(-4), section.CurrentTemperature)
+
+	batch, err := batches.NewProductBatchRepository(database).Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(-4), batch.CurrentTemperature)
+
+	_, err = service.Ingest(3, []db.TemperatureReading{{RecordedAt: "2022-08-01 11:00:00", Temperature: -4}})
+	assert.Equal(t, sections.ErrSectionNotFoundError, err)
+
+	util.DropDB(database)
+}
+
+func Test_History_ShouldRollUpReadings(t *testing.T) {
+
+	service := newMockTelemetryService(MockTelemetryRepository{Readings: sectionReadings})
+
+	rollups, err := service.History(1, from, to, time.Hour)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TemperatureRollup{
+		{BucketStart: "2022-08-01 10:00:00", Count: 3, Minimum: -3, Maximum: 1, Average: -1},
+		{BucketStart: "2022-08-01 11:00:00", Count: 2, Minimum: -0.5, Maximum: 2, Average: 0.75},
+	}, rollups)
+}
+
+func Test_History_ShouldValidateRangeAndBucket(t *testing.T) {
+
+	service := newMockTelemetryService(MockTelemetryRepository{})
+
+	_, err := service.History(1, to, from, time.Hour)
+	assert.Equal(t, InvalidRangeError, err)
+
+	_, err = service.History(1, from, to, 0)
+	assert.Equal(t, InvalidBucketError, err)
+
+	_, err = service.History(1, from, to, time.Second)
+	assert.Equal(t, TooManyBucketsError, err)
+}
+
+func Test_Excursions_ShouldMeasureDuration(t *testing.T) {
+
+	service := newMockTelemetryService(MockTelemetryRepository{
+		Readings: sectionReadings,
+		Batches:  []db.ProductBatch{{Id: 7, MinimumTemperature: -2}},
+	})
+
+	excursions, err := service.Excursions(1, from, to)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.TemperatureExcursion{
+		{SectionId: 1, MinimumTemperature: 0, LowestTemperature: -3, StartedAt: "2022-08-01 10:20:00", EndedAt: "2022-08-01 11:00:00", DurationSeconds: 2400},
+		{SectionId: 1, MinimumTemperature: 0, LowestTemperature: -0.5, StartedAt: "2022-08-01 11:30:00", DurationSeconds: 0, Ongoing: true},
+		{SectionId: 1, ProductBatchId: 7, MinimumTemperature: -2, LowestTemperature: -3, StartedAt: "2022-08-01 10:40:00", EndedAt: "2022-08-01 11:00:00", DurationSeconds: 1200},
+	}, excursions)
+}
+
+func Test_Excursions_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	service := newMockTelemetryService(MockTelemetryRepository{Err: expectedError})
+
+	_, err := service.Excursions(1, from, to)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func newMockTelemetryService(telemetryRepository MockTelemetryRepository) TelemetryService {
+	sectionRepository := sections.MockSectionRepository{GetById: db.Section{Id: 1}}
+
+	return NewTelemetryService(telemetryRepository, sectionRepository, uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Telemetry:      telemetryRepository,
+			Sections:       sectionRepository,
+			ProductBatches: batches.MockProductBatchesRepository{},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+package transfers
+
+import (
+	"database/sql"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type TransferRepository interface {
+	Create(transfer db.StockTransfer) (db.StockTransfer, error)
+	Get(id uint64) (db.StockTransfer, error)
+	GetAll(params query.Params) (query.Page[db.StockTransfer], error)
+}
+
+type transferRepository struct {
+	db db.Querier
+}
+
+func NewTransferRepository(database db.Querier) TransferRepository {
+	return &transferRepository{
+		db: database,
+	}
+}
+
+var transfersQuery = query.NewBuilder(
+	"stock_transfers",
+	"id, product_batch_id, target_batch_id, from_section_id, to_section_id, quantity, created_at",
+	map[string]string{
+		"id":               "id",
+		"product_batch_id": "product_batch_id",
+		"target_batch_id":  "target_batch_id",
+		"from_section_id":  "from_section_id",
+		"to_section_id":    "to_section_id",
+		"created_at":       "created_at",
+	},
+)
+
+func (r *transferRepository) Create(transfer db.StockTransfer) (db.StockTransfer, error) {
+	stmt, err := r.db.Prepare(`
+		INSERT INTO stock_transfers(product_batch_id, target_batch_id, from_section_id, to_section_id, quantity, created_at)
+		VALUES(?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return db.StockTransfer{}, err
+	}
+
+	defer stmt.Close()
+
+	var result sql.Result
+	result, err = stmt.Exec(
+		transfer.ProductBatchId,
+		transfer.TargetBatchId,
+		transfer.FromSectionId,
+		transfer.ToSectionId,
+		transfer.Quantity,
+		transfer.CreatedAt,
+	)
+	if err != nil {
+		return db.StockTransfer{}, err
+	}
+
+	insertedId, _ := result.LastInsertId()
+	transfer.Id = uint64(insertedId)
+
+	return transfer, nil
+}
+
+func (r *transferRepository) Get(id uint64) (db.StockTransfer, error) {
+	var transfer db.StockTransfer
+
+	row := r.db.QueryRow(`
+		SELECT id, product_batch_id, target_batch_id, from_section_id, to_section_id, quantity, created_at
+		FROM stock_transfers WHERE id = ?`, id,
+	)
+
+	err := row.Scan(
+		&transfer.Id,
+		&transfer.ProductBatchId,
+		&transfer.TargetBatchId,
+		&transfer.FromSectionId,
+		&transfer.ToSectionId,
+		&transfer.Quantity,
+		&transfer.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return db.StockTransfer{}, TransferNotFoundError
+	}
+
+	return transfer, err
+}
+
+func (r *transferRepository) GetAll(params query.Params) (query.Page[db.StockTransfer], error) {
+	statement, args, err := transfersQuery.Build(params)
+	if err != nil {
+		return query.Page[db.StockTransfer]{}, err
+	}
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[db.StockTransfer]{}, err
+	}
+
+	defer rows.Close()
+
+	var transfers []db.StockTransfer
+
+	for rows.Next() {
+		var transfer db.StockTransfer
+
+		if err := rows.Scan(
+			&transfer.Id,
+			&transfer.ProductBatchId,
+			&transfer.TargetBatchId,
+			&transfer.FromSectionId,
+			&transfer.ToSectionId,
+			&transfer.Quantity,
+			&transfer.CreatedAt,
+		); err != nil {
+			return query.Page[db.StockTransfer]{}, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return query.NewPage(transfers, params)
+}
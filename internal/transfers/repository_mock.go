@@ -0,0 +1,33 @@
+package transfers
+
+import (
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+type MockTransferRepository struct {
+	Result any
+	Err    error
+}
+
+func (m MockTransferRepository) Create(transfer db.StockTransfer) (db.StockTransfer, error) {
+	if m.Err != nil {
+		return db.StockTransfer{}, m.Err
+	}
+	transfer.Id = 1
+	return transfer, nil
+}
+
+func (m MockTransferRepository) Get(id uint64) (db.StockTransfer, error) {
+	if m.Err != nil {
+		return db.StockTransfer{}, m.Err
+	}
+	return m.Result.(db.StockTransfer), nil
+}
+
+func (m MockTransferRepository) GetAll(params query.Params) (query.Page[db.StockTransfer], error) {
+	if m.Err != nil {
+		return query.Page[db.StockTransfer]{}, m.Err
+	}
+	return query.NewPage(m.Result.([]db.StockTransfer), params)
+}
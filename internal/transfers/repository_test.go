@@ -0,0 +1,179 @@
+package transfers
+
+import (
+	"database/sql"
+	"testing"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Repo_Create_Ok(t *testing.T) {
+
+	database := createTransfersDB()
+	repository := NewTransferRepository(database)
+
+	transfer := db.StockTransfer{ProductBatchId: 1, TargetBatchId: 3, FromSectionId: 1, ToSectionId: 2, Quantity: 4, CreatedAt: "2022-08-01 10:00:00"}
+
+	created, err := repository.Create(transfer)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), created.Id)
+
+	found, err := repository.Get(created.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, created, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_Get_ShouldReturnTransferNotFoundError(t *testing.T) {
+
+	database := createTransfersDB()
+	repository := NewTransferRepository(database)
+
+	found, err := repository.Get(1)
+
+	assert.Equal(t, TransferNotFoundError, err)
+	assert.Equal(t, db.StockTransfer{}, found)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_ShouldFilterBySection(t *testing.T) {
+
+	database := createTransfersDB()
+	repository := NewTransferRepository(database)
+
+	first, _ := repository.Create(db.StockTransfer{ProductBatchId: 1, TargetBatchId: 1, FromSectionId: 1, ToSectionId: 2, Quantity: 10, CreatedAt: "2022-08-01 10:00:00"})
+	repository.Create(db.StockTransfer{ProductBatchId: 2, TargetBatchId: 3, FromSectionId: 1, ToSectionId: 3, Quantity: 5, CreatedAt: "2022-08-01 11:00:00"})
+
+	found, err := repository.GetAll(query.Params{Filters: map[string]string{"to_section_id": "2"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []db.StockTransfer{first}, found.Items)
+
+	util.DropDB(database)
+}
+
+func Test_Repo_GetAll_ConnectionError(t *testing.T) {
+
+	database := createTransfersDB()
+	repository := NewTransferRepository(database)
+
+	database.Close()
+	found, err := repository.GetAll(query.Params{})
+
+	assert.NotNil(t, err)
+	assert.Empty(t, found.Items)
+
+	util.DropDB(database)
+}
+
+func createTransfersDB() *sql.DB {
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCTS_TABLE)
+	util.QueryExec(database, CREATE_PRODUCT_BATCHES_TABLE)
+	util.QueryExec(database, CREATE_STOCK_TRANSFERS_TABLE)
+	util.QueryExec(database, CREATE_INVENTORY_MOVEMENTS_TABLE)
+	util.QueryExec(database, INSERT_SECTIONS)
+	util.QueryExec(database, INSERT_PRODUCTS)
+	util.QueryExec(database, INSERT_PRODUCT_BATCHES)
+	return database
+}
+
+const INSERT_PRODUCT_BATCHES = `
+	INSERT INTO product_batches(batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+	VALUES (10, 10, 1, "2022-09-01 10:00:00", 10, "2022-01-01", "10:00:00", 1, 1, 1),
+		(20, 30, 1, "2022-09-10 10:00:00", 30, "2022-01-01", "10:00:00", 1, 1, 1)
+`
+
+// Section 2 is in another warehouse and has room for 15 more units;
+// section 3 stores another product type.
+const INSERT_SECTIONS = `
+	INSERT INTO sections(section_number, current_capacity, current_temperature, maximum_capacity, minimum_capacity, minimum_temperature, product_type, warehouse_id)
+	VALUES (11, 40, 1, 100, 10, 1, 1, 1),
+		(22, 15, 1, 30, 10, 1, 1, 2),
+		(33, 0, 1, 100, 10, 1, 2, 1)
+`
+
+const INSERT_PRODUCTS = `
+	INSERT INTO products(description, expiration_rate, freezing_rate, height, length, net_weight, product_code, recommended_freezing_temperature, width, product_type, seller_id)
+	VALUES ("Banana", 0.5, 1, 1, 1, 1, "BAN", 1, 1, 1, 1)
+`
+
+const CREATE_STOCK_TRANSFERS_TABLE = `
+	CREATE TABLE "stock_transfers"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		target_batch_id BIGINT NOT NULL,
+		from_section_id BIGINT NOT NULL,
+		to_section_id BIGINT NOT NULL,
+		quantity BIGINT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_INVENTORY_MOVEMENTS_TABLE = `
+	CREATE TABLE "inventory_movements"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_batch_id BIGINT NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL,
+		movement_type TEXT NOT NULL,
+		quantity BIGINT NOT NULL,
+		reason TEXT NOT NULL,
+		created_at TEXT NOT NULL
+	);
+`
+
+const CREATE_PRODUCT_BATCHES_TABLE = `
+	CREATE TABLE "product_batches"(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		batch_number BIGINT NOT NULL,
+		current_quantity BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		due_date TEXT NOT NULL,
+		initial_quantity BIGINT NOT NULL,
+		manufacturing_date TEXT NOT NULL,
+		manufacturing_hour TEXT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_id BIGINT NOT NULL,
+		section_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
+
+const CREATE_PRODUCTS_TABLE = `
+	CREATE TABLE "products" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		expiration_rate DECIMAL(19, 2) NOT NULL,
+		freezing_rate DECIMAL(19, 2) NOT NULL,
+		height DECIMAL(19, 2) NOT NULL,
+		length DECIMAL(19, 2) NOT NULL,
+		net_weight DECIMAL(19, 2) NOT NULL,
+		product_code TEXT NOT NULL,
+		recommended_freezing_temperature DECIMAL(19, 2) NOT NULL,
+		width DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT  NOT NULL,
+		seller_id BIGINT  NOT NULL
+	);
+`
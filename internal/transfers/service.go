@@ -0,0 +1,188 @@
+package transfers
+
+import (
+	"errors"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+)
+
+var (
+	TransferNotFoundError     = errors.New("transfer not found")
+	ProductBatchNotFoundError = errors.New("product batch not found")
+	SectionNotFoundError      = errors.New("section not found")
+	SameSectionError          = errors.New("product batch is already stored in the section")
+	ProductTypeMismatchError  = errors.New("section does not store the product type of the batch")
+	TemperatureMismatchError  = errors.New("section cannot keep the product at its recommended freezing temperature")
+)
+
+type TransferService interface {
+	Transfer(productBatchId uint64, toSectionId uint64, quantity uint64) (db.StockTransfer, error)
+	Get(id uint64) (db.StockTransfer, error)
+	GetAll(params query.Params) (query.Page[db.StockTransfer], error)
+}
+
+// Repositories are the transaction-bound repositories used by Transfer.
+type Repositories struct {
+	Transfers      TransferRepository
+	ProductBatches batches.ProductBatchRepository
+	Sections       sections.SectionRepository
+	Products       products.ProductRepository
+	Ledger         ledger.LedgerRepository
+}
+
+type transferService struct {
+	transferRepository TransferRepository
+	unitOfWork         uow.UnitOfWork[Repositories]
+	now                func() time.Time
+}
+
+func NewTransferService(transferRepository TransferRepository, unitOfWork uow.UnitOfWork[Repositories]) TransferService {
+	return &transferService{
+		transferRepository: transferRepository,
+		unitOfWork:         unitOfWork,
+		now:                time.Now,
+	}
+}
+
+// Transfer moves quantity of the batch to another section, in the same or
+// in another warehouse. A zero quantity moves the whole stock of the batch.
+// The whole stock moves the batch itself; part of it is split into a new
+// batch in the target section that keeps the batch number of its origin.
+// The capacity in use of both sections follows the stock, and the ledger
+// gets a movement out of the origin and one into the target.
+func (s *transferService) Transfer(productBatchId uint64, toSectionId uint64, quantity uint64) (db.StockTransfer, error) {
+	var transfer db.StockTransfer
+
+	err := s.unitOfWork.Do(func(r Repositories) error {
+		batch, err := r.ProductBatches.Get(productBatchId)
+		if err != nil {
+			return err
+		}
+
+		if (batch == db.ProductBatch{}) {
+			return ProductBatchNotFoundError
+		}
+
+		if quantity == 0 {
+			quantity = batch.CurrentQuantity
+		}
+
+		if quantity == 0 || quantity > batch.CurrentQuantity {
+			return batches.InsufficientQuantityError
+		}
+
+		if batch.SectionId == toSectionId {
+			return SameSectionError
+		}
+
+		section, err := r.Sections.Get(toSectionId)
+		if err != nil {
+			if err == sections.ErrSectionNotFoundError {
+				return SectionNotFoundError
+			}
+			return err
+		}
+
+		product, err := r.Products.Get(batch.ProductId)
+		if err != nil {
+			return err
+		}
+
+		if product.ProductTypeId != section.ProductTypeId {
+			return ProductTypeMismatchError
+		}
+
+		if !batches.CompatibleTemperature(product, section) {
+			return TemperatureMismatchError
+		}
+
+		if err := r.Sections.IncreaseCurrentCapacity(toSectionId, quantity); err != nil {
+			return err
+		}
+
+		if err := r.Sections.DecreaseCurrentCapacity(batch.SectionId, quantity); err != nil {
+			return err
+		}
+
+		origin, err := r.Sections.Get(batch.SectionId)
+		if err != nil {
+			return err
+		}
+
+		targetBatchId := batch.Id
+
+		if quantity == batch.CurrentQuantity {
+			err = r.ProductBatches.UpdateSection(batch.Id, toSectionId)
+		} else {
+			targetBatchId, err = split(r.ProductBatches, batch, toSectionId, quantity)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		createdAt := s.now().UTC().Format(ledger.TimeLayout)
+
+		movements := []db.InventoryMovement{
+			{ProductBatchId: batch.Id, SectionId: batch.SectionId, WarehouseId: origin.WarehouseId, Quantity: -int64(quantity)},
+			{ProductBatchId: targetBatchId, SectionId: toSectionId, WarehouseId: section.WarehouseId, Quantity: int64(quantity)},
+		}
+
+		for _, movement := range movements {
+			movement.ProductId = batch.ProductId
+			movement.Type = ledger.TransferMovement
+			movement.CreatedAt = createdAt
+
+			if _, err := r.Ledger.Record(movement); err != nil {
+				return err
+			}
+		}
+
+		transfer, err = r.Transfers.Create(db.StockTransfer{
+			ProductBatchId: batch.Id,
+			TargetBatchId:  targetBatchId,
+			FromSectionId:  batch.SectionId,
+			ToSectionId:    toSectionId,
+			Quantity:       quantity,
+			CreatedAt:      createdAt,
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return db.StockTransfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (s *transferService) Get(id uint64) (db.StockTransfer, error) {
+	return s.transferRepository.Get(id)
+}
+
+func (s *transferService) GetAll(params query.Params) (query.Page[db.StockTransfer], error) {
+	return s.transferRepository.GetAll(params)
+}
+
+// split takes quantity out of the batch into a new batch in the section,
+// returning the id of the new batch.
+func split(repository batches.ProductBatchRepository, batch db.ProductBatch, sectionId uint64, quantity uint64) (uint64, error) {
+	if err := repository.DecreaseCurrentQuantity(batch.Id, quantity); err != nil {
+		return 0, err
+	}
+
+	created, err := repository.Create(
+		batch.Number, quantity, batch.CurrentTemperature, batch.DueDate,
+		quantity, batch.ManufacturingDate, batch.ManufacturingHour, batch.MinimumTemperature, batch.ProductId, sectionId,
+	)
+
+	return created.Id, err
+}
@@ -0,0 +1,214 @@
+package transfers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/ledger"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/products/batches"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/uow"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var stockedBatch = db.ProductBatch{Id: 1, Number: 10, CurrentQuantity: 10, ProductId: 1, SectionId: 1}
+
+func Test_Transfer_WholeBatch_Ok(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch},
+		sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 1}},
+	))
+
+	transfer, err := service.Transfer(1, 2, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), transfer.TargetBatchId)
+	assert.Equal(t, uint64(1), transfer.FromSectionId)
+	assert.Equal(t, uint64(2), transfer.ToSectionId)
+	assert.Equal(t, uint64(10), transfer.Quantity)
+}
+
+func Test_Transfer_ShouldReturnProductBatchNotFoundError(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{},
+		sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 1}},
+	))
+
+	_, err := service.Transfer(1, 2, 0)
+
+	assert.Equal(t, ProductBatchNotFoundError, err)
+}
+
+func Test_Transfer_ShouldReturnInsufficientQuantityError(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch},
+		sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 1}},
+	))
+
+	_, err := service.Transfer(1, 2, 11)
+
+	assert.Equal(t, batches.InsufficientQuantityError, err)
+}
+
+func Test_Transfer_ShouldReturnSameSectionError(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch},
+		sections.MockSectionRepository{GetById: db.Section{Id: 1, ProductTypeId: 1}},
+	))
+
+	_, err := service.Transfer(1, 1, 5)
+
+	assert.Equal(t, SameSectionError, err)
+}
+
+func Test_Transfer_ShouldReturnProductTypeMismatchError(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch},
+		sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 2}},
+	))
+
+	_, err := service.Transfer(1, 2, 5)
+
+	assert.Equal(t, ProductTypeMismatchError, err)
+}
+
+func Test_Transfer_ShouldReturnTemperatureMismatchError(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch},
+		sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 1, MinimumTemperature: -25, CurrentTemperature: -18}},
+	))
+
+	_, err := service.Transfer(1, 2, 5)
+
+	assert.Equal(t, TemperatureMismatchError, err)
+}
+
+func Test_Transfer_ShouldReturnCapacityExceededError(t *testing.T) {
+
+	service := NewTransferService(MockTransferRepository{}, mockUnitOfWork(
+		batches.MockProductBatchesRepository{GetById: stockedBatch},
+		sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 1}, UpdateErr: sections.ErrSectionCapacityExceededError},
+	))
+
+	_, err := service.Transfer(1, 2, 5)
+
+	assert.Equal(t, sections.ErrSectionCapacityExceededError, err)
+}
+
+func Test_Transfer_ShouldReturnRepositoryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+	unitOfWork := uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Transfers:      MockTransferRepository{Err: expectedError},
+			ProductBatches: batches.MockProductBatchesRepository{GetById: stockedBatch},
+			Sections:       sections.MockSectionRepository{GetById: db.Section{Id: 2, ProductTypeId: 1}},
+			Products:       products.MockProductRepository{GetById: db.Product{Id: 1, ProductTypeId: 1}},
+			Ledger:         ledger.MockLedgerRepository{},
+		},
+	}
+	service := NewTransferService(MockTransferRepository{}, unitOfWork)
+
+	transfer, err := service.Transfer(1, 2, 0)
+
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, db.StockTransfer{}, transfer)
+}
+
+func Test_Transfer_ShouldMoveStockBetweenSections(t *testing.T) {
+
+	database := createTransfersDB()
+
+	service := &transferService{
+		unitOfWork: uow.New(database, func(q db.Querier) Repositories {
+			return Repositories{
+				Transfers:      NewTransferRepository(q),
+				ProductBatches: batches.NewProductBatchRepository(q),
+				Sections:       sections.NewRepository(q),
+				Products:       products.NewProductRepository(q),
+				Ledger:         ledger.NewLedgerRepository(q),
+			}
+		}),
+		now: func() time.Time { return time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC) },
+	}
+
+	batchRepository := batches.NewProductBatchRepository(database)
+	sectionRepository := sections.NewRepository(database)
+
+	// Part of batch 2 is split into a new batch with the same number.
+	transfer, err := service.Transfer(2, 2, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, db.StockTransfer{
+		Id: 1, ProductBatchId: 2, TargetBatchId: 3, FromSectionId: 1, ToSectionId: 2, Quantity: 4, CreatedAt: "2022-08-01 10:00:00",
+	}, transfer)
+
+	origin, _ := batchRepository.Get(2)
+	split, _ := batchRepository.Get(3)
+	assert.Equal(t, uint64(26), origin.CurrentQuantity)
+	assert.Equal(t, uint64(20), split.Number)
+	assert.Equal(t, uint64(4), split.CurrentQuantity)
+	assert.Equal(t, uint64(4), split.InitialQuantity)
+	assert.Equal(t, origin.DueDate, split.DueDate)
+	assert.Equal(t, uint64(2), split.SectionId)
+
+	levels, _ := ledger.NewLedgerRepository(database).StockAt(1, 0, "2022-08-01 10:00:00")
+	assert.Equal(t, []db.StockLevel{
+		{ProductId: 1, WarehouseId: 1, Quantity: -4},
+		{ProductId: 1, WarehouseId: 2, Quantity: 4},
+	}, levels)
+
+	// Section 2 has room for 11 more units only.
+	_, err = service.Transfer(2, 2, 0)
+	assert.Equal(t, sections.ErrSectionCapacityExceededError, err)
+
+	_, err = service.Transfer(1, 3, 0)
+	assert.Equal(t, ProductTypeMismatchError, err)
+
+	// Section 1 holds less than the batch, so the transfer is undone.
+	database.Exec("UPDATE sections SET current_capacity = 5 WHERE id = 1")
+	_, err = service.Transfer(1, 2, 0)
+	assert.Equal(t, sections.ErrSectionCapacityUnderflowError, err)
+
+	untouched, _ := batchRepository.Get(1)
+	assert.Equal(t, uint64(1), untouched.SectionId)
+	database.Exec("UPDATE sections SET current_capacity = 36 WHERE id = 1")
+
+	// The whole batch 1 moves itself.
+	transfer, err = service.Transfer(1, 2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), transfer.TargetBatchId)
+	assert.Equal(t, uint64(10), transfer.Quantity)
+
+	first, _ := batchRepository.Get(1)
+	assert.Equal(t, uint64(10), first.CurrentQuantity)
+	assert.Equal(t, uint64(2), first.SectionId)
+
+	firstSection, _ := sectionRepository.Get(1)
+	secondSection, _ := sectionRepository.Get(2)
+	assert.Equal(t, uint32(26), firstSection.CurrentCapacity)
+	assert.Equal(t, uint32(29), secondSection.CurrentCapacity)
+
+	util.DropDB(database)
+}
+
+func mockUnitOfWork(batchRepository batches.ProductBatchRepository, sectionRepository sections.SectionRepository) uow.UnitOfWork[Repositories] {
+	return uow.MockUnitOfWork[Repositories]{
+		Repositories: Repositories{
+			Transfers:      MockTransferRepository{},
+			ProductBatches: batchRepository,
+			Sections:       sectionRepository,
+			Products:       products.MockProductRepository{GetById: db.Product{Id: 1, ProductTypeId: 1}},
+			Ledger:         ledger.MockLedgerRepository{},
+		},
+	}
+}
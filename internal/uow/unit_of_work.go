@@ -0,0 +1,51 @@
+package uow
+
+import (
+	"database/sql"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+)
+
+// UnitOfWork runs a callback against repositories bound to a single
+// transaction. R is the set of repositories the caller needs, usually a
+// struct declared by the service that uses it.
+type UnitOfWork[R any] interface {
+	// Do commits when fn returns nil and rolls back when it returns an
+	// error or panics. The error returned by fn is returned unchanged.
+	Do(fn func(R) error) error
+}
+
+type unitOfWork[R any] struct {
+	db    *sql.DB
+	build func(database.Querier) R
+}
+
+// New returns a UnitOfWork that begins a transaction on db and calls build
+// with it to create the repositories handed to each Do callback.
+func New[R any](db *sql.DB, build func(database.Querier) R) UnitOfWork[R] {
+	return &unitOfWork[R]{
+		db:    db,
+		build: build,
+	}
+}
+
+func (u *unitOfWork[R]) Do(fn func(R) error) error {
+	tx, err := u.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tx.Rollback()
+			panic(recovered)
+		}
+	}()
+
+	if err := fn(u.build(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
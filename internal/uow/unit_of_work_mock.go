@@ -0,0 +1,16 @@
+package uow
+
+// MockUnitOfWork calls the callback with Repositories, without any
+// transaction. Err, when set, is returned instead of running the callback,
+// as if the transaction could not be started.
+type MockUnitOfWork[R any] struct {
+	Repositories R
+	Err          error
+}
+
+func (m MockUnitOfWork[R]) Do(fn func(R) error) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	return fn(m.Repositories)
+}
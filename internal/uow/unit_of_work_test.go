@@ -0,0 +1,103 @@
+package uow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/internal/sections"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Do_ShouldCommitWhenCallbackSucceeds(t *testing.T) {
+
+	db := util.CreateDB()
+	util.QueryExec(db, CREATE_SECTION_TABLE)
+
+	unitOfWork := New(db, sections.NewRepository)
+
+	err := unitOfWork.Do(func(repository sections.SectionRepository) error {
+		_, err := repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1)
+		return err
+	})
+	assert.Nil(t, err)
+
+	found, err := sections.NewRepository(db).Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), found.Id)
+
+	util.DropDB(db)
+}
+
+func Test_Do_ShouldRollbackWhenCallbackFails(t *testing.T) {
+
+	db := util.CreateDB()
+	util.QueryExec(db, CREATE_SECTION_TABLE)
+
+	expectedError := errors.New("second write failed")
+	unitOfWork := New(db, sections.NewRepository)
+
+	err := unitOfWork.Do(func(repository sections.SectionRepository) error {
+		if _, err := repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1); err != nil {
+			return err
+		}
+		return expectedError
+	})
+	assert.Equal(t, expectedError, err)
+
+	exists, err := sections.NewRepository(db).ExistsSectionNumber(1)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	util.DropDB(db)
+}
+
+func Test_Do_ShouldRollbackWhenCallbackPanics(t *testing.T) {
+
+	db := util.CreateDB()
+	util.QueryExec(db, CREATE_SECTION_TABLE)
+
+	unitOfWork := New(db, sections.NewRepository)
+
+	assert.Panics(t, func() {
+		unitOfWork.Do(func(repository sections.SectionRepository) error {
+			repository.Create(1, 11.1, 1.0, 60, 10, 100, 1, 1)
+			panic("unexpected")
+		})
+	})
+
+	exists, err := sections.NewRepository(db).ExistsSectionNumber(1)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	util.DropDB(db)
+}
+
+func Test_Do_ConnectionError(t *testing.T) {
+
+	db := util.CreateDB()
+	unitOfWork := New(db, sections.NewRepository)
+
+	db.Close()
+	err := unitOfWork.Do(func(repository sections.SectionRepository) error {
+		return nil
+	})
+	assert.NotNil(t, err)
+
+	util.DropDB(db)
+}
+
+const CREATE_SECTION_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity  BIGINT NOT NULL,
+		current_temperature DECIMAL(19, 2) NOT NULL,
+		maximum_capacity  BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		minimum_temperature DECIMAL(19, 2) NOT NULL,
+		product_type BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
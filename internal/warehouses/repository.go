@@ -4,31 +4,52 @@ import (
 	"database/sql"
 	"log"
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 )
 
 type WarehouseRepository interface {
-	GetAll() ([]database.Warehouse, error)
+	GetAll(params query.Params) (query.Page[database.Warehouse], error)
 	Create(Code string, address string, telephone string, minimunCapacity uint32, minimunTemperature float32, localityId string) (database.Warehouse, error)
 	Get(id uint64) (database.Warehouse, error)
 	Delete(id uint64) error
 	Update(warehouse database.Warehouse) (database.Warehouse, error)
 	ExistsWarehouseCode(code string) (bool, error)
+	GetSectionsUtilisation(id uint64) ([]database.SectionUtilisation, error)
 }
 
-func NewRepository(db *sql.DB) WarehouseRepository {
+func NewRepository(db database.Querier) WarehouseRepository {
 	return &warehouseRepository{
 		db: db,
 	}
 }
 
+var warehousesQuery = query.NewBuilder(
+	"warehouses",
+	"id, warehouse_code, address, telephone, minimum_capacity, minimum_temperature, locality_id",
+	map[string]string{
+		"id":                  "id",
+		"warehouse_code":      "warehouse_code",
+		"address":             "address",
+		"telephone":           "telephone",
+		"minimum_capacity":    "minimum_capacity",
+		"minimum_temperature": "minimum_temperature",
+		"locality_id":         "locality_id",
+	},
+)
+
 type warehouseRepository struct {
-	db *sql.DB
+	db database.Querier
 }
 
-func (r *warehouseRepository) GetAll() ([]database.Warehouse, error) {
-	stmt, err := r.db.Query("SELECT id, warehouse_code, address, telephone, minimum_capacity, minimum_temperature, locality_id FROM warehouses")
+func (r *warehouseRepository) GetAll(params query.Params) (query.Page[database.Warehouse], error) {
+	statement, args, err := warehousesQuery.Build(params)
 	if err != nil {
-		return nil, err
+		return query.Page[database.Warehouse]{}, err
+	}
+
+	stmt, err := r.db.Query(statement, args...)
+	if err != nil {
+		return query.Page[database.Warehouse]{}, err
 	}
 
 	defer stmt.Close()
@@ -47,13 +68,13 @@ func (r *warehouseRepository) GetAll() ([]database.Warehouse, error) {
 			&warehouse.MinimumTemperature,
 			&warehouse.LocalityID,
 		); err != nil {
-			return nil, err
+			return query.Page[database.Warehouse]{}, err
 		}
 
 		warehouses = append(warehouses, warehouse)
 	}
 
-	return warehouses, nil
+	return query.NewPage(warehouses, params)
 }
 
 func (r *warehouseRepository) Create(code string, address string, telephone string, minimumCapacity uint32, minimumTemperature float32, localityId string) (database.Warehouse, error) {
@@ -166,3 +187,31 @@ func (r *warehouseRepository) Update(warehouse database.Warehouse) (database.War
 
 	return warehouse, nil
 }
+
+// GetSectionsUtilisation lists the capacity of the sections of the
+// warehouse, by section number. The fill percentage is left to the service.
+func (r *warehouseRepository) GetSectionsUtilisation(id uint64) ([]database.SectionUtilisation, error) {
+	rows, err := r.db.Query(
+		"SELECT id, section_number, current_capacity, minimum_capacity, maximum_capacity FROM sections WHERE warehouse_id = ? ORDER BY section_number",
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	sections := []database.SectionUtilisation{}
+
+	for rows.Next() {
+		var section database.SectionUtilisation
+		err := rows.Scan(&section.SectionId, &section.SectionNumber, &section.CurrentCapacity, &section.MinimumCapacity, &section.MaximumCapacity)
+		if err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, section)
+	}
+
+	return sections, rows.Err()
+}
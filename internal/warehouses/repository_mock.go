@@ -2,6 +2,7 @@ package warehouses
 
 import (
 	database "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 )
 
 type MockWarehouseRepository struct {
@@ -9,14 +10,15 @@ type MockWarehouseRepository struct {
 	Err        error
 	FindByCode bool
 	GetById    database.Warehouse
+	Sections   []database.SectionUtilisation
 }
 
-func (m MockWarehouseRepository) GetAll() ([]database.Warehouse, error) {
+func (m MockWarehouseRepository) GetAll(params query.Params) (query.Page[database.Warehouse], error) {
 	if m.Err != nil {
-		return []database.Warehouse{}, m.Err
+		return query.Page[database.Warehouse]{}, m.Err
 	}
 
-	return m.Result.([]database.Warehouse), nil
+	return query.NewPage(m.Result.([]database.Warehouse), params)
 }
 
 func (m MockWarehouseRepository) Get(id uint64) (database.Warehouse, error) {
@@ -51,3 +53,7 @@ func (m MockWarehouseRepository) Update(warehouse database.Warehouse) (database.
 	}
 	return m.Result.(database.Warehouse), nil
 }
+
+func (m MockWarehouseRepository) GetSectionsUtilisation(id uint64) ([]database.SectionUtilisation, error) {
+	return m.Sections, m.Err
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	models "github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	util "github.com/GuiTadeu/mercado-fresh-panic/pkg/util"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
@@ -64,8 +65,8 @@ func Test_Repo_GetAll_Warehouse_Ok(t *testing.T) {
 	_, err = repository.Create("LP", "disco", "11976723778", 4, 3.0, "1")
 	assert.Nil(t, err)
 
-	foundWarehouses, _ := repository.GetAll()
-	assert.Equal(t, expectedCountRows, len(foundWarehouses))
+	foundWarehouses, _ := repository.GetAll(query.Params{})
+	assert.Equal(t, expectedCountRows, len(foundWarehouses.Items))
 
 	util.DropDB(database)
 
@@ -79,7 +80,7 @@ func Test_Repo_GetAll_Warehouse_ConnectionError(t *testing.T) {
 	repository := NewRepository(database)
 
 	database.Close()
-	_, err := repository.GetAll()
+	_, err := repository.GetAll(query.Params{})
 	assert.NotNil(t, err)
 
 	util.DropDB(database)
@@ -241,6 +242,29 @@ func Test_Repo_ExistsWarehouseCode_Warehouse_ConnectionError(t *testing.T) {
 	util.DropDB(database)
 }
 
+func Test_Repo_GetSectionsUtilisation_Ok(t *testing.T) {
+
+	database := util.CreateDB()
+	util.QueryExec(database, CREATE_SECTIONS_TABLE)
+	util.QueryExec(database, `INSERT INTO sections(section_number, current_capacity, minimum_capacity, maximum_capacity, warehouse_id)
+		VALUES (2, 5, 10, 100, 1), (1, 80, 10, 100, 1), (3, 50, 10, 100, 2)`)
+
+	repository := NewRepository(database)
+
+	sections, err := repository.GetSectionsUtilisation(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []models.SectionUtilisation{
+		{SectionId: 2, SectionNumber: 1, CurrentCapacity: 80, MinimumCapacity: 10, MaximumCapacity: 100},
+		{SectionId: 1, SectionNumber: 2, CurrentCapacity: 5, MinimumCapacity: 10, MaximumCapacity: 100},
+	}, sections)
+
+	empty, err := repository.GetSectionsUtilisation(9)
+	assert.Nil(t, err)
+	assert.Empty(t, empty)
+
+	util.DropDB(database)
+}
+
 const CREATE_WAREHOUSES_TABLE = `
 	CREATE TABLE "warehouses" (
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -253,3 +277,14 @@ const CREATE_WAREHOUSES_TABLE = `
 		FOREIGN KEY (locality_id) REFERENCES localities(id)	
 	);
 `
+
+const CREATE_SECTIONS_TABLE = `
+	CREATE TABLE "sections" (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		section_number BIGINT NOT NULL,
+		current_capacity BIGINT NOT NULL,
+		minimum_capacity BIGINT NOT NULL,
+		maximum_capacity BIGINT NOT NULL,
+		warehouse_id BIGINT NOT NULL
+	);
+`
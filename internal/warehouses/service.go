@@ -3,8 +3,10 @@ package warehouses
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/imdario/mergo"
 )
 
@@ -14,11 +16,14 @@ var (
 )
 
 type WarehouseService interface {
-	GetAll() ([]database.Warehouse, error)
+	GetAll(params query.Params) (query.Page[database.Warehouse], error)
 	Create(Code string, address string, telephone string, minimunCapacity uint32, minimunTemperature float32, localityId string) (database.Warehouse, error)
 	Get(id uint64) (database.Warehouse, error)
 	Delete(id uint64) error
 	Update(id uint64, code string, address string, telephone string, minimumCapacity uint32, minimumTemperature float32) (database.Warehouse, error)	
+	// GetUtilisation reports how full each section of the warehouse is,
+	// and the warehouse as a whole.
+	GetUtilisation(id uint64) (database.WarehouseUtilisation, error)
 }
 
 func NewService(warehouseRepo WarehouseRepository) WarehouseService {
@@ -31,8 +36,8 @@ type warehouseService struct {
 	warehouseRepo WarehouseRepository
 }
 
-func (s *warehouseService) GetAll() ([]database.Warehouse, error) {
-	return s.warehouseRepo.GetAll()
+func (s *warehouseService) GetAll(params query.Params) (query.Page[database.Warehouse], error) {
+	return s.warehouseRepo.GetAll(params)
 
 }
 
@@ -90,3 +95,47 @@ func (s *warehouseService) Update(id uint64, code string, address string, teleph
 	}
 	return newWarehouse, nil
 }
+
+func (s *warehouseService) GetUtilisation(id uint64) (database.WarehouseUtilisation, error) {
+	if _, err := s.Get(id); err != nil {
+		return database.WarehouseUtilisation{}, err
+	}
+
+	sections, err := s.warehouseRepo.GetSectionsUtilisation(id)
+	if err != nil {
+		return database.WarehouseUtilisation{}, err
+	}
+
+	utilisation := database.WarehouseUtilisation{
+		WarehouseId:          id,
+		Sections:             sections,
+		SectionsBelowMinimum: []uint64{},
+	}
+
+	for i := range utilisation.Sections {
+		section := &utilisation.Sections[i]
+		section.FillPercentage = fillPercentage(uint64(section.CurrentCapacity), uint64(section.MaximumCapacity))
+		section.BelowMinimum = section.CurrentCapacity < section.MinimumCapacity
+
+		if section.BelowMinimum {
+			utilisation.SectionsBelowMinimum = append(utilisation.SectionsBelowMinimum, section.SectionId)
+		}
+
+		utilisation.CurrentCapacity += uint64(section.CurrentCapacity)
+		utilisation.MaximumCapacity += uint64(section.MaximumCapacity)
+	}
+
+	utilisation.FillPercentage = fillPercentage(utilisation.CurrentCapacity, utilisation.MaximumCapacity)
+
+	return utilisation, nil
+}
+
+// fillPercentage is current as a percentage of maximum, with two decimals.
+// A section without capacity is reported as empty.
+func fillPercentage(current uint64, maximum uint64) float64 {
+	if maximum == 0 {
+		return 0
+	}
+
+	return math.Round(float64(current)*10000/float64(maximum)) / 100
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/GuiTadeu/mercado-fresh-panic/cmd/server/database"
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,10 +57,10 @@ func Test_GetAll_ShouldReturnWarehouseList(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
 	assert.Nil(t, err)
-	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, expectedResult, result.Items)
 }
 
 func Test_GetAll_ShouldReturnError(t *testing.T) {
@@ -73,9 +74,9 @@ func Test_GetAll_ShouldReturnError(t *testing.T) {
 	}
 
 	service := NewService(mockRepository)
-	result, err := service.GetAll()
+	result, err := service.GetAll(query.Params{})
 
-	assert.Equal(t, expectedResult, result)
+	assert.Empty(t, result.Items)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -213,3 +214,44 @@ func Test_Update_ShouldReturnErrorExists(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 
 }
+
+func Test_GetUtilisation_ShouldReturnOK(t *testing.T) {
+
+	mockWarehouseRepository := MockWarehouseRepository{
+		GetById: database.Warehouse{Id: 1},
+		Sections: []database.SectionUtilisation{
+			{SectionId: 1, SectionNumber: 1, CurrentCapacity: 80, MinimumCapacity: 10, MaximumCapacity: 100},
+			{SectionId: 2, SectionNumber: 2, CurrentCapacity: 5, MinimumCapacity: 10, MaximumCapacity: 200},
+			{SectionId: 3, SectionNumber: 3, CurrentCapacity: 0, MinimumCapacity: 0, MaximumCapacity: 0},
+		},
+	}
+
+	service := NewService(mockWarehouseRepository)
+	result, err := service.GetUtilisation(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, database.WarehouseUtilisation{
+		WarehouseId:     1,
+		CurrentCapacity: 85,
+		MaximumCapacity: 300,
+		FillPercentage:  28.33,
+		Sections: []database.SectionUtilisation{
+			{SectionId: 1, SectionNumber: 1, CurrentCapacity: 80, MinimumCapacity: 10, MaximumCapacity: 100, FillPercentage: 80},
+			{SectionId: 2, SectionNumber: 2, CurrentCapacity: 5, MinimumCapacity: 10, MaximumCapacity: 200, FillPercentage: 2.5, BelowMinimum: true},
+			{SectionId: 3, SectionNumber: 3},
+		},
+		SectionsBelowMinimum: []uint64{2},
+	}, result)
+}
+
+func Test_GetUtilisation_ShouldReturnNotFound(t *testing.T) {
+
+	mockWarehouseRepository := MockWarehouseRepository{
+		Err: errors.New("sql: no rows in result set"),
+	}
+
+	service := NewService(mockWarehouseRepository)
+	_, err := service.GetUtilisation(1)
+
+	assert.Equal(t, WarehouseNotFoundError, err)
+}
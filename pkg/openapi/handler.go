@@ -0,0 +1,37 @@
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed docs.html
+var docsPage string
+
+// Handler serves the document of routes as JSON. It is built on the first
+// request, when every route of the server has been registered.
+func (r *Registry) Handler(info Info, routes func() gin.RoutesInfo) gin.HandlerFunc {
+	var once sync.Once
+	var document Document
+
+	return func(ctx *gin.Context) {
+		once.Do(func() {
+			document = r.Document(info, routes())
+		})
+		ctx.JSON(http.StatusOK, document)
+	}
+}
+
+// DocsHandler serves a self-contained page that renders the document found
+// at specURL, so the docs work without access to a CDN.
+func DocsHandler(specURL string) gin.HandlerFunc {
+	page := strings.ReplaceAll(docsPage, "{{SPEC_URL}}", specURL)
+
+	return func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(page))
+	}
+}
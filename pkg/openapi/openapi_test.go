@@ -0,0 +1,148 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testItem struct {
+	Id   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+type testRequest struct {
+	Name  string   `json:"name" binding:"required"`
+	Tags  []string `json:"tags"`
+	Price float64  `json:"price,omitempty"`
+}
+
+var errTestNotFound = errors.New("item not found")
+
+func testErrorHandler(err error) int {
+	switch err {
+	case errTestNotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func testRoutes() gin.RoutesInfo {
+	return gin.RoutesInfo{
+		{Method: "POST", Path: "/api/v1/items/"},
+		{Method: "GET", Path: "/api/v1/items/:id"},
+		{Method: "GET", Path: "/api/v1/other"},
+	}
+}
+
+func testRegistry() *Registry {
+	registry := NewRegistry()
+	registry.Add(
+		Operation{Method: "POST", Path: "/api/v1/items/", Tag: "items", Request: testRequest{}, Response: testItem{}, Status: http.StatusCreated},
+		Operation{Method: "GET", Path: "/api/v1/items/:id", Tag: "items", Response: testItem{}, Errors: Errors(testErrorHandler, errTestNotFound)},
+	)
+	return registry
+}
+
+func Test_Schema_Object(t *testing.T) {
+
+	schemas := newSchemas()
+
+	schema := schemas.of(testRequest{})
+
+	assert.Equal(t, "#/components/schemas/testRequest", schema.Ref)
+
+	component := schemas.components["testRequest"]
+	assert.Equal(t, "object", component.Type)
+	assert.Equal(t, []string{"name"}, component.Required)
+	assert.Equal(t, "array", component.Properties["tags"].Type)
+	assert.Equal(t, "string", component.Properties["tags"].Items.Type)
+	assert.Equal(t, "number", component.Properties["price"].Type)
+}
+
+func Test_Document_PathParameters(t *testing.T) {
+
+	document := testRegistry().Document(Info{Title: "test"}, testRoutes())
+
+	operation := document.Paths["/api/v1/items/{id}"]["get"]
+	assert.NotNil(t, operation)
+	assert.Equal(t, "getItemsId", operation.OperationId)
+	assert.Equal(t, "id", operation.Parameters[0].Name)
+	assert.Equal(t, "path", operation.Parameters[0].In)
+	assert.True(t, operation.Parameters[0].Required)
+}
+
+func Test_Document_EnvelopeAndErrors(t *testing.T) {
+
+	document := testRegistry().Document(Info{Title: "test"}, testRoutes())
+
+	operation := document.Paths["/api/v1/items/{id}"]["get"]
+
+	envelope := operation.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "#/components/schemas/testItem", envelope.Properties["data"].Ref)
+
+	assert.Equal(t, "item not found", operation.Responses["404"].Description)
+	assert.Contains(t, operation.Responses, "401")
+	assert.Contains(t, operation.Responses, "403")
+	assert.Contains(t, operation.Responses, "500")
+	assert.Contains(t, document.Components.Schemas, "Error")
+
+	create := document.Paths["/api/v1/items/"]["post"]
+	assert.Contains(t, create.Responses, "201")
+	assert.Equal(t, "#/components/schemas/testRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func Test_Document_UndocumentedRoute(t *testing.T) {
+
+	document := testRegistry().Document(Info{Title: "test"}, testRoutes())
+
+	operation := document.Paths["/api/v1/other"]["get"]
+	assert.NotNil(t, operation)
+	assert.Equal(t, []string{"other"}, operation.Tags)
+}
+
+func Test_Document_Paginated(t *testing.T) {
+
+	registry := NewRegistry()
+	registry.Add(Operation{Method: "GET", Path: "/api/v1/items/", Response: []testItem{}, Paginated: true, Public: true})
+
+	document := registry.Document(Info{}, gin.RoutesInfo{{Method: "GET", Path: "/api/v1/items/"}})
+
+	operation := document.Paths["/api/v1/items/"]["get"]
+	envelope := operation.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "array", envelope.Properties["data"].Type)
+	assert.Contains(t, envelope.Properties, "meta")
+	assert.Len(t, operation.Parameters, 4)
+	assert.NotContains(t, operation.Responses, "401")
+	assert.Empty(t, *operation.Security)
+}
+
+func Test_Handlers(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/openapi.json", testRegistry().Handler(Info{Title: "test"}, testRoutes))
+	router.GET("/docs", DocsHandler("/openapi.json"))
+
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest("GET", "/openapi.json", nil))
+
+	var document Document
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Nil(t, json.Unmarshal(response.Body.Bytes(), &document))
+	assert.Equal(t, Version, document.OpenAPI)
+
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, httptest.NewRequest("GET", "/docs", nil))
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.True(t, strings.Contains(response.Body.String(), `fetch("/openapi.json")`))
+	assert.False(t, strings.Contains(response.Body.String(), "{{SPEC_URL}}"))
+}
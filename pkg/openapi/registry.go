@@ -0,0 +1,245 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/query"
+	"github.com/gin-gonic/gin"
+)
+
+const Version = "3.0.3"
+
+// Operation describes one route registered in gin. Routes without an
+// Operation are still listed in the document, with generic responses.
+type Operation struct {
+	Method string
+	// Path as registered in gin, e.g. /api/v1/products/:id.
+	Path    string
+	Tag     string
+	Summary string
+	// Request is a value of the JSON body type, nil when there is no body.
+	Request any
+	// Response is a value of the type sent in the data field of web.Response.
+	Response any
+	// Status is the success status, http.StatusOK when zero.
+	Status int
+	// Paginated adds the pkg/query parameters and the meta block.
+	Paginated bool
+	Query     []Parameter
+	Errors    ErrorResponses
+	// Public operations do not require credentials.
+	Public bool
+}
+
+// ErrorResponses lists the descriptions of each error status of an
+// operation.
+type ErrorResponses map[int][]string
+
+// Errors maps service errors to their status with the error handler of a
+// controller, so the document shows the same codes the API returns.
+func Errors(handler func(error) int, errs ...error) ErrorResponses {
+	responses := ErrorResponses{}
+	for _, err := range errs {
+		responses = responses.With(handler(err), err.Error())
+	}
+	return responses
+}
+
+func (e ErrorResponses) With(status int, description string) ErrorResponses {
+	responses := ErrorResponses{}
+	for code, descriptions := range e {
+		responses[code] = append([]string{}, descriptions...)
+	}
+	responses[status] = append(responses[status], description)
+	return responses
+}
+
+type Registry struct {
+	operations map[string]Operation
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		operations: map[string]Operation{},
+	}
+}
+
+func (r *Registry) Add(operations ...Operation) {
+	for _, operation := range operations {
+		r.operations[operation.Method+" "+operation.Path] = operation
+	}
+}
+
+// Document builds the specification of the given routes.
+func (r *Registry) Document(info Info, routes gin.RoutesInfo) Document {
+	schemas := newSchemas()
+
+	document := Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Security: []map[string][]string{
+			{"bearerAuth": {}},
+			{"apiKeyAuth": {}},
+		},
+	}
+
+	sorted := append(gin.RoutesInfo{}, routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path == sorted[j].Path {
+			return sorted[i].Method < sorted[j].Method
+		}
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	for _, route := range sorted {
+		operation, ok := r.operations[route.Method+" "+route.Path]
+		if !ok {
+			operation = Operation{Method: route.Method, Path: route.Path, Tag: defaultTag(route.Path)}
+		}
+
+		path, parameters := pathParameters(route.Path)
+		if document.Paths[path] == nil {
+			document.Paths[path] = PathItem{}
+		}
+		document.Paths[path][strings.ToLower(route.Method)] = r.operation(schemas, operation, parameters)
+	}
+
+	document.Components = Components{
+		Schemas: schemas.components,
+		SecuritySchemes: map[string]SecurityScheme{
+			"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			"apiKeyAuth": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+		},
+	}
+
+	document.Components.Schemas["Error"] = &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{"error": {Type: "string"}},
+		Required:   []string{"error"},
+	}
+
+	return document
+}
+
+func (r *Registry) operation(schemas *schemas, operation Operation, parameters []Parameter) *OperationObject {
+	object := &OperationObject{
+		Summary:     operation.Summary,
+		OperationId: operationId(operation.Method, operation.Path),
+		Parameters:  append(parameters, operation.Query...),
+		Responses:   map[string]ResponseObject{},
+	}
+
+	if operation.Tag != "" {
+		object.Tags = []string{operation.Tag}
+	}
+
+	if operation.Paginated {
+		object.Parameters = append(object.Parameters, paginationParameters()...)
+	}
+
+	if operation.Request != nil {
+		object.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: schemas.of(operation.Request)}},
+		}
+	}
+
+	status := operation.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := ResponseObject{Description: http.StatusText(status)}
+	if status != http.StatusNoContent && (operation.Response != nil || operation.Paginated) {
+		envelope := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		if operation.Response != nil {
+			envelope.Properties["data"] = schemas.of(operation.Response)
+		}
+		if operation.Paginated {
+			envelope.Properties["meta"] = schemas.of(query.Meta{})
+		}
+		success.Content = map[string]MediaType{"application/json": {Schema: envelope}}
+	}
+	object.Responses[strconv.Itoa(status)] = success
+
+	errors := operation.Errors
+	if operation.Public {
+		empty := []map[string][]string{}
+		object.Security = &empty
+	} else {
+		errors = errors.With(http.StatusUnauthorized, "missing or invalid credentials").
+			With(http.StatusForbidden, "operation not allowed for this user")
+	}
+	errors = errors.With(http.StatusInternalServerError, "unexpected error")
+
+	for code, descriptions := range errors {
+		object.Responses[strconv.Itoa(code)] = ResponseObject{
+			Description: strings.Join(descriptions, "; "),
+			Content:     map[string]MediaType{"application/json": {Schema: &Schema{Ref: "#/components/schemas/Error"}}},
+		}
+	}
+
+	return object
+}
+
+// pathParameters turns the gin parameters of path into OpenAPI ones, e.g.
+// /products/:id becomes /products/{id}.
+func pathParameters(path string) (string, []Parameter) {
+	var parameters []Parameter
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
+			continue
+		}
+
+		name := segment[1:]
+		schema := &Schema{Type: "string"}
+		if name == "id" {
+			schema = &Schema{Type: "integer", Format: "int64"}
+		}
+
+		parameters = append(parameters, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+		segments[i] = "{" + name + "}"
+	}
+
+	return strings.Join(segments, "/"), parameters
+}
+
+func paginationParameters() []Parameter {
+	explode := true
+	return []Parameter{
+		{Name: "limit", In: "query", Description: "page size, 50 by default and at most 500", Schema: &Schema{Type: "integer"}},
+		{Name: "cursor", In: "query", Description: "next_cursor of the previous page", Schema: &Schema{Type: "string"}},
+		{Name: "sort", In: "query", Description: "field to sort by, prefixed with - for descending order", Schema: &Schema{Type: "string"}},
+		{
+			Name:        "filter",
+			In:          "query",
+			Description: "equality filters, as filter[field]=value",
+			Style:       "deepObject",
+			Explode:     &explode,
+			Schema:      &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		},
+	}
+}
+
+func operationId(method string, path string) string {
+	id := strings.ToLower(method)
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "/api/v1"), "/") {
+		segment = strings.TrimLeft(segment, ":*")
+		if segment == "" {
+			continue
+		}
+		id += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+	return id
+}
+
+func defaultTag(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/api/v1/"), "/")
+	return segments[0]
+}
@@ -0,0 +1,159 @@
+package openapi
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	timeType       = reflect.TypeOf(time.Time{})
+)
+
+// schemas collects the component schemas of the named structs reached
+// while describing the operations.
+type schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{
+		components: map[string]*Schema{},
+		names:      map[reflect.Type]string{},
+	}
+}
+
+// of returns the schema of value's type, as encoding/json writes it.
+func (s *schemas) of(value any) *Schema {
+	return s.schema(reflect.TypeOf(value))
+}
+
+func (s *schemas) schema(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case rawMessageType:
+		return &Schema{}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		format := "int64"
+		if t.Bits() <= 32 {
+			format = "int32"
+		}
+		return &Schema{Type: "integer", Format: format, Minimum: &zero}
+
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+
+	case reflect.String:
+		return &Schema{Type: "string"}
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + s.component(t)}
+
+	default:
+		return &Schema{}
+	}
+}
+
+// component registers a named struct once and returns its component name.
+// The name is registered before the fields are described, so recursive
+// types end in a reference instead of looping.
+func (s *schemas) component(t reflect.Type) string {
+	if name, ok := s.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := s.components[name]; taken {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+
+	s.names[t] = name
+	s.components[name] = &Schema{}
+	*s.components[name] = *s.object(t)
+
+	return name
+}
+
+// object describes a struct from its JSON tags. Fields with a binding
+// "required" tag are listed as required, and embedded structs are
+// flattened like encoding/json does.
+func (s *schemas) object(t reflect.Type) *Schema {
+	object := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := s.object(field.Type)
+			for property, schema := range embedded.Properties {
+				object.Properties[property] = schema
+			}
+			object.Required = append(object.Required, embedded.Required...)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		object.Properties[name] = s.schema(field.Type)
+
+		for _, rule := range strings.Split(field.Tag.Get("binding"), ",") {
+			if rule == "required" {
+				object.Required = append(object.Required, name)
+			}
+		}
+	}
+
+	return object
+}
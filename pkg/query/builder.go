@@ -0,0 +1,93 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Builder writes the paginated SELECT of one table. Fields maps each JSON
+// field that can be sorted or filtered on to its column, and must include
+// "id", used to break ties between rows with the same sort value.
+type Builder struct {
+	table   string
+	columns string
+	fields  map[string]string
+}
+
+func NewBuilder(table string, columns string, fields map[string]string) Builder {
+	return Builder{
+		table:   table,
+		columns: columns,
+		fields:  fields,
+	}
+}
+
+// Build returns the query and its arguments for the page described by
+// params. It asks for one row more than the limit, so NewPage can tell
+// whether there is a next page.
+func (b Builder) Build(params Params) (string, []any, error) {
+	field, descending := params.sortField()
+
+	sortColumn, ok := b.fields[field]
+	if !ok {
+		return "", nil, ErrInvalidSort
+	}
+	idColumn := b.fields["id"]
+
+	var conditions []string
+	var args []any
+
+	filters := make([]string, 0, len(params.Filters))
+	for filter := range params.Filters {
+		filters = append(filters, filter)
+	}
+	sort.Strings(filters)
+
+	for _, filter := range filters {
+		column, ok := b.fields[filter]
+		if !ok {
+			return "", nil, ErrInvalidFilter
+		}
+		conditions = append(conditions, column+" = ?")
+		args = append(args, params.Filters[filter])
+	}
+
+	order, operator := "ASC", ">"
+	if descending {
+		order, operator = "DESC", "<"
+	}
+
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params.Cursor)
+		if err != nil || cursor.Sort != params.Sort {
+			return "", nil, ErrInvalidCursor
+		}
+
+		if sortColumn == idColumn {
+			conditions = append(conditions, fmt.Sprintf("%s %s ?", idColumn, operator))
+			args = append(args, cursor.Id)
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"(%s %s ? OR (%s = ? AND %s %s ?))",
+				sortColumn, operator, sortColumn, idColumn, operator,
+			))
+			args = append(args, cursor.Value, cursor.Value, cursor.Id)
+		}
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", b.columns, b.table)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s %s", sortColumn, order)
+	if sortColumn != idColumn {
+		query += fmt.Sprintf(", %s %s", idColumn, order)
+	}
+
+	query += " LIMIT ?"
+	args = append(args, params.limit()+1)
+
+	return query, args, nil
+}
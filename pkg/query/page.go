@@ -0,0 +1,130 @@
+package query
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Page is one page of a list endpoint. Meta is sent in the meta block of
+// the response.
+type Page[T any] struct {
+	Items []T
+	Meta  Meta
+}
+
+type Meta struct {
+	Limit      int    `json:"limit"`
+	Count      int    `json:"count"`
+	Sort       string `json:"sort,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// cursor points after the last item of a page. It keeps the sort it was
+// made for, since it means nothing under another one.
+type cursor struct {
+	Sort  string `json:"s,omitempty"`
+	Value any    `json:"v,omitempty"`
+	Id    any    `json:"id"`
+}
+
+// NewPage cuts the rows fetched with a Builder query down to the limit and,
+// when there were more, makes the cursor of the next page from the JSON
+// fields of the last item.
+func NewPage[T any](items []T, params Params) (Page[T], error) {
+	limit := params.limit()
+
+	page := Page[T]{
+		Items: items,
+		Meta: Meta{
+			Limit: limit,
+			Sort:  params.Sort,
+		},
+	}
+
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+
+	if len(items) > limit {
+		page.Items = items[:limit]
+
+		next, err := encodeCursor(params, page.Items[limit-1])
+		if err != nil {
+			return Page[T]{}, err
+		}
+		page.Meta.NextCursor = next
+	}
+
+	page.Meta.Count = len(page.Items)
+	return page, nil
+}
+
+func encodeCursor(params Params, item any) (string, error) {
+	field, _ := params.sortField()
+
+	next := cursor{Sort: params.Sort}
+	next.Id, _ = jsonField(item, "id")
+	if field != "id" {
+		next.Value, _ = jsonField(item, field)
+	}
+
+	data, err := json.Marshal(next)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(encoded string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var decoded cursor
+	if err := decoder.Decode(&decoded); err != nil {
+		return cursor{}, err
+	}
+
+	decoded.Value = number(decoded.Value)
+	decoded.Id = number(decoded.Id)
+	return decoded, nil
+}
+
+// number turns a decoded json.Number back into an int64 or float64, so the
+// database compares it as a number and not as text.
+func number(value any) any {
+	n, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
+
+// jsonField returns the value of the struct field whose JSON name is name.
+func jsonField(item any, name string) (any, bool) {
+	value := reflect.Indirect(reflect.ValueOf(item))
+	if value.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		tag := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+		if tag == name {
+			return value.Field(i).Interface(), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 500
+)
+
+var (
+	ErrInvalidLimit  = errors.New("limit must be a positive number")
+	ErrInvalidSort   = errors.New("sort field not supported")
+	ErrInvalidFilter = errors.New("filter field not supported")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Params describes one page of a list endpoint, as sent in
+// ?limit=&cursor=&sort=&filter[field]=. Field names are the JSON names of
+// the listed model, and a sort prefixed with "-" is descending.
+type Params struct {
+	Limit   int
+	Cursor  string
+	Sort    string
+	Filters map[string]string
+}
+
+// Parse reads the list parameters from a query string. Sort and filter
+// fields are only checked later by the Builder, which knows the columns.
+func Parse(values url.Values) (Params, error) {
+	params := Params{
+		Limit:   DefaultLimit,
+		Cursor:  values.Get("cursor"),
+		Sort:    values.Get("sort"),
+		Filters: map[string]string{},
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 1 {
+			return Params{}, ErrInvalidLimit
+		}
+		params.Limit = parsed
+	}
+
+	if params.Limit > MaxLimit {
+		params.Limit = MaxLimit
+	}
+
+	for key, value := range values {
+		if strings.HasPrefix(key, "filter[") && strings.HasSuffix(key, "]") && len(value) > 0 {
+			params.Filters[key[len("filter["):len(key)-1]] = value[0]
+		}
+	}
+
+	return params, nil
+}
+
+func (p Params) limit() int {
+	if p.Limit < 1 {
+		return DefaultLimit
+	}
+	return p.Limit
+}
+
+// sortField returns the field to sort by, defaulting to id, and whether
+// the order is descending.
+func (p Params) sortField() (string, bool) {
+	if strings.HasPrefix(p.Sort, "-") {
+		return strings.TrimPrefix(p.Sort, "-"), true
+	}
+	if p.Sort == "" {
+		return "id", false
+	}
+	return p.Sort, false
+}
@@ -0,0 +1,118 @@
+package query
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testItem struct {
+	Id   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+var testBuilder = NewBuilder("items", "id, name", map[string]string{
+	"id":   "id",
+	"name": "item_name",
+})
+
+func Test_Parse_Defaults(t *testing.T) {
+
+	params, err := Parse(url.Values{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultLimit, params.Limit)
+	assert.Empty(t, params.Sort)
+	assert.Empty(t, params.Filters)
+}
+
+func Test_Parse_LimitAndFilters(t *testing.T) {
+
+	values, _ := url.ParseQuery("limit=1000&sort=-name&filter[name]=banana&other=1")
+
+	params, err := Parse(values)
+
+	assert.Nil(t, err)
+	assert.Equal(t, MaxLimit, params.Limit)
+	assert.Equal(t, "-name", params.Sort)
+	assert.Equal(t, map[string]string{"name": "banana"}, params.Filters)
+}
+
+func Test_Parse_InvalidLimit(t *testing.T) {
+
+	for _, limit := range []string{"0", "-1", "abc"} {
+		_, err := Parse(url.Values{"limit": {limit}})
+		assert.Equal(t, ErrInvalidLimit, err)
+	}
+}
+
+func Test_Build_DefaultSort(t *testing.T) {
+
+	statement, args, err := testBuilder.Build(Params{Limit: 10})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT id, name FROM items ORDER BY id ASC LIMIT ?", statement)
+	assert.Equal(t, []any{11}, args)
+}
+
+func Test_Build_FilterAndDescendingSort(t *testing.T) {
+
+	params := Params{Limit: 5, Sort: "-name", Filters: map[string]string{"name": "banana", "id": "2"}}
+
+	statement, args, err := testBuilder.Build(params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT id, name FROM items WHERE id = ? AND item_name = ? ORDER BY item_name DESC, id DESC LIMIT ?", statement)
+	assert.Equal(t, []any{"2", "banana", 6}, args)
+}
+
+func Test_Build_UnknownFields(t *testing.T) {
+
+	_, _, err := testBuilder.Build(Params{Sort: "price"})
+	assert.Equal(t, ErrInvalidSort, err)
+
+	_, _, err = testBuilder.Build(Params{Filters: map[string]string{"price": "1"}})
+	assert.Equal(t, ErrInvalidFilter, err)
+}
+
+func Test_Build_InvalidCursor(t *testing.T) {
+
+	_, _, err := testBuilder.Build(Params{Cursor: "not a cursor"})
+	assert.Equal(t, ErrInvalidCursor, err)
+
+	page, _ := NewPage([]testItem{{Id: 1, Name: "a"}, {Id: 2, Name: "b"}}, Params{Limit: 1, Sort: "name"})
+
+	_, _, err = testBuilder.Build(Params{Limit: 1, Sort: "-name", Cursor: page.Meta.NextCursor})
+	assert.Equal(t, ErrInvalidCursor, err)
+}
+
+func Test_NewPage_CursorRoundTrip(t *testing.T) {
+
+	items := []testItem{{Id: 3, Name: "apple"}, {Id: 1, Name: "banana"}, {Id: 2, Name: "cherry"}}
+	params := Params{Limit: 2, Sort: "name"}
+
+	page, err := NewPage(items, params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, items[:2], page.Items)
+	assert.Equal(t, 2, page.Meta.Count)
+	assert.NotEmpty(t, page.Meta.NextCursor)
+
+	params.Cursor = page.Meta.NextCursor
+	statement, args, err := testBuilder.Build(params)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT id, name FROM items WHERE (item_name > ? OR (item_name = ? AND id > ?)) ORDER BY item_name ASC, id ASC LIMIT ?", statement)
+	assert.Equal(t, []any{"banana", "banana", int64(1), 3}, args)
+}
+
+func Test_NewPage_LastPage(t *testing.T) {
+
+	page, err := NewPage[testItem](nil, Params{Limit: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []testItem{}, page.Items)
+	assert.Equal(t, 0, page.Meta.Count)
+	assert.Empty(t, page.Meta.NextCursor)
+}
@@ -0,0 +1,41 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"reflect"
+	"strings"
+)
+
+func writeCSV(w io.Writer, columns table, rows cellRows) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(columns.columns); err != nil {
+		return err
+	}
+
+	record := make([]string, len(columns.columns))
+	err := rows(func(cells []reflect.Value) error {
+		for j, cell := range cells {
+			record[j] = csvText(cell)
+		}
+
+		return writer.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvText quotes text that a spreadsheet would run as a formula, like a
+// description starting with "=". Numbers keep their sign.
+func csvText(cell reflect.Value) string {
+	value := text(cell)
+	if cell.Kind() == reflect.String && value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,231 @@
+package report
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/GuiTadeu/mercado-fresh-panic/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	JSON = "json"
+	CSV  = "csv"
+	XLSX = "xlsx"
+
+	CSVContentType  = "text/csv"
+	XLSXContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+var (
+	ErrInvalidFormat = errors.New("format must be json, csv or xlsx")
+	ErrInvalidRows   = errors.New("report rows must be structs")
+)
+
+// Format picks the format of a report from ?format=, or else from the
+// Accept header. Anything else is answered with JSON, so browsers and the
+// existing clients keep working.
+func Format(ctx *gin.Context) (string, error) {
+	if format, ok := ctx.GetQuery("format"); ok {
+		switch strings.ToLower(format) {
+		case JSON, CSV, XLSX:
+			return strings.ToLower(format), nil
+		}
+		return "", ErrInvalidFormat
+	}
+
+	switch ctx.NegotiateFormat(gin.MIMEJSON, CSVContentType, XLSXContentType) {
+	case CSVContentType:
+		return CSV, nil
+	case XLSXContentType:
+		return XLSX, nil
+	default:
+		return JSON, nil
+	}
+}
+
+// Render answers with the rows of a report, flat structs, in the format
+// asked by the client. Spreadsheets are sent as a name.csv or name.xlsx
+// attachment, with one column per JSON field. Rows are encoded one at a
+// time straight into the response as they are iterated, so neither the
+// rows nor the file are held in memory. An error before anything is sent
+// is answered with a 500; after that it can only be logged.
+func Render[T any](ctx *gin.Context, name string, rows Rows[T]) {
+	format, err := Format(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, nil, err.Error()))
+		return
+	}
+
+	if format == JSON {
+		ctx.Header("Content-Type", gin.MIMEJSON+"; charset=utf-8")
+		ctx.Status(http.StatusOK)
+		send(ctx, writeJSON(ctx.Writer, rows))
+		return
+	}
+
+	columns, err := newTable(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, web.NewResponse(http.StatusInternalServerError, nil, err.Error()))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+	ctx.Status(http.StatusOK)
+
+	if format == CSV {
+		ctx.Header("Content-Type", CSVContentType+"; charset=utf-8")
+		err = writeCSV(ctx.Writer, columns, cellsOf(columns, rows))
+	} else {
+		ctx.Header("Content-Type", XLSXContentType)
+		err = writeXLSX(ctx.Writer, name, columns, cellsOf(columns, rows))
+	}
+
+	send(ctx, err)
+}
+
+// RenderOne answers with a single row: the row itself in JSON, like the
+// other endpoints that return one item, or a spreadsheet with one row.
+func RenderOne[T any](ctx *gin.Context, name string, row T) {
+	if format, err := Format(ctx); err == nil && format == JSON {
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, row, ""))
+		return
+	}
+
+	Render(ctx, name, Slice([]T{row}))
+}
+
+// send reports the error of a rendering. Writers buffer their output, so
+// an error of the first rows, like a failed query, is usually found before
+// anything is sent and can still be answered with a 500.
+func send(ctx *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	if !ctx.Writer.Written() {
+		ctx.Writer.Header().Del("Content-Disposition")
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.JSON(http.StatusInternalServerError, web.NewResponse(http.StatusInternalServerError, nil, err.Error()))
+		return
+	}
+
+	ctx.Error(err)
+}
+
+// table is the columns of a report, read from its row type through
+// reflection.
+type table struct {
+	columns []string
+	fields  []int
+}
+
+func newTable(rowType reflect.Type) (table, error) {
+	if rowType.Kind() != reflect.Struct {
+		return table{}, ErrInvalidRows
+	}
+
+	var t table
+
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		t.columns = append(t.columns, name)
+		t.fields = append(t.fields, i)
+	}
+
+	return t, nil
+}
+
+// cells returns the fields of a row in the order of the columns.
+func (t table) cells(row reflect.Value) []reflect.Value {
+	cells := make([]reflect.Value, len(t.fields))
+	for j, field := range t.fields {
+		cells[j] = reflect.Indirect(row.Field(field))
+	}
+	return cells
+}
+
+// cellRows iterates over the cells of the rows of a report.
+type cellRows func(yield func(cells []reflect.Value) error) error
+
+func cellsOf[T any](t table, rows Rows[T]) cellRows {
+	return func(yield func(cells []reflect.Value) error) error {
+		return rows(func(row T) error {
+			return yield(t.cells(reflect.ValueOf(row)))
+		})
+	}
+}
+
+// writeJSON writes the rows as the data of a web.Response.
+func writeJSON[T any](w io.Writer, rows Rows[T]) error {
+	out := bufio.NewWriter(w)
+	out.WriteString(`{"data":[`)
+
+	first := true
+	err := rows(func(row T) error {
+		if !first {
+			out.WriteByte(',')
+		}
+		first = false
+
+		encoded, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+
+		_, err = out.Write(encoded)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	out.WriteString(`]}`)
+	return out.Flush()
+}
+
+func isNumber(cell reflect.Value) bool {
+	switch cell.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// text formats a cell as it reads in the JSON response. Nil pointers are
+// empty.
+func text(cell reflect.Value) string {
+	switch cell.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.String:
+		return cell.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(cell.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(cell.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(cell.Float(), 'f', -1, cell.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(cell.Bool())
+	default:
+		return fmt.Sprint(cell.Interface())
+	}
+}
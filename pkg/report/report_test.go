@@ -0,0 +1,270 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRow struct {
+	Id          uint64  `json:"id"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Active      bool    `json:"active"`
+	internal    string
+	Ignored     string `json:"-"`
+}
+
+var testRows = []testRow{
+	{Id: 1, Description: "Banana, prata", Price: 2.5, Active: true},
+	{Id: 2, Description: "=1+1", Price: -3, Active: false},
+}
+
+func Test_Format_DefaultsToJSON(t *testing.T) {
+
+	for _, accept := range []string{"", "*/*", "text/html", gin.MIMEJSON} {
+		format, err := Format(testContext("/report", accept))
+
+		assert.Nil(t, err)
+		assert.Equal(t, JSON, format)
+	}
+}
+
+func Test_Format_FromAcceptHeader(t *testing.T) {
+
+	format, _ := Format(testContext("/report", "text/csv"))
+	assert.Equal(t, CSV, format)
+
+	format, _ = Format(testContext("/report", XLSXContentType))
+	assert.Equal(t, XLSX, format)
+}
+
+func Test_Format_QueryOverridesAcceptHeader(t *testing.T) {
+
+	format, err := Format(testContext("/report?format=XLSX", "text/csv"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, XLSX, format)
+}
+
+func Test_Format_Invalid(t *testing.T) {
+
+	_, err := Format(testContext("/report?format=pdf", ""))
+
+	assert.Equal(t, ErrInvalidFormat, err)
+}
+
+func Test_Render_JSON(t *testing.T) {
+
+	response := render("/report", "", Slice(testRows))
+
+	body := struct{ Data []testRow }{}
+	json.Unmarshal(response.Body.Bytes(), &body)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, testRows, body.Data)
+}
+
+func Test_Render_CSV(t *testing.T) {
+
+	response := render("/report", "text/csv", Slice(testRows))
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", response.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="items.csv"`, response.Header().Get("Content-Disposition"))
+	assert.Equal(t, "id,description,price,active\n"+
+		"1,\"Banana, prata\",2.5,true\n"+
+		"2,'=1+1,-3,false\n", response.Body.String())
+}
+
+func Test_Render_CSV_SingleRow(t *testing.T) {
+
+	response := renderOne("/report?format=csv", "", testRows[0])
+
+	assert.Equal(t, "id,description,price,active\n1,\"Banana, prata\",2.5,true\n", response.Body.String())
+}
+
+func Test_RenderOne_JSON(t *testing.T) {
+
+	response := renderOne("/report", "", testRows[0])
+
+	body := struct{ Data testRow }{}
+	json.Unmarshal(response.Body.Bytes(), &body)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, testRows[0], body.Data)
+}
+
+func Test_Render_CSV_EmptyReportHasHeader(t *testing.T) {
+
+	response := render("/report?format=csv", "", Slice([]testRow{}))
+
+	assert.Equal(t, "id,description,price,active\n", response.Body.String())
+}
+
+func Test_Render_XLSX(t *testing.T) {
+
+	response := render("/report?format=xlsx", "", Slice(testRows))
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, XLSXContentType, response.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="items.xlsx"`, response.Header().Get("Content-Disposition"))
+
+	archive, err := zip.NewReader(bytes.NewReader(response.Body.Bytes()), int64(response.Body.Len()))
+	assert.Nil(t, err)
+
+	names := []string{}
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.Equal(t, []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"}, names)
+
+	workbook := readZipFile(archive, "xl/workbook.xml")
+	assert.Contains(t, workbook, `<sheet name="items" sheetId="1" r:id="rId1"/>`)
+
+	sheet := readZipFile(archive, "xl/worksheets/sheet1.xml")
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">id</t></is></c>`)
+	assert.Contains(t, sheet, `<row r="2"><c r="A2"><v>1</v></c><c r="B2" t="inlineStr"><is><t xml:space="preserve">Banana, prata</t></is></c><c r="C2"><v>2.5</v></c><c r="D2" t="b"><v>1</v></c></row>`)
+	assert.Contains(t, sheet, `<c r="B3" t="inlineStr"><is><t xml:space="preserve">=1+1</t></is></c><c r="C3"><v>-3</v></c>`)
+}
+
+func Test_Render_400_InvalidFormat(t *testing.T) {
+
+	response := render("/report?format=pdf", "", Slice(testRows))
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func Test_Render_500_RowsAreNotStructs(t *testing.T) {
+
+	response := render("/report?format=csv", "", Slice([]string{"a"}))
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func Test_Render_ShouldWriteRowsAsTheyAreIterated(t *testing.T) {
+
+	for _, format := range []string{JSON, CSV, XLSX} {
+		var sentBeforeTheEnd int
+
+		gin.SetMode(gin.TestMode)
+		response := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(response)
+		ctx.Request = testRequest("/report?format="+format, "")
+
+		Render(ctx, "items", func(yield func(row testRow) error) error {
+			for i := 0; i < 10000; i++ {
+				if err := yield(testRow{Id: uint64(i), Description: "Banana, prata"}); err != nil {
+					return err
+				}
+			}
+			sentBeforeTheEnd = response.Body.Len()
+			return nil
+		})
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Greater(t, sentBeforeTheEnd, 0, format)
+	}
+}
+
+func Test_Render_500_QueryError(t *testing.T) {
+
+	expectedError := errors.New("connection error")
+
+	for _, format := range []string{JSON, CSV, XLSX} {
+		response := render("/report?format="+format, "", Err[testRow](expectedError))
+
+		body := struct{ Error string }{}
+		json.Unmarshal(response.Body.Bytes(), &body)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.Equal(t, expectedError.Error(), body.Error)
+		assert.Empty(t, response.Header().Get("Content-Disposition"))
+		assert.Equal(t, "application/json; charset=utf-8", response.Header().Get("Content-Type"))
+	}
+}
+
+func Test_Query_ShouldScanEachRow(t *testing.T) {
+
+	database, _ := sql.Open("sqlite3", ":memory:")
+	defer database.Close()
+
+	database.Exec("CREATE TABLE items(id INTEGER, description TEXT)")
+	database.Exec("INSERT INTO items VALUES (1, 'Banana'), (2, 'Maçã')")
+
+	rows := Query(func() (*sql.Rows, error) {
+		return database.Query("SELECT id, description FROM items ORDER BY id")
+	}, func(rows *sql.Rows, row *testRow) error {
+		return rows.Scan(&row.Id, &row.Description)
+	})
+
+	items, err := Collect(rows)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []testRow{{Id: 1, Description: "Banana"}, {Id: 2, Description: "Maçã"}}, items)
+
+	database.Close()
+	_, err = Collect(rows)
+	assert.NotNil(t, err)
+}
+
+func Test_CellName(t *testing.T) {
+
+	assert.Equal(t, "A1", cellName(0, 1))
+	assert.Equal(t, "Z2", cellName(25, 2))
+	assert.Equal(t, "AA3", cellName(26, 3))
+	assert.Equal(t, "AZ4", cellName(51, 4))
+	assert.Equal(t, "BA5", cellName(52, 5))
+}
+
+func render[T any](url string, accept string, rows Rows[T]) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	response := httptest.NewRecorder()
+
+	ctx, _ := gin.CreateTestContext(response)
+	ctx.Request = testRequest(url, accept)
+	Render(ctx, "items", rows)
+
+	return response
+}
+
+func renderOne(url string, accept string, row testRow) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	response := httptest.NewRecorder()
+
+	ctx, _ := gin.CreateTestContext(response)
+	ctx.Request = testRequest(url, accept)
+	RenderOne(ctx, "items", row)
+
+	return response
+}
+
+func testContext(url string, accept string) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = testRequest(url, accept)
+	return ctx
+}
+
+func testRequest(url string, accept string) *http.Request {
+	request, _ := http.NewRequest("GET", url, nil)
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	return request
+}
+
+func readZipFile(archive *zip.Reader, name string) string {
+	file, _ := archive.Open(name)
+	content, _ := io.ReadAll(file)
+	return string(content)
+}
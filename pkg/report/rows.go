@@ -0,0 +1,68 @@
+package report
+
+import "database/sql"
+
+// Rows iterates over the rows of a report, calling yield once per row in
+// order and stopping at the first error, of yield or of the rows.
+type Rows[T any] func(yield func(row T) error) error
+
+// Slice iterates over rows that are already in memory, like a report
+// computed by a service.
+func Slice[T any](rows []T) Rows[T] {
+	return func(yield func(row T) error) error {
+		for _, row := range rows {
+			if err := yield(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Query iterates over the result of a query, scanning each row right before
+// it is yielded, so a single row is held at a time. The query only runs
+// when the rows are iterated, and is closed when the iteration stops.
+func Query[T any](query func() (*sql.Rows, error), scan func(rows *sql.Rows, row *T) error) Rows[T] {
+	return func(yield func(row T) error) error {
+		rows, err := query()
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var row T
+			if err := scan(rows, &row); err != nil {
+				return err
+			}
+
+			if err := yield(row); err != nil {
+				return err
+			}
+		}
+
+		return rows.Err()
+	}
+}
+
+// Collect reads every row into a slice, for the callers that need them all
+// at once.
+func Collect[T any](rows Rows[T]) ([]T, error) {
+	collected := []T{}
+
+	err := rows(func(row T) error {
+		collected = append(collected, row)
+		return nil
+	})
+
+	return collected, err
+}
+
+// Err iterates over no rows, failing with err, like a query that could not
+// run.
+func Err[T any](err error) Rows[T] {
+	return func(yield func(row T) error) error {
+		return err
+	}
+}
@@ -0,0 +1,145 @@
+package report
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const contentTypesXML = xmlHeader +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const relsXML = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookRelsXML = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
+
+const workbookXML = xmlHeader +
+	`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+// maxSheetName is the longest sheet name spreadsheets accept.
+const maxSheetName = 31
+
+// writeXLSX writes a workbook with a single sheet named after the report.
+// It is the smallest package spreadsheets open: text goes in inline
+// strings, so no shared string table has to be built before the rows.
+func writeXLSX(w io.Writer, name string, columns table, rows cellRows) error {
+	archive := zip.NewWriter(w)
+
+	if len(name) > maxSheetName {
+		name = name[:maxSheetName]
+	}
+
+	parts := []struct {
+		path    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", relsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, escape(name))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+	}
+
+	for _, part := range parts {
+		file, err := archive.Create(part.path)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+
+	if err := writeSheet(sheet, columns, rows); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func writeSheet(w io.Writer, columns table, rows cellRows) error {
+	sheet := bufio.NewWriter(w)
+
+	sheet.WriteString(xmlHeader)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	sheet.WriteString(`<row r="1">`)
+	for j, column := range columns.columns {
+		writeCell(sheet, cellName(j, 1), reflect.ValueOf(column))
+	}
+	sheet.WriteString(`</row>`)
+
+	line := 1
+	err := rows(func(cells []reflect.Value) error {
+		line++
+
+		fmt.Fprintf(sheet, `<row r="%d">`, line)
+		for j, cell := range cells {
+			writeCell(sheet, cellName(j, line), cell)
+		}
+		_, err := sheet.WriteString(`</row>`)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	sheet.WriteString(`</sheetData></worksheet>`)
+	return sheet.Flush()
+}
+
+func writeCell(w *bufio.Writer, name string, cell reflect.Value) {
+	switch {
+	case cell.Kind() == reflect.Invalid:
+		fmt.Fprintf(w, `<c r="%s"/>`, name)
+	case isNumber(cell):
+		fmt.Fprintf(w, `<c r="%s"><v>%s</v></c>`, name, text(cell))
+	case cell.Kind() == reflect.Bool:
+		value := 0
+		if cell.Bool() {
+			value = 1
+		}
+		fmt.Fprintf(w, `<c r="%s" t="b"><v>%d</v></c>`, name, value)
+	default:
+		fmt.Fprintf(w, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, name, escape(text(cell)))
+	}
+}
+
+// cellName is the A1 reference of a zero based column and a row.
+func cellName(column int, row int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+	return name + strconv.Itoa(row)
+}
+
+func escape(value string) string {
+	var escaped strings.Builder
+	xml.EscapeText(&escaped, []byte(value))
+	return escaped.String()
+}
@@ -3,12 +3,17 @@ package web
 type Response struct {
 	Code  int         `json:"-"`
 	Data  interface{} `json:"data,omitempty"`
+	Meta  interface{} `json:"meta,omitempty"`
 	Error string      `json:"error,omitempty"`
 }
 
 func NewResponse(code int, data interface{}, err string) Response {
 	if code < 300 {
-		return Response{code, data, ""}
+		return Response{Code: code, Data: data}
 	}
-	return Response{code, nil, err}
+	return Response{Code: code, Error: err}
+}
+
+func NewPaginatedResponse(code int, data interface{}, meta interface{}) Response {
+	return Response{Code: code, Data: data, Meta: meta}
 }